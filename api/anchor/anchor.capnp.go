@@ -8,6 +8,7 @@ import (
 	fc "capnproto.org/go/capnp/v3/flowcontrol"
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
+	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
 	cluster "github.com/wetware/pkg/api/cluster"
 	core "github.com/wetware/pkg/api/core"
@@ -260,6 +261,26 @@ func (c Anchor) Cell(ctx context.Context, params func(Anchor_cell_Params) error)
 
 }
 
+func (c Anchor) Watch(ctx context.Context, params func(Anchor_watch_Params) error) (Anchor_watch_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe41237e4098ed922,
+			MethodID:      3,
+			InterfaceName: "anchor.capnp:Anchor",
+			MethodName:    "watch",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Anchor_watch_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Anchor_watch_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c Anchor) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -338,6 +359,8 @@ type Anchor_Server interface {
 	Walk(context.Context, Anchor_walk) error
 
 	Cell(context.Context, Anchor_cell) error
+
+	Watch(context.Context, Anchor_watch) error
 }
 
 // Anchor_NewServer creates a new Server from an implementation of Anchor_Server.
@@ -356,7 +379,7 @@ func Anchor_ServerToClient(s Anchor_Server) Anchor {
 // This can be used to create a more complicated Server.
 func Anchor_Methods(methods []server.Method, s Anchor_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 3)
+		methods = make([]server.Method, 0, 4)
 	}
 
 	methods = append(methods, server.Method{
@@ -395,6 +418,18 @@ func Anchor_Methods(methods []server.Method, s Anchor_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe41237e4098ed922,
+			MethodID:      3,
+			InterfaceName: "anchor.capnp:Anchor",
+			MethodName:    "watch",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Watch(ctx, Anchor_watch{call})
+		},
+	})
+
 	return methods
 }
 
@@ -449,6 +484,23 @@ func (c Anchor_cell) AllocResults() (Anchor_cell_Results, error) {
 	return Anchor_cell_Results(r), err
 }
 
+// Anchor_watch holds the state for a server call to Anchor.watch.
+// See server.Call for documentation.
+type Anchor_watch struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Anchor_watch) Args() Anchor_watch_Params {
+	return Anchor_watch_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Anchor_watch) AllocResults() (Anchor_watch_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Anchor_watch_Results(r), err
+}
+
 // Anchor_List is a list of Anchor.
 type Anchor_List = capnp.CapList[Anchor]
 
@@ -561,6 +613,400 @@ func (p Anchor_Child_Future) Anchor() Anchor {
 	return Anchor(p.Future.Field(0, nil).Client())
 }
 
+type Anchor_Handler capnp.Client
+
+// Anchor_Handler_TypeID is the unique identifier for the type Anchor_Handler.
+const Anchor_Handler_TypeID = 0xe0a604aeaefacdc0
+
+func (c Anchor_Handler) Recv(ctx context.Context, params func(Anchor_Handler_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe0a604aeaefacdc0,
+			MethodID:      0,
+			InterfaceName: "anchor.capnp:Anchor.Handler",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Anchor_Handler_recv_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
+
+}
+
+func (c Anchor_Handler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Anchor_Handler) String() string {
+	return "Anchor_Handler(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Anchor_Handler) AddRef() Anchor_Handler {
+	return Anchor_Handler(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Anchor_Handler) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Anchor_Handler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Anchor_Handler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Anchor_Handler) DecodeFromPtr(p capnp.Ptr) Anchor_Handler {
+	return Anchor_Handler(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Anchor_Handler) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Anchor_Handler) IsSame(other Anchor_Handler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Anchor_Handler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Anchor_Handler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Anchor_Handler_Server is a Anchor_Handler with a local implementation.
+type Anchor_Handler_Server interface {
+	Recv(context.Context, Anchor_Handler_recv) error
+}
+
+// Anchor_Handler_NewServer creates a new Server from an implementation of Anchor_Handler_Server.
+func Anchor_Handler_NewServer(s Anchor_Handler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Anchor_Handler_Methods(nil, s), s, c)
+}
+
+// Anchor_Handler_ServerToClient creates a new Client from an implementation of Anchor_Handler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Anchor_Handler_ServerToClient(s Anchor_Handler_Server) Anchor_Handler {
+	return Anchor_Handler(capnp.NewClient(Anchor_Handler_NewServer(s)))
+}
+
+// Anchor_Handler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Anchor_Handler_Methods(methods []server.Method, s Anchor_Handler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe0a604aeaefacdc0,
+			MethodID:      0,
+			InterfaceName: "anchor.capnp:Anchor.Handler",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, Anchor_Handler_recv{call})
+		},
+	})
+
+	return methods
+}
+
+// Anchor_Handler_recv holds the state for a server call to Anchor_Handler.recv.
+// See server.Call for documentation.
+type Anchor_Handler_recv struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Anchor_Handler_recv) Args() Anchor_Handler_recv_Params {
+	return Anchor_Handler_recv_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Anchor_Handler_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// Anchor_Handler_List is a list of Anchor_Handler.
+type Anchor_Handler_List = capnp.CapList[Anchor_Handler]
+
+// NewAnchor_Handler creates a new list of Anchor_Handler.
+func NewAnchor_Handler_List(s *capnp.Segment, sz int32) (Anchor_Handler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Anchor_Handler](l), err
+}
+
+type Anchor_Handler_recv_Params capnp.Struct
+
+// Anchor_Handler_recv_Params_TypeID is the unique identifier for the type Anchor_Handler_recv_Params.
+const Anchor_Handler_recv_Params_TypeID = 0xae86aa7d11369783
+
+func NewAnchor_Handler_recv_Params(s *capnp.Segment) (Anchor_Handler_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Anchor_Handler_recv_Params(st), err
+}
+
+func NewRootAnchor_Handler_recv_Params(s *capnp.Segment) (Anchor_Handler_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Anchor_Handler_recv_Params(st), err
+}
+
+func ReadRootAnchor_Handler_recv_Params(msg *capnp.Message) (Anchor_Handler_recv_Params, error) {
+	root, err := msg.Root()
+	return Anchor_Handler_recv_Params(root.Struct()), err
+}
+
+func (s Anchor_Handler_recv_Params) String() string {
+	str, _ := text.Marshal(0xae86aa7d11369783, capnp.Struct(s))
+	return str
+}
+
+func (s Anchor_Handler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Anchor_Handler_recv_Params) DecodeFromPtr(p capnp.Ptr) Anchor_Handler_recv_Params {
+	return Anchor_Handler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Anchor_Handler_recv_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Anchor_Handler_recv_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Anchor_Handler_recv_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Anchor_Handler_recv_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Anchor_Handler_recv_Params) Event() (Anchor_Event, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Anchor_Event(p.Struct()), err
+}
+
+func (s Anchor_Handler_recv_Params) HasEvent() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Anchor_Handler_recv_Params) SetEvent(v Anchor_Event) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated Anchor_Event struct, preferring placement in s's segment.
+func (s Anchor_Handler_recv_Params) NewEvent() (Anchor_Event, error) {
+	ss, err := NewAnchor_Event(capnp.Struct(s).Segment())
+	if err != nil {
+		return Anchor_Event{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Anchor_Handler_recv_Params_List is a list of Anchor_Handler_recv_Params.
+type Anchor_Handler_recv_Params_List = capnp.StructList[Anchor_Handler_recv_Params]
+
+// NewAnchor_Handler_recv_Params creates a new list of Anchor_Handler_recv_Params.
+func NewAnchor_Handler_recv_Params_List(s *capnp.Segment, sz int32) (Anchor_Handler_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Anchor_Handler_recv_Params](l), err
+}
+
+// Anchor_Handler_recv_Params_Future is a wrapper for a Anchor_Handler_recv_Params promised by a client call.
+type Anchor_Handler_recv_Params_Future struct{ *capnp.Future }
+
+func (f Anchor_Handler_recv_Params_Future) Struct() (Anchor_Handler_recv_Params, error) {
+	p, err := f.Future.Ptr()
+	return Anchor_Handler_recv_Params(p.Struct()), err
+}
+func (p Anchor_Handler_recv_Params_Future) Event() Anchor_Event_Future {
+	return Anchor_Event_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Anchor_Event capnp.Struct
+type Anchor_Event_Which uint16
+
+const (
+	Anchor_Event_Which_created Anchor_Event_Which = 0
+	Anchor_Event_Which_removed Anchor_Event_Which = 1
+	Anchor_Event_Which_stored  Anchor_Event_Which = 2
+)
+
+func (w Anchor_Event_Which) String() string {
+	const s = "createdremovedstored"
+	switch w {
+	case Anchor_Event_Which_created:
+		return s[0:7]
+	case Anchor_Event_Which_removed:
+		return s[7:14]
+	case Anchor_Event_Which_stored:
+		return s[14:20]
+
+	}
+	return "Anchor_Event_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Anchor_Event_TypeID is the unique identifier for the type Anchor_Event.
+const Anchor_Event_TypeID = 0xc34d4ec6839ec70a
+
+func NewAnchor_Event(s *capnp.Segment) (Anchor_Event, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Anchor_Event(st), err
+}
+
+func NewRootAnchor_Event(s *capnp.Segment) (Anchor_Event, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Anchor_Event(st), err
+}
+
+func ReadRootAnchor_Event(msg *capnp.Message) (Anchor_Event, error) {
+	root, err := msg.Root()
+	return Anchor_Event(root.Struct()), err
+}
+
+func (s Anchor_Event) String() string {
+	str, _ := text.Marshal(0xc34d4ec6839ec70a, capnp.Struct(s))
+	return str
+}
+
+func (s Anchor_Event) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Anchor_Event) DecodeFromPtr(p capnp.Ptr) Anchor_Event {
+	return Anchor_Event(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Anchor_Event) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s Anchor_Event) Which() Anchor_Event_Which {
+	return Anchor_Event_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Anchor_Event) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Anchor_Event) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Anchor_Event) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Anchor_Event) Created() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		panic("Which() != created")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Anchor_Event) HasCreated() bool {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Anchor_Event) CreatedBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Anchor_Event) SetCreated(v string) error {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Anchor_Event) Removed() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != removed")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Anchor_Event) HasRemoved() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Anchor_Event) RemovedBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Anchor_Event) SetRemoved(v string) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Anchor_Event) SetStored() {
+	capnp.Struct(s).SetUint16(0, 2)
+
+}
+
+// Anchor_Event_List is a list of Anchor_Event.
+type Anchor_Event_List = capnp.StructList[Anchor_Event]
+
+// NewAnchor_Event creates a new list of Anchor_Event.
+func NewAnchor_Event_List(s *capnp.Segment, sz int32) (Anchor_Event_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Anchor_Event](l), err
+}
+
+// Anchor_Event_Future is a wrapper for a Anchor_Event promised by a client call.
+type Anchor_Event_Future struct{ *capnp.Future }
+
+func (f Anchor_Event_Future) Struct() (Anchor_Event, error) {
+	p, err := f.Future.Ptr()
+	return Anchor_Event(p.Struct()), err
+}
+
 type Anchor_Loader capnp.Client
 
 // Anchor_Loader_TypeID is the unique identifier for the type Anchor_Loader.
@@ -1677,69 +2123,235 @@ func (p Anchor_cell_Results_Future) Storer() Anchor_Storer {
 	return Anchor_Storer(p.Future.Field(1, nil).Client())
 }
 
-const schema_efb5a91f96d44de3 = "x\xda\x8cU]h\x1cU\x14>\xe7\xdeYg&\xec" +
-	"vs3[i\xade\x89\xa44\x0d&4\x89P\xbb" +
-	" \xbb\xa9\xc6\xda\xd2\xe8\xdehm\xd6\x9f\x87aw`" +
-	"\xa3\xd3\xddtv7\xab\x88\x04\x84\xfa *\x0a\"6" +
-	"\xda\x07\x85\x82EhT\xa8\xa5\x82BE\x8c/b(" +
-	"\x015PP[\x85>\x88\xc6'-\xea\x95{';" +
-	"3\xc9&\xd1\xb7\xd9{\xcf~\xe7|\xdfw\xce\xb9{" +
-	"\xef\xa29m0\xd1\xdc\x02\x84\xbf\x1b\xbbI\\\xea]" +
-	"\xf8q\xfe\x89\xe1\x17\x80\xa5\xa8\xb8\xed\xbb\x97\xcdk\xfb" +
-	"\xba\xae\x01\xa0\xd5\xad/Y\xfd\xba\x0e`\xed\xd1\x0fZ" +
-	"\\~\x89\xeb\xb1\x0ff\xef}\xf1\x99\xb7\x81\xa5\x10 " +
-	"Ft\x80\xe1\xfdz\x07\x02Z#z\x13P\xec>]" +
-	"x#m\xbe\xf4^\x1b\xdai}\xc9:\xab\xd0\xce\xe8" +
-	"\x07\xad\xaf\x14\x9a\xfb\xe5[\xcd\x89\x9d\x9f\x9d[AC" +
-	"\x89v\xdeG\xfbD\xcf\x02\x8a3\xf7\x8f-\x8b\xb9+" +
-	"\x17\x80\xed\x08\x02\xae\xe8\x07d\xc0O*\xe0\x04\x9f\xb0" +
-	"w\xdfH^\x8c\"\xc4\x0c\"\x03LC\x06\xfc\xc0\x8e" +
-	"\xd5N.\xc4.\xf9\x01\x9abc\xfc\x0e\x9a85x" +
-	"\xf4\xc3[\x9f\xda6//\xc2B\x15#+a\\\xb6" +
-	"\xb6\x1b\xf2k\xab!I\xe9\xcf\x96\xd2\xd3\x9f\x9e\xfb\x1a" +
-	"x\x02Q\\\x1d[|=}\xf6\xfc\xaf0\x8a:\x05" +
-	"\xb0N\x18\xb3\xd6\xd3*\xbaa\xcc\x01\x8a\xc2\xfb\xd7O" +
-	"~K\x1f\xbb\x1c\xa6\x1cN\x98\x04A\x13\x85\xc5\xd4\xe7" +
-	"\x0b3\xdf,\x01\xdf\x81A\xb9\xcb\x86\xe2\xf3\xa7\xca4" +
-	"\xd1\xec\x9aymn\xd7\xd5\x08\x1f\xab`\xde\x00\xb4\x1e" +
-	"7%\x9d\xa0P\x96\xa0a!\x80\xd6\xab\xe6;\xd6)" +
-	"s\x1f\x80u\xd1\x9c\xb7\x06;\xa4\xbc\xc7.d\xde|" +
-	"\xee\x97\xc5\x9fW\xd2\xa9J\xb6w\x1c\x96\xd9vuH" +
-	"\xb4Go\xfe\xf8\x81W\xfar\x7f\xf8\xf2\xaa\xfbC\x1d" +
-	"\x19\x04\x01\xfd\xc2\xae\x14\xcbUo\xa0H\xec\xa9\xcaT" +
-	"f\xc4\xffu\xa4j\x97\xa8\xe3\xe5\x11\xb9Fc\x00\x01" +
-	"\x02\xb6\x9cb\xac\x0f\x08\x8b\xe9I\xb7j\x97r\x98G" +
-	"\x0c\x90h\x14\xa9\xe8\xb8n\xcf\xb8Sk\xb8\xf5\x1a\x00" +
-	"7\xa8\x06\xa0!\x00\xdb\x93\x01\xe0=\x14\xf9^\x82\x0c" +
-	"1%+g\xfd\xf2\xb0\x97\"\xbf\x83`V\";\x1e" +
-	"\xb2\xb0s\x01\x91\x01fk\xf5\xaa\xa7.\x82&\xf4/" +
-	"\xd6\xe7\xf2`\xbd\xea\xad\xe2\xd2r\x07[\xba16\xa4" +
-	"\xb8\xa4\x15\xf0&d\x9a\xb6\xfbd\x84\x8c\x16\x90Id" +
-	"\x147\xe4)\x82Y\xff\xaf\xc8\xa2c\xb1\xaa<m\xad" +
-	"\xd4\x8e7 \xc9\x06\xd0Q\xe4\xa1\x109=m\xbb\x0d" +
-	"\x07\xbb\x80`\x17lVb\xde\xf6\xec\xe3\xaba\xfaB" +
-	"\x98\xe4\x94]/c\x1c\x08\xc67\xd2\xcc\xad\xf5\xe4\xd3" +
-	"\x0ad\xfd\xfb\xbb\xcb\x93.\x96\xa4\xa4\xffeh_\xc4" +
-	"\xd0\x8d\x84IV\xec\xe3N[A\xa8\x12>l\xbb\xb4" +
-	"\xe1\xc8Lq\xaa\xc5\x85P\xa9F\x0f\x00\xf0\x1cE~" +
-	"\x84`\x02\xff\x11~\xaeC2\xd7=\x14y\x9e`\x82" +
-	"\xfc-RH\x00\xd8\x98,\xeb>\x8a\xfc!\x823E" +
-	"\xb7Q\xab\xab\xd6\x99\xed\xf9\xeb\x91\xe1\xdfv\xb6z*" +
-	"Y\xae\xd6\xea\xd8)\x0a\xdf\x7f\xf4\xfcr\xf7\xd1/\xe4" +
-	"q'\xfc\x0f/\x8d\xb6f_\x11\xbf\x15\xb0\xb6\x15\x1d" +
-	"o@\xb5Y`RD\xc1\xa1P\xc1@\xc0q\x00~" +
-	";E~g\x9b\xff\xd5i\xc7kz\x93u@\x07\x11" +
-	"\x08\xe2&n\x8eg\xfd\xd6\x8a\xb6\xc4a\x00\x1e\xa7\xc8" +
-	"{\x09\x8aby\xd2-yN\x05\x00p\x0b`\x9e\"" +
-	"v\x86\xab\x13P\x1e\xaeqf\xa4R\xd4\xcbU\x8f\xc7" +
-	"\x11#K\x96\x0dE\x1e\x9aD&\xf2N\x98\x99\xb4l" +
-	"\x9bR\xd6\xef\xf8\xac/\x06\x8f\xab\x99l\xadol-" +
-	"F\xc6o\x01\xc2Fu\x0cw?\xb6\x9e\x11\xb6_\xee" +
-	"\x9e~\x1dI\xb0\x83\xb1\xf5`\xb1ny\xb7U\xa7n" +
-	"-\x87I9\x0d9LJ_V\x8f\xb5\xb6\xa1-\xeb" +
-	"\x8e\xf7\xf8\x8aT\xdb\x08\x8aZ\xa3Xt\x9c\x92\x03X" +
-	"j\x93\x9dn4\xd9Y\xdf\xed\x7f\x03\x00\x00\xff\xff\xbb" +
-	"\x7f\x0d\x9b"
+type Anchor_watch_Params capnp.Struct
+
+// Anchor_watch_Params_TypeID is the unique identifier for the type Anchor_watch_Params.
+const Anchor_watch_Params_TypeID = 0xafd55575952c8b79
+
+func NewAnchor_watch_Params(s *capnp.Segment) (Anchor_watch_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Anchor_watch_Params(st), err
+}
+
+func NewRootAnchor_watch_Params(s *capnp.Segment) (Anchor_watch_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Anchor_watch_Params(st), err
+}
+
+func ReadRootAnchor_watch_Params(msg *capnp.Message) (Anchor_watch_Params, error) {
+	root, err := msg.Root()
+	return Anchor_watch_Params(root.Struct()), err
+}
+
+func (s Anchor_watch_Params) String() string {
+	str, _ := text.Marshal(0xafd55575952c8b79, capnp.Struct(s))
+	return str
+}
+
+func (s Anchor_watch_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Anchor_watch_Params) DecodeFromPtr(p capnp.Ptr) Anchor_watch_Params {
+	return Anchor_watch_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Anchor_watch_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Anchor_watch_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Anchor_watch_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Anchor_watch_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Anchor_watch_Params) Handler() Anchor_Handler {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Anchor_Handler(p.Interface().Client())
+}
+
+func (s Anchor_watch_Params) HasHandler() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Anchor_watch_Params) SetHandler(v Anchor_Handler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Anchor_watch_Params_List is a list of Anchor_watch_Params.
+type Anchor_watch_Params_List = capnp.StructList[Anchor_watch_Params]
+
+// NewAnchor_watch_Params creates a new list of Anchor_watch_Params.
+func NewAnchor_watch_Params_List(s *capnp.Segment, sz int32) (Anchor_watch_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Anchor_watch_Params](l), err
+}
+
+// Anchor_watch_Params_Future is a wrapper for a Anchor_watch_Params promised by a client call.
+type Anchor_watch_Params_Future struct{ *capnp.Future }
+
+func (f Anchor_watch_Params_Future) Struct() (Anchor_watch_Params, error) {
+	p, err := f.Future.Ptr()
+	return Anchor_watch_Params(p.Struct()), err
+}
+func (p Anchor_watch_Params_Future) Handler() Anchor_Handler {
+	return Anchor_Handler(p.Future.Field(0, nil).Client())
+}
+
+type Anchor_watch_Results capnp.Struct
+
+// Anchor_watch_Results_TypeID is the unique identifier for the type Anchor_watch_Results.
+const Anchor_watch_Results_TypeID = 0xd54a4537fc328795
+
+func NewAnchor_watch_Results(s *capnp.Segment) (Anchor_watch_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Anchor_watch_Results(st), err
+}
+
+func NewRootAnchor_watch_Results(s *capnp.Segment) (Anchor_watch_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Anchor_watch_Results(st), err
+}
+
+func ReadRootAnchor_watch_Results(msg *capnp.Message) (Anchor_watch_Results, error) {
+	root, err := msg.Root()
+	return Anchor_watch_Results(root.Struct()), err
+}
+
+func (s Anchor_watch_Results) String() string {
+	str, _ := text.Marshal(0xd54a4537fc328795, capnp.Struct(s))
+	return str
+}
+
+func (s Anchor_watch_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Anchor_watch_Results) DecodeFromPtr(p capnp.Ptr) Anchor_watch_Results {
+	return Anchor_watch_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Anchor_watch_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Anchor_watch_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Anchor_watch_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Anchor_watch_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Anchor_watch_Results_List is a list of Anchor_watch_Results.
+type Anchor_watch_Results_List = capnp.StructList[Anchor_watch_Results]
+
+// NewAnchor_watch_Results creates a new list of Anchor_watch_Results.
+func NewAnchor_watch_Results_List(s *capnp.Segment, sz int32) (Anchor_watch_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Anchor_watch_Results](l), err
+}
+
+// Anchor_watch_Results_Future is a wrapper for a Anchor_watch_Results promised by a client call.
+type Anchor_watch_Results_Future struct{ *capnp.Future }
+
+func (f Anchor_watch_Results_Future) Struct() (Anchor_watch_Results, error) {
+	p, err := f.Future.Ptr()
+	return Anchor_watch_Results(p.Struct()), err
+}
+
+const schema_efb5a91f96d44de3 = "x\xda\x8cVm\x8c\x13U\x17>gf\xca\xbc\x90N" +
+	"g\xeeNy\xc3\xd7\xa6Y\xb3\x04\xd8\xc0\x06Z\x01i" +
+	"b\xdaEW\x90\xb0\xd8YD\xa8\x9a\x98I;I\xd1" +
+	"\xa1\x85\xe9\x97\xc6\x90F\x09\x98\xa8\x18\x7f\x88\xca\xfa\x91" +
+	"`\x82\x09\x9a\xec\x8a\x09\x12L4\xa0\x06\xfcc$d" +
+	"7\xea&\x9b\xf0\xa5\x09?\x8c\xe2/\xdd\xa0c\xee\x9d" +
+	"\xce\xc7n[\xd6\x7f\xed\xbd\xcf<\xf7\x9c\xe7<\xe7\xdc" +
+	"\xbbvC(-\xac\x93V,\x01n\xe7Z\x0c\xcd\xb3" +
+	"/\xac\xbc|\xfd\xd2\xd3\x89\x97\x81Dy\xfb\x9e\x9f^" +
+	"\x9b\x7fsc\xd7M\x00T\xff\x0aO\xaa!I\x04P" +
+	"Q\xda\xa2\xae\xa2\xbf\xec[\xa1\xd3#\x0f\xbd\xfa\xfc\x09" +
+	" Q\x04\x08q\"@\x82H\x0b\x10P],\xd5\x01" +
+	"\xed\x15\xefe\xdf\x8e\xcd?\xfaq\x0b[U\x9aT_" +
+	"`l\x07\xa5-\xeaI\xc6v\xe8\xad\x0d\xe4\xe0GG" +
+	"F\x81,\xa3lH\xd9\x8eJ\x9b)\xdb1)\x05h" +
+	"\x9b\xdf\xbe[\xdf\xd3\xfd\xd5h\xf38\x068\xe3\x1c\xf7" +
+	"\x05\x03<\xf7\xca\xeac\xd5]\x13cA\xc0\x94\x03\xf8" +
+	"\x99\x01N\xee\x18\xbam\x8fM\x9d\x05\xb2\xd4\x03\x84\"" +
+	"\xec\x08)B\x01\x07\xb4=\xfa\x8ai\xf9\\\x90aM" +
+	"\x84\xa3\x80u\x0cp\x8d\xec.\x1f\xbe\x1c\xba\xe0\x00\x04" +
+	"\x9a\x81\x16\xf9\x03\x04{\xc1\xa5\xf7\x0f]\xdc1\xf45" +
+	"hQD?\xd7A\x14y\x00u}\xe4\x8a:\x10\xa1" +
+	"\xe8\xfb#c\x80\xf6\xf1u\xbb>]\xf6\xec\xa2K\x94" +
+	"\xc6\x073\x05\xd5\x89\xc8\x15\xf5\x1a\xc3NE\xa8\x88\xe2" +
+	"\xc1|\xac\xf6\xe5\xe8\xf7\xa0I\x88\xf6\x8d\xa1\xf17c" +
+	"\xa7\xce\xfc\xe62o\x92G\xd4\x01\x991\xcb\x949\xfb" +
+	"\xc9\xad\xc3?\xf2O^\xf1\x03LL\xc8\x1c\x82`\x1f" +
+	"{)~g\xe3\xe0\xb6\x89\xc0\xce9\xb9\x8b\xeed\xc7" +
+	"\xa3\xdf\\n\xfc0\x09\xdaR\xf4\xd2>!3]N" +
+	"\xc94\x86\xf3\xdfM\x8f\x8e\x0a\x1f^m)\xe4|\xe5" +
+	"\xba\xbaP\xa1\xc7\x13e\x8b\xba\x89\xfe\xb2\xf7\xd4\xbb\x1a" +
+	"o\x8c-\xbf\x11\x10Q\xedQ\xa6\x01\xd5\xe5\x0a\xd5\xd0" +
+	"\xfb\x9eH\xbc\x9f\x0f\xa0\x9aU>Pue7@\xe2" +
+	"\xb8\"\xa2\xba\x89P\xb6\xddg\x93\xef\xbc\xf8\xeb\xf8/" +
+	"\xcd\xe0X\xdc=d\x1b\x8dm\x0d\xa1tO\xfc\xff\xf3" +
+	"G^\xefK\xff\xe9\x14\x95\xedk$\x89`\x83\x0d\xa6" +
+	"\xad\x17s\x85\x92\xd5\x9f\xe3\xf4\xfd\xc5\xfd\xc9\x01\xe7\xdf" +
+	"\xf6\x92\x9e\xe7\x0d+\x83\xa8\x09|\x08\xc0\xe3@\xd7!" +
+	"\x84\xf4\x01GB\xa2l\x96\xf4|\x1a3\x88\x1e\x13\x1f" +
+	"d\xca\x19\xa6\xd9;l\x94\xabf\xa5\x0c\xa0\xfd\x8f\x17" +
+	"\x00\x04\x04 \xab\x92\x00Z/\x8f\xdaZ\x0e\x09b\x14" +
+	"\xe9\xe2\x1a\xba\xb8\x92G\xed^\x0eS\x94\xd9\xb0\x90\xf8" +
+	"=\x07\x88\x040U\xae\x94,\xb6\xe1\xb5\x8f\xb3\xd1>" +
+	"\x97\x9d\x95\x925#\x17\xb7\x9a\xe8*GH\x9c\xe5\x12" +
+	"c\xc43\x93\x11\x82T[\xf5b\xde4\xac~\xcb\xc8" +
+	"\xd5z3\xba\xa5\xef+\x83&x)Iq\x96!j" +
+	"Q\x0ecF\xcd(VP\xf1}\x0f\x88\x0atP\xa9" +
+	"\xae\x9b\xcf\x04T\x0aP&}\xca\x94\xf3)\x92\xa0\xc1" +
+	"\x90t\xe6\xac\xe4\x0an\x94387\xfb\x9c\x8d\x82\x93" +
+	"\x12\x12\xdf\xc2\xb3H\x85\xd9\xc60\xac~Z\x1a/\xde" +
+	"N\x0a\xd4t\xb3j`\x17p\xd8u\xd7\xbc\xdb\x08\xd9" +
+	"\xe7\xd3\xc8\xfb\xf5J\x01\xc3\xc0a\xb8S\x85\xcdro" +
+	"&\xc6H\xda\xef\x0f\xd6\x8c\"V\xa8\x01\xc2\xbc\x10\xb6" +
+	"mv\xc6 U!\xcd\xa3\xb6\x9dC\x09\xff\xb1\x1d\x03" +
+	">LW\x1f\xe4Q\xcbp(q\x7f\xdbQ\xe4\x00\xc8" +
+	"\x10\xad\xc2V\x1e\xb5G9l\xe4,C\xaf\x18y7" +
+	"\xa4\x86e\xec+\xd5\xfc\xff\x8e;\xf30\xaf},\x0f" +
+	"\x14\xf6\x9a\x98\xa7\xb1\xcc\xd5\x0a}\x81V\xe8Ty\xb9" +
+	"\xa8\xef3Z\xc4Av\xe0c\xba\xc9W\x8d\xff\x96u" +
+	"\xdf\xdcY\x9b\xd5r\x85\xf9d\xa4\xf7\xce\xe3\x89\xdf\xbb" +
+	"\xddn\x94\x0b\xa52\xf5y\xf6\xeagGn\xf7\xec\xba" +
+	"\xd8\xf4\xf9\xdcf\x15[\xc6D\xd3\x08.\xa0\xd5\xcc\x8e" +
+	"\xe3\xb0\xdc\xde\x9b;\xd9X\xe8g\xfa{\xa6\x0a\xa8\x1c" +
+	"\xf7U\xf6D\x1e\x06\xd0V\xf3\xa8\xdd\xd7\xe2\xd7R\xcd" +
+	"\xb0\xea\xd6\xde\x0a\xa0\x81\x08\x1cb'\xf7\xd1\xa1 \x9a" +
+	"\xc1\x01\xe3^\xd4X<}\xbe\x9e\x18y\xea\xb87," +
+	"\xe9\xe0\x989_f\x1by8\xe5tU\xb0\x1b\xb6\x01" +
+	"ha\x1e\xb5\x95\x1c\xda\xb9\xc2^3o\x19E\x00\xc0" +
+	"\x08`\x86GT\xfc\xdb\x12\x90.\xce2\xc2@1'" +
+	"\x16J\x16\xbbw=$\xe9\x89\x07.\xad\xee\xcd\xfe\x9c" +
+	"\"\x8b\xe3\x81W\xce\xc2d\xe0\x91B\x921\xea\xdf|" +
+	"\xa39\x08c\xb4\xb3*)g(\xa4\x1c\xfd5\x85i" +
+	"\xe0\xbe\x03\xd0\xbd\xec\xc8\x81%\xc0\x11CD\xff\x11\x81" +
+	"\xee\x83\x85d\xa9>C\"r\xde\xf5\x8c\xee\xdb\x89\x0c" +
+	"\xd0\xbd\xf5\"\xf2\xde\xf3\x05\xdd\x9b\x9a\xd6\x94#\xdd\"" +
+	"o\x96\xd3(\xd3a\x92F\x99Z)\x8d1\xe6\x98\xbb" +
+	"\xcc\xf2\x19ni;z\x87\x9b\xb2/\xe2\xd0.Ws" +
+	"9\xc3\xc8\x1b\x80\xf9\x167\xf0\x9d\x06d\xca1\xe1\xbf" +
+	"\x03\x00\xbc%\xd2\xe2"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -1748,14 +2360,19 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x8a336ac7e2d028c1,
 			0xa17b8c469ab105e9,
 			0xab8d091f98599d27,
+			0xae86aa7d11369783,
 			0xaec21d58779cc86c,
+			0xafd55575952c8b79,
 			0xb7ddaffff14d4ea5,
 			0xb90ffa2761585171,
 			0xc105d085735711e1,
+			0xc34d4ec6839ec70a,
 			0xc718781cb2553199,
 			0xcfaebe761f647d07,
 			0xd25c03d885e9b059,
+			0xd54a4537fc328795,
 			0xdad77fd0c414d459,
+			0xe0a604aeaefacdc0,
 			0xe325af947f127758,
 			0xe41237e4098ed922,
 			0xe6d4ed829b3ab757,
@@ -10,6 +10,7 @@ import (
 	server "capnproto.org/go/capnp/v3/server"
 	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
+	math "math"
 	strconv "strconv"
 )
 
@@ -19,12 +20,12 @@ type Heartbeat capnp.Struct
 const Heartbeat_TypeID = 0xa97471079836f720
 
 func NewHeartbeat(s *capnp.Segment) (Heartbeat, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return Heartbeat(st), err
 }
 
 func NewRootHeartbeat(s *capnp.Segment) (Heartbeat, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return Heartbeat(st), err
 }
 
@@ -117,13 +118,36 @@ func (s Heartbeat) NewMeta(n int32) (capnp.TextList, error) {
 	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
 	return l, err
 }
+func (s Heartbeat) Load() (Heartbeat_Load, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return Heartbeat_Load(p.Struct()), err
+}
+
+func (s Heartbeat) HasLoad() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Heartbeat) SetLoad(v Heartbeat_Load) error {
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+}
+
+// NewLoad sets the load field to a newly
+// allocated Heartbeat_Load struct, preferring placement in s's segment.
+func (s Heartbeat) NewLoad() (Heartbeat_Load, error) {
+	ss, err := NewHeartbeat_Load(capnp.Struct(s).Segment())
+	if err != nil {
+		return Heartbeat_Load{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
 
 // Heartbeat_List is a list of Heartbeat.
 type Heartbeat_List = capnp.StructList[Heartbeat]
 
 // NewHeartbeat creates a new list of Heartbeat.
 func NewHeartbeat_List(s *capnp.Segment, sz int32) (Heartbeat_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
 	return capnp.StructList[Heartbeat](l), err
 }
 
@@ -134,6 +158,105 @@ func (f Heartbeat_Future) Struct() (Heartbeat, error) {
 	p, err := f.Future.Ptr()
 	return Heartbeat(p.Struct()), err
 }
+func (p Heartbeat_Future) Load() Heartbeat_Load_Future {
+	return Heartbeat_Load_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Heartbeat_Load capnp.Struct
+
+// Heartbeat_Load_TypeID is the unique identifier for the type Heartbeat_Load.
+const Heartbeat_Load_TypeID = 0xab0b637130859041
+
+func NewHeartbeat_Load(s *capnp.Segment) (Heartbeat_Load, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Heartbeat_Load(st), err
+}
+
+func NewRootHeartbeat_Load(s *capnp.Segment) (Heartbeat_Load, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Heartbeat_Load(st), err
+}
+
+func ReadRootHeartbeat_Load(msg *capnp.Message) (Heartbeat_Load, error) {
+	root, err := msg.Root()
+	return Heartbeat_Load(root.Struct()), err
+}
+
+func (s Heartbeat_Load) String() string {
+	str, _ := text.Marshal(0xab0b637130859041, capnp.Struct(s))
+	return str
+}
+
+func (s Heartbeat_Load) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Heartbeat_Load) DecodeFromPtr(p capnp.Ptr) Heartbeat_Load {
+	return Heartbeat_Load(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Heartbeat_Load) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Heartbeat_Load) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Heartbeat_Load) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Heartbeat_Load) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Heartbeat_Load) Procs() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Heartbeat_Load) SetProcs(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Heartbeat_Load) Memory() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Heartbeat_Load) SetMemory(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s Heartbeat_Load) Cpu() float32 {
+	return math.Float32frombits(capnp.Struct(s).Uint32(4))
+}
+
+func (s Heartbeat_Load) SetCpu(v float32) {
+	capnp.Struct(s).SetUint32(4, math.Float32bits(v))
+}
+
+func (s Heartbeat_Load) Slots() uint32 {
+	return capnp.Struct(s).Uint32(16)
+}
+
+func (s Heartbeat_Load) SetSlots(v uint32) {
+	capnp.Struct(s).SetUint32(16, v)
+}
+
+// Heartbeat_Load_List is a list of Heartbeat_Load.
+type Heartbeat_Load_List = capnp.StructList[Heartbeat_Load]
+
+// NewHeartbeat_Load creates a new list of Heartbeat_Load.
+func NewHeartbeat_Load_List(s *capnp.Segment, sz int32) (Heartbeat_Load_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Heartbeat_Load](l), err
+}
+
+// Heartbeat_Load_Future is a wrapper for a Heartbeat_Load promised by a client call.
+type Heartbeat_Load_Future struct{ *capnp.Future }
+
+func (f Heartbeat_Load_Future) Struct() (Heartbeat_Load, error) {
+	p, err := f.Future.Ptr()
+	return Heartbeat_Load(p.Struct()), err
+}
 
 type View capnp.Client
 
@@ -200,6 +323,26 @@ func (c View) Reverse(ctx context.Context, params func(View_reverse_Params) erro
 
 }
 
+func (c View) Watch(ctx context.Context, params func(View_watch_Params) error) (View_watch_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      3,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "watch",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_watch_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return View_watch_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c View) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -278,6 +421,8 @@ type View_Server interface {
 	Iter(context.Context, View_iter) error
 
 	Reverse(context.Context, View_reverse) error
+
+	Watch(context.Context, View_watch) error
 }
 
 // View_NewServer creates a new Server from an implementation of View_Server.
@@ -296,7 +441,7 @@ func View_ServerToClient(s View_Server) View {
 // This can be used to create a more complicated Server.
 func View_Methods(methods []server.Method, s View_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 3)
+		methods = make([]server.Method, 0, 4)
 	}
 
 	methods = append(methods, server.Method{
@@ -335,6 +480,18 @@ func View_Methods(methods []server.Method, s View_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      3,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "watch",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Watch(ctx, View_watch{call})
+		},
+	})
+
 	return methods
 }
 
@@ -389,6 +546,23 @@ func (c View_reverse) AllocResults() (View_reverse_Results, error) {
 	return View_reverse_Results(r), err
 }
 
+// View_watch holds the state for a server call to View.watch.
+// See server.Call for documentation.
+type View_watch struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_watch) Args() View_watch_Params {
+	return View_watch_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_watch) AllocResults() (View_watch_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_watch_Results(r), err
+}
+
 // View_List is a list of View.
 type View_List = capnp.CapList[View]
 
@@ -648,1404 +822,2864 @@ func (p View_Handler_recv_Params_Future) Record() View_Record_Future {
 	return View_Record_Future{Future: p.Future.Field(0, nil)}
 }
 
-type View_Selector capnp.Struct
-type View_Selector_Which uint16
-
-const (
-	View_Selector_Which_all   View_Selector_Which = 0
-	View_Selector_Which_match View_Selector_Which = 1
-	View_Selector_Which_from  View_Selector_Which = 2
-)
+type View_EventHandler capnp.Client
 
-func (w View_Selector_Which) String() string {
-	const s = "allmatchfrom"
-	switch w {
-	case View_Selector_Which_all:
-		return s[0:3]
-	case View_Selector_Which_match:
-		return s[3:8]
-	case View_Selector_Which_from:
-		return s[8:12]
+// View_EventHandler_TypeID is the unique identifier for the type View_EventHandler.
+const View_EventHandler_TypeID = 0x80c0e4fe6a3677dd
 
+func (c View_EventHandler) Recv(ctx context.Context, params func(View_EventHandler_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x80c0e4fe6a3677dd,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View.EventHandler",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_EventHandler_recv_Params(s)) }
 	}
-	return "View_Selector_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
 
-// View_Selector_TypeID is the unique identifier for the type View_Selector.
-const View_Selector_TypeID = 0xb2029ff7b712d18a
+	return capnp.Client(c).SendStreamCall(ctx, s)
 
-func NewView_Selector(s *capnp.Segment) (View_Selector, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_Selector(st), err
 }
 
-func NewRootView_Selector(s *capnp.Segment) (View_Selector, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_Selector(st), err
+func (c View_EventHandler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
 }
 
-func ReadRootView_Selector(msg *capnp.Message) (View_Selector, error) {
-	root, err := msg.Root()
-	return View_Selector(root.Struct()), err
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c View_EventHandler) String() string {
+	return "View_EventHandler(" + capnp.Client(c).String() + ")"
 }
 
-func (s View_Selector) String() string {
-	str, _ := text.Marshal(0xb2029ff7b712d18a, capnp.Struct(s))
-	return str
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c View_EventHandler) AddRef() View_EventHandler {
+	return View_EventHandler(capnp.Client(c).AddRef())
 }
 
-func (s View_Selector) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c View_EventHandler) Release() {
+	capnp.Client(c).Release()
 }
 
-func (View_Selector) DecodeFromPtr(p capnp.Ptr) View_Selector {
-	return View_Selector(capnp.Struct{}.DecodeFromPtr(p))
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c View_EventHandler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-func (s View_Selector) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (c View_EventHandler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (s View_Selector) Which() View_Selector_Which {
-	return View_Selector_Which(capnp.Struct(s).Uint16(0))
-}
-func (s View_Selector) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (View_EventHandler) DecodeFromPtr(p capnp.Ptr) View_EventHandler {
+	return View_EventHandler(capnp.Client{}.DecodeFromPtr(p))
 }
 
-func (s View_Selector) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c View_EventHandler) IsValid() bool {
+	return capnp.Client(c).IsValid()
 }
 
-func (s View_Selector) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c View_EventHandler) IsSame(other View_EventHandler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
-func (s View_Selector) SetAll() {
-	capnp.Struct(s).SetUint16(0, 0)
 
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c View_EventHandler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-func (s View_Selector) Match() (View_Index, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != match")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return View_Index(p.Struct()), err
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c View_EventHandler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
 
-func (s View_Selector) HasMatch() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// A View_EventHandler_Server is a View_EventHandler with a local implementation.
+type View_EventHandler_Server interface {
+	Recv(context.Context, View_EventHandler_recv) error
 }
 
-func (s View_Selector) SetMatch(v View_Index) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// View_EventHandler_NewServer creates a new Server from an implementation of View_EventHandler_Server.
+func View_EventHandler_NewServer(s View_EventHandler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(View_EventHandler_Methods(nil, s), s, c)
 }
 
-// NewMatch sets the match field to a newly
-// allocated View_Index struct, preferring placement in s's segment.
-func (s View_Selector) NewMatch() (View_Index, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewView_Index(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_Index{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// View_EventHandler_ServerToClient creates a new Client from an implementation of View_EventHandler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func View_EventHandler_ServerToClient(s View_EventHandler_Server) View_EventHandler {
+	return View_EventHandler(capnp.NewClient(View_EventHandler_NewServer(s)))
 }
 
-func (s View_Selector) From() (View_Index, error) {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != from")
+// View_EventHandler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func View_EventHandler_Methods(methods []server.Method, s View_EventHandler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return View_Index(p.Struct()), err
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x80c0e4fe6a3677dd,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View.EventHandler",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, View_EventHandler_recv{call})
+		},
+	})
+
+	return methods
 }
 
-func (s View_Selector) HasFrom() bool {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// View_EventHandler_recv holds the state for a server call to View_EventHandler.recv.
+// See server.Call for documentation.
+type View_EventHandler_recv struct {
+	*server.Call
 }
 
-func (s View_Selector) SetFrom(v View_Index) error {
-	capnp.Struct(s).SetUint16(0, 2)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// Args returns the call's arguments.
+func (c View_EventHandler_recv) Args() View_EventHandler_recv_Params {
+	return View_EventHandler_recv_Params(c.Call.Args())
 }
 
-// NewFrom sets the from field to a newly
-// allocated View_Index struct, preferring placement in s's segment.
-func (s View_Selector) NewFrom() (View_Index, error) {
-	capnp.Struct(s).SetUint16(0, 2)
-	ss, err := NewView_Index(capnp.Struct(s).Segment())
+// AllocResults allocates the results struct.
+func (c View_EventHandler_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// View_EventHandler_List is a list of View_EventHandler.
+type View_EventHandler_List = capnp.CapList[View_EventHandler]
+
+// NewView_EventHandler creates a new list of View_EventHandler.
+func NewView_EventHandler_List(s *capnp.Segment, sz int32) (View_EventHandler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[View_EventHandler](l), err
+}
+
+type View_EventHandler_recv_Params capnp.Struct
+
+// View_EventHandler_recv_Params_TypeID is the unique identifier for the type View_EventHandler_recv_Params.
+const View_EventHandler_recv_Params_TypeID = 0xda088b4a1f4c577d
+
+func NewView_EventHandler_recv_Params(s *capnp.Segment) (View_EventHandler_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_EventHandler_recv_Params(st), err
+}
+
+func NewRootView_EventHandler_recv_Params(s *capnp.Segment) (View_EventHandler_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_EventHandler_recv_Params(st), err
+}
+
+func ReadRootView_EventHandler_recv_Params(msg *capnp.Message) (View_EventHandler_recv_Params, error) {
+	root, err := msg.Root()
+	return View_EventHandler_recv_Params(root.Struct()), err
+}
+
+func (s View_EventHandler_recv_Params) String() string {
+	str, _ := text.Marshal(0xda088b4a1f4c577d, capnp.Struct(s))
+	return str
+}
+
+func (s View_EventHandler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_EventHandler_recv_Params) DecodeFromPtr(p capnp.Ptr) View_EventHandler_recv_Params {
+	return View_EventHandler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_EventHandler_recv_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_EventHandler_recv_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_EventHandler_recv_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_EventHandler_recv_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_EventHandler_recv_Params) Event() (View_Event, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Event(p.Struct()), err
+}
+
+func (s View_EventHandler_recv_Params) HasEvent() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_EventHandler_recv_Params) SetEvent(v View_Event) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated View_Event struct, preferring placement in s's segment.
+func (s View_EventHandler_recv_Params) NewEvent() (View_Event, error) {
+	ss, err := NewView_Event(capnp.Struct(s).Segment())
 	if err != nil {
-		return View_Index{}, err
+		return View_Event{}, err
 	}
 	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
 	return ss, err
 }
 
-// View_Selector_List is a list of View_Selector.
-type View_Selector_List = capnp.StructList[View_Selector]
+// View_EventHandler_recv_Params_List is a list of View_EventHandler_recv_Params.
+type View_EventHandler_recv_Params_List = capnp.StructList[View_EventHandler_recv_Params]
 
-// NewView_Selector creates a new list of View_Selector.
-func NewView_Selector_List(s *capnp.Segment, sz int32) (View_Selector_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[View_Selector](l), err
+// NewView_EventHandler_recv_Params creates a new list of View_EventHandler_recv_Params.
+func NewView_EventHandler_recv_Params_List(s *capnp.Segment, sz int32) (View_EventHandler_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[View_EventHandler_recv_Params](l), err
 }
 
-// View_Selector_Future is a wrapper for a View_Selector promised by a client call.
-type View_Selector_Future struct{ *capnp.Future }
+// View_EventHandler_recv_Params_Future is a wrapper for a View_EventHandler_recv_Params promised by a client call.
+type View_EventHandler_recv_Params_Future struct{ *capnp.Future }
 
-func (f View_Selector_Future) Struct() (View_Selector, error) {
+func (f View_EventHandler_recv_Params_Future) Struct() (View_EventHandler_recv_Params, error) {
 	p, err := f.Future.Ptr()
-	return View_Selector(p.Struct()), err
+	return View_EventHandler_recv_Params(p.Struct()), err
 }
-func (p View_Selector_Future) Match() View_Index_Future {
-	return View_Index_Future{Future: p.Future.Field(0, nil)}
-}
-func (p View_Selector_Future) From() View_Index_Future {
-	return View_Index_Future{Future: p.Future.Field(0, nil)}
+func (p View_EventHandler_recv_Params_Future) Event() View_Event_Future {
+	return View_Event_Future{Future: p.Future.Field(0, nil)}
 }
 
-type View_Constraint capnp.Struct
-type View_Constraint_Which uint16
+type View_Event capnp.Struct
+type View_Event_Which uint16
 
 const (
-	View_Constraint_Which_limit View_Constraint_Which = 0
-	View_Constraint_Which_to    View_Constraint_Which = 1
+	View_Event_Which_join   View_Event_Which = 0
+	View_Event_Which_update View_Event_Which = 1
+	View_Event_Which_leave  View_Event_Which = 2
 )
 
-func (w View_Constraint_Which) String() string {
-	const s = "limitto"
+func (w View_Event_Which) String() string {
+	const s = "joinupdateleave"
 	switch w {
-	case View_Constraint_Which_limit:
-		return s[0:5]
-	case View_Constraint_Which_to:
-		return s[5:7]
+	case View_Event_Which_join:
+		return s[0:4]
+	case View_Event_Which_update:
+		return s[4:10]
+	case View_Event_Which_leave:
+		return s[10:15]
 
 	}
-	return "View_Constraint_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "View_Event_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// View_Constraint_TypeID is the unique identifier for the type View_Constraint.
-const View_Constraint_TypeID = 0xab133d2062f6cc53
+// View_Event_TypeID is the unique identifier for the type View_Event.
+const View_Event_TypeID = 0xa94e26d7a3b4d37d
 
-func NewView_Constraint(s *capnp.Segment) (View_Constraint, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return View_Constraint(st), err
+func NewView_Event(s *capnp.Segment) (View_Event, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Event(st), err
 }
 
-func NewRootView_Constraint(s *capnp.Segment) (View_Constraint, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return View_Constraint(st), err
+func NewRootView_Event(s *capnp.Segment) (View_Event, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Event(st), err
 }
 
-func ReadRootView_Constraint(msg *capnp.Message) (View_Constraint, error) {
+func ReadRootView_Event(msg *capnp.Message) (View_Event, error) {
 	root, err := msg.Root()
-	return View_Constraint(root.Struct()), err
+	return View_Event(root.Struct()), err
 }
 
-func (s View_Constraint) String() string {
-	str, _ := text.Marshal(0xab133d2062f6cc53, capnp.Struct(s))
+func (s View_Event) String() string {
+	str, _ := text.Marshal(0xa94e26d7a3b4d37d, capnp.Struct(s))
 	return str
 }
 
-func (s View_Constraint) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_Event) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_Constraint) DecodeFromPtr(p capnp.Ptr) View_Constraint {
-	return View_Constraint(capnp.Struct{}.DecodeFromPtr(p))
+func (View_Event) DecodeFromPtr(p capnp.Ptr) View_Event {
+	return View_Event(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_Constraint) ToPtr() capnp.Ptr {
+func (s View_Event) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s View_Constraint) Which() View_Constraint_Which {
-	return View_Constraint_Which(capnp.Struct(s).Uint16(8))
+func (s View_Event) Which() View_Event_Which {
+	return View_Event_Which(capnp.Struct(s).Uint16(0))
 }
-func (s View_Constraint) IsValid() bool {
+func (s View_Event) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_Constraint) Message() *capnp.Message {
+func (s View_Event) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_Constraint) Segment() *capnp.Segment {
+func (s View_Event) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_Constraint) Limit() uint64 {
-	if capnp.Struct(s).Uint16(8) != 0 {
-		panic("Which() != limit")
-	}
-	return capnp.Struct(s).Uint64(0)
-}
-
-func (s View_Constraint) SetLimit(v uint64) {
-	capnp.Struct(s).SetUint16(8, 0)
-	capnp.Struct(s).SetUint64(0, v)
-}
-
-func (s View_Constraint) To() (View_Index, error) {
-	if capnp.Struct(s).Uint16(8) != 1 {
-		panic("Which() != to")
-	}
+func (s View_Event) Record() (View_Record, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return View_Index(p.Struct()), err
+	return View_Record(p.Struct()), err
 }
 
-func (s View_Constraint) HasTo() bool {
-	if capnp.Struct(s).Uint16(8) != 1 {
-		return false
-	}
+func (s View_Event) HasRecord() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Constraint) SetTo(v View_Index) error {
-	capnp.Struct(s).SetUint16(8, 1)
+func (s View_Event) SetRecord(v View_Record) error {
 	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-// NewTo sets the to field to a newly
-// allocated View_Index struct, preferring placement in s's segment.
-func (s View_Constraint) NewTo() (View_Index, error) {
-	capnp.Struct(s).SetUint16(8, 1)
-	ss, err := NewView_Index(capnp.Struct(s).Segment())
+// NewRecord sets the record field to a newly
+// allocated View_Record struct, preferring placement in s's segment.
+func (s View_Event) NewRecord() (View_Record, error) {
+	ss, err := NewView_Record(capnp.Struct(s).Segment())
 	if err != nil {
-		return View_Index{}, err
+		return View_Record{}, err
 	}
 	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
 	return ss, err
 }
 
-// View_Constraint_List is a list of View_Constraint.
-type View_Constraint_List = capnp.StructList[View_Constraint]
+func (s View_Event) SetJoin() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-// NewView_Constraint creates a new list of View_Constraint.
-func NewView_Constraint_List(s *capnp.Segment, sz int32) (View_Constraint_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
-	return capnp.StructList[View_Constraint](l), err
 }
 
-// View_Constraint_Future is a wrapper for a View_Constraint promised by a client call.
-type View_Constraint_Future struct{ *capnp.Future }
+func (s View_Event) SetUpdate() {
+	capnp.Struct(s).SetUint16(0, 1)
 
-func (f View_Constraint_Future) Struct() (View_Constraint, error) {
+}
+
+func (s View_Event) SetLeave() {
+	capnp.Struct(s).SetUint16(0, 2)
+
+}
+
+// View_Event_List is a list of View_Event.
+type View_Event_List = capnp.StructList[View_Event]
+
+// NewView_Event creates a new list of View_Event.
+func NewView_Event_List(s *capnp.Segment, sz int32) (View_Event_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[View_Event](l), err
+}
+
+// View_Event_Future is a wrapper for a View_Event promised by a client call.
+type View_Event_Future struct{ *capnp.Future }
+
+func (f View_Event_Future) Struct() (View_Event, error) {
 	p, err := f.Future.Ptr()
-	return View_Constraint(p.Struct()), err
+	return View_Event(p.Struct()), err
 }
-func (p View_Constraint_Future) To() View_Index_Future {
-	return View_Index_Future{Future: p.Future.Field(0, nil)}
+func (p View_Event_Future) Record() View_Record_Future {
+	return View_Record_Future{Future: p.Future.Field(0, nil)}
 }
 
-type View_Index capnp.Struct
-type View_Index_Which uint16
+type View_Selector capnp.Struct
+type View_Selector_Which uint16
 
 const (
-	View_Index_Which_peer   View_Index_Which = 0
-	View_Index_Which_server View_Index_Which = 1
-	View_Index_Which_host   View_Index_Which = 2
-	View_Index_Which_meta   View_Index_Which = 3
+	View_Selector_Which_all   View_Selector_Which = 0
+	View_Selector_Which_match View_Selector_Which = 1
+	View_Selector_Which_from  View_Selector_Which = 2
 )
 
-func (w View_Index_Which) String() string {
-	const s = "peerserverhostmeta"
+func (w View_Selector_Which) String() string {
+	const s = "allmatchfrom"
 	switch w {
-	case View_Index_Which_peer:
-		return s[0:4]
-	case View_Index_Which_server:
-		return s[4:10]
-	case View_Index_Which_host:
-		return s[10:14]
-	case View_Index_Which_meta:
-		return s[14:18]
+	case View_Selector_Which_all:
+		return s[0:3]
+	case View_Selector_Which_match:
+		return s[3:8]
+	case View_Selector_Which_from:
+		return s[8:12]
 
 	}
-	return "View_Index_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "View_Selector_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// View_Index_TypeID is the unique identifier for the type View_Index.
-const View_Index_TypeID = 0xcc2d04cc26d4f6a5
+// View_Selector_TypeID is the unique identifier for the type View_Selector.
+const View_Selector_TypeID = 0xb2029ff7b712d18a
 
-func NewView_Index(s *capnp.Segment) (View_Index, error) {
+func NewView_Selector(s *capnp.Segment) (View_Selector, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_Index(st), err
+	return View_Selector(st), err
 }
 
-func NewRootView_Index(s *capnp.Segment) (View_Index, error) {
+func NewRootView_Selector(s *capnp.Segment) (View_Selector, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_Index(st), err
+	return View_Selector(st), err
 }
 
-func ReadRootView_Index(msg *capnp.Message) (View_Index, error) {
+func ReadRootView_Selector(msg *capnp.Message) (View_Selector, error) {
 	root, err := msg.Root()
-	return View_Index(root.Struct()), err
+	return View_Selector(root.Struct()), err
 }
 
-func (s View_Index) String() string {
-	str, _ := text.Marshal(0xcc2d04cc26d4f6a5, capnp.Struct(s))
+func (s View_Selector) String() string {
+	str, _ := text.Marshal(0xb2029ff7b712d18a, capnp.Struct(s))
 	return str
 }
 
-func (s View_Index) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_Selector) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_Index) DecodeFromPtr(p capnp.Ptr) View_Index {
-	return View_Index(capnp.Struct{}.DecodeFromPtr(p))
+func (View_Selector) DecodeFromPtr(p capnp.Ptr) View_Selector {
+	return View_Selector(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_Index) ToPtr() capnp.Ptr {
+func (s View_Selector) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s View_Index) Which() View_Index_Which {
-	return View_Index_Which(capnp.Struct(s).Uint16(2))
+func (s View_Selector) Which() View_Selector_Which {
+	return View_Selector_Which(capnp.Struct(s).Uint16(0))
 }
-func (s View_Index) IsValid() bool {
+func (s View_Selector) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_Index) Message() *capnp.Message {
+func (s View_Selector) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_Index) Segment() *capnp.Segment {
+func (s View_Selector) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_Index) Prefix() bool {
-	return capnp.Struct(s).Bit(0)
-}
+func (s View_Selector) SetAll() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-func (s View_Index) SetPrefix(v bool) {
-	capnp.Struct(s).SetBit(0, v)
 }
 
-func (s View_Index) Peer() (string, error) {
-	if capnp.Struct(s).Uint16(2) != 0 {
-		panic("Which() != peer")
+func (s View_Selector) Match() (View_Index, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != match")
 	}
 	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return View_Index(p.Struct()), err
 }
 
-func (s View_Index) HasPeer() bool {
-	if capnp.Struct(s).Uint16(2) != 0 {
+func (s View_Selector) HasMatch() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Index) PeerBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s View_Index) SetPeer(v string) error {
-	capnp.Struct(s).SetUint16(2, 0)
-	return capnp.Struct(s).SetText(0, v)
+func (s View_Selector) SetMatch(v View_Index) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-func (s View_Index) Server() ([]byte, error) {
-	if capnp.Struct(s).Uint16(2) != 1 {
-		panic("Which() != server")
+// NewMatch sets the match field to a newly
+// allocated View_Index struct, preferring placement in s's segment.
+func (s View_Selector) NewMatch() (View_Index, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	ss, err := NewView_Index(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Index{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_Selector) From() (View_Index, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != from")
 	}
 	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+	return View_Index(p.Struct()), err
 }
 
-func (s View_Index) HasServer() bool {
-	if capnp.Struct(s).Uint16(2) != 1 {
+func (s View_Selector) HasFrom() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Index) SetServer(v []byte) error {
-	capnp.Struct(s).SetUint16(2, 1)
-	return capnp.Struct(s).SetData(0, v)
+func (s View_Selector) SetFrom(v View_Index) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-func (s View_Index) Host() (string, error) {
-	if capnp.Struct(s).Uint16(2) != 2 {
-		panic("Which() != host")
+// NewFrom sets the from field to a newly
+// allocated View_Index struct, preferring placement in s's segment.
+func (s View_Selector) NewFrom() (View_Index, error) {
+	capnp.Struct(s).SetUint16(0, 2)
+	ss, err := NewView_Index(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Index{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Selector_List is a list of View_Selector.
+type View_Selector_List = capnp.StructList[View_Selector]
+
+// NewView_Selector creates a new list of View_Selector.
+func NewView_Selector_List(s *capnp.Segment, sz int32) (View_Selector_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[View_Selector](l), err
+}
+
+// View_Selector_Future is a wrapper for a View_Selector promised by a client call.
+type View_Selector_Future struct{ *capnp.Future }
+
+func (f View_Selector_Future) Struct() (View_Selector, error) {
+	p, err := f.Future.Ptr()
+	return View_Selector(p.Struct()), err
+}
+func (p View_Selector_Future) Match() View_Index_Future {
+	return View_Index_Future{Future: p.Future.Field(0, nil)}
+}
+func (p View_Selector_Future) From() View_Index_Future {
+	return View_Index_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_Constraint capnp.Struct
+type View_Constraint_Which uint16
+
+const (
+	View_Constraint_Which_limit View_Constraint_Which = 0
+	View_Constraint_Which_to    View_Constraint_Which = 1
+	View_Constraint_Which_where View_Constraint_Which = 2
+)
+
+func (w View_Constraint_Which) String() string {
+	const s = "limittowhere"
+	switch w {
+	case View_Constraint_Which_limit:
+		return s[0:5]
+	case View_Constraint_Which_to:
+		return s[5:7]
+	case View_Constraint_Which_where:
+		return s[7:12]
+
+	}
+	return "View_Constraint_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// View_Constraint_TypeID is the unique identifier for the type View_Constraint.
+const View_Constraint_TypeID = 0xab133d2062f6cc53
+
+func NewView_Constraint(s *capnp.Segment) (View_Constraint, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Constraint(st), err
+}
+
+func NewRootView_Constraint(s *capnp.Segment) (View_Constraint, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Constraint(st), err
+}
+
+func ReadRootView_Constraint(msg *capnp.Message) (View_Constraint, error) {
+	root, err := msg.Root()
+	return View_Constraint(root.Struct()), err
+}
+
+func (s View_Constraint) String() string {
+	str, _ := text.Marshal(0xab133d2062f6cc53, capnp.Struct(s))
+	return str
+}
+
+func (s View_Constraint) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Constraint) DecodeFromPtr(p capnp.Ptr) View_Constraint {
+	return View_Constraint(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Constraint) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s View_Constraint) Which() View_Constraint_Which {
+	return View_Constraint_Which(capnp.Struct(s).Uint16(8))
+}
+func (s View_Constraint) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Constraint) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Constraint) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Constraint) Limit() uint64 {
+	if capnp.Struct(s).Uint16(8) != 0 {
+		panic("Which() != limit")
+	}
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s View_Constraint) SetLimit(v uint64) {
+	capnp.Struct(s).SetUint16(8, 0)
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+func (s View_Constraint) To() (View_Index, error) {
+	if capnp.Struct(s).Uint16(8) != 1 {
+		panic("Which() != to")
 	}
 	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return View_Index(p.Struct()), err
 }
 
-func (s View_Index) HasHost() bool {
-	if capnp.Struct(s).Uint16(2) != 2 {
+func (s View_Constraint) HasTo() bool {
+	if capnp.Struct(s).Uint16(8) != 1 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Index) HostBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s View_Constraint) SetTo(v View_Index) error {
+	capnp.Struct(s).SetUint16(8, 1)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-func (s View_Index) SetHost(v string) error {
-	capnp.Struct(s).SetUint16(2, 2)
-	return capnp.Struct(s).SetText(0, v)
+// NewTo sets the to field to a newly
+// allocated View_Index struct, preferring placement in s's segment.
+func (s View_Constraint) NewTo() (View_Index, error) {
+	capnp.Struct(s).SetUint16(8, 1)
+	ss, err := NewView_Index(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Index{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
 }
 
-func (s View_Index) Meta() (string, error) {
-	if capnp.Struct(s).Uint16(2) != 3 {
-		panic("Which() != meta")
+func (s View_Constraint) Where() (View_Predicate, error) {
+	if capnp.Struct(s).Uint16(8) != 2 {
+		panic("Which() != where")
 	}
 	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return View_Predicate(p.Struct()), err
 }
 
-func (s View_Index) HasMeta() bool {
-	if capnp.Struct(s).Uint16(2) != 3 {
+func (s View_Constraint) HasWhere() bool {
+	if capnp.Struct(s).Uint16(8) != 2 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Index) MetaBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s View_Constraint) SetWhere(v View_Predicate) error {
+	capnp.Struct(s).SetUint16(8, 2)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-func (s View_Index) SetMeta(v string) error {
-	capnp.Struct(s).SetUint16(2, 3)
-	return capnp.Struct(s).SetText(0, v)
+// NewWhere sets the where field to a newly
+// allocated View_Predicate struct, preferring placement in s's segment.
+func (s View_Constraint) NewWhere() (View_Predicate, error) {
+	capnp.Struct(s).SetUint16(8, 2)
+	ss, err := NewView_Predicate(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Predicate{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
 }
 
-// View_Index_List is a list of View_Index.
-type View_Index_List = capnp.StructList[View_Index]
+// View_Constraint_List is a list of View_Constraint.
+type View_Constraint_List = capnp.StructList[View_Constraint]
 
-// NewView_Index creates a new list of View_Index.
-func NewView_Index_List(s *capnp.Segment, sz int32) (View_Index_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[View_Index](l), err
+// NewView_Constraint creates a new list of View_Constraint.
+func NewView_Constraint_List(s *capnp.Segment, sz int32) (View_Constraint_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[View_Constraint](l), err
 }
 
-// View_Index_Future is a wrapper for a View_Index promised by a client call.
-type View_Index_Future struct{ *capnp.Future }
+// View_Constraint_Future is a wrapper for a View_Constraint promised by a client call.
+type View_Constraint_Future struct{ *capnp.Future }
 
-func (f View_Index_Future) Struct() (View_Index, error) {
+func (f View_Constraint_Future) Struct() (View_Constraint, error) {
 	p, err := f.Future.Ptr()
-	return View_Index(p.Struct()), err
+	return View_Constraint(p.Struct()), err
+}
+func (p View_Constraint_Future) To() View_Index_Future {
+	return View_Index_Future{Future: p.Future.Field(0, nil)}
+}
+func (p View_Constraint_Future) Where() View_Predicate_Future {
+	return View_Predicate_Future{Future: p.Future.Field(0, nil)}
 }
 
-type View_Record capnp.Struct
+type View_Predicate capnp.Struct
+type View_Predicate_Which uint16
 
-// View_Record_TypeID is the unique identifier for the type View_Record.
-const View_Record_TypeID = 0xcdcf42beb2537d20
+const (
+	View_Predicate_Which_exists View_Predicate_Which = 0
+	View_Predicate_Which_eq     View_Predicate_Which = 1
+	View_Predicate_Which_prefix View_Predicate_Which = 2
+	View_Predicate_Which_lt     View_Predicate_Which = 3
+	View_Predicate_Which_lte    View_Predicate_Which = 4
+	View_Predicate_Which_gt     View_Predicate_Which = 5
+	View_Predicate_Which_gte    View_Predicate_Which = 6
+)
 
-func NewView_Record(s *capnp.Segment) (View_Record, error) {
+func (w View_Predicate_Which) String() string {
+	const s = "existseqprefixltltegtgte"
+	switch w {
+	case View_Predicate_Which_exists:
+		return s[0:6]
+	case View_Predicate_Which_eq:
+		return s[6:8]
+	case View_Predicate_Which_prefix:
+		return s[8:14]
+	case View_Predicate_Which_lt:
+		return s[14:16]
+	case View_Predicate_Which_lte:
+		return s[16:19]
+	case View_Predicate_Which_gt:
+		return s[19:21]
+	case View_Predicate_Which_gte:
+		return s[21:24]
+
+	}
+	return "View_Predicate_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// View_Predicate_TypeID is the unique identifier for the type View_Predicate.
+const View_Predicate_TypeID = 0x926dd174ab4af74c
+
+func NewView_Predicate(s *capnp.Segment) (View_Predicate, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return View_Record(st), err
+	return View_Predicate(st), err
 }
 
-func NewRootView_Record(s *capnp.Segment) (View_Record, error) {
+func NewRootView_Predicate(s *capnp.Segment) (View_Predicate, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return View_Record(st), err
+	return View_Predicate(st), err
 }
 
-func ReadRootView_Record(msg *capnp.Message) (View_Record, error) {
+func ReadRootView_Predicate(msg *capnp.Message) (View_Predicate, error) {
 	root, err := msg.Root()
-	return View_Record(root.Struct()), err
+	return View_Predicate(root.Struct()), err
 }
 
-func (s View_Record) String() string {
-	str, _ := text.Marshal(0xcdcf42beb2537d20, capnp.Struct(s))
+func (s View_Predicate) String() string {
+	str, _ := text.Marshal(0x926dd174ab4af74c, capnp.Struct(s))
 	return str
 }
 
-func (s View_Record) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_Predicate) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_Record) DecodeFromPtr(p capnp.Ptr) View_Record {
-	return View_Record(capnp.Struct{}.DecodeFromPtr(p))
+func (View_Predicate) DecodeFromPtr(p capnp.Ptr) View_Predicate {
+	return View_Predicate(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_Record) ToPtr() capnp.Ptr {
+func (s View_Predicate) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_Record) IsValid() bool {
+
+func (s View_Predicate) Which() View_Predicate_Which {
+	return View_Predicate_Which(capnp.Struct(s).Uint16(0))
+}
+func (s View_Predicate) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_Record) Message() *capnp.Message {
+func (s View_Predicate) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_Record) Segment() *capnp.Segment {
+func (s View_Predicate) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_Record) Peer() (string, error) {
+func (s View_Predicate) Key() (string, error) {
 	p, err := capnp.Struct(s).Ptr(0)
 	return p.Text(), err
 }
 
-func (s View_Record) HasPeer() bool {
+func (s View_Predicate) HasKey() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Record) PeerBytes() ([]byte, error) {
+func (s View_Predicate) KeyBytes() ([]byte, error) {
 	p, err := capnp.Struct(s).Ptr(0)
 	return p.TextBytes(), err
 }
 
-func (s View_Record) SetPeer(v string) error {
+func (s View_Predicate) SetKey(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s View_Record) Server() uint64 {
-	return capnp.Struct(s).Uint64(0)
+func (s View_Predicate) SetExists() {
+	capnp.Struct(s).SetUint16(0, 0)
+
 }
 
-func (s View_Record) SetServer(v uint64) {
-	capnp.Struct(s).SetUint64(0, v)
+func (s View_Predicate) Eq() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != eq")
+	}
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
 }
 
-func (s View_Record) Seq() uint64 {
-	return capnp.Struct(s).Uint64(8)
+func (s View_Predicate) HasEq() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s View_Record) SetSeq(v uint64) {
-	capnp.Struct(s).SetUint64(8, v)
+func (s View_Predicate) EqBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
 }
 
-func (s View_Record) Heartbeat() (Heartbeat, error) {
+func (s View_Predicate) SetEq(v string) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s View_Predicate) Prefix() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != prefix")
+	}
 	p, err := capnp.Struct(s).Ptr(1)
-	return Heartbeat(p.Struct()), err
+	return p.Text(), err
 }
 
-func (s View_Record) HasHeartbeat() bool {
+func (s View_Predicate) HasPrefix() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		return false
+	}
 	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s View_Record) SetHeartbeat(v Heartbeat) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+func (s View_Predicate) PrefixBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
 }
 
-// NewHeartbeat sets the heartbeat field to a newly
-// allocated Heartbeat struct, preferring placement in s's segment.
-func (s View_Record) NewHeartbeat() (Heartbeat, error) {
-	ss, err := NewHeartbeat(capnp.Struct(s).Segment())
-	if err != nil {
-		return Heartbeat{}, err
+func (s View_Predicate) SetPrefix(v string) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s View_Predicate) Lt() float64 {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != lt")
 	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
 }
 
-// View_Record_List is a list of View_Record.
-type View_Record_List = capnp.StructList[View_Record]
+func (s View_Predicate) SetLt(v float64) {
+	capnp.Struct(s).SetUint16(0, 3)
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
 
-// NewView_Record creates a new list of View_Record.
-func NewView_Record_List(s *capnp.Segment, sz int32) (View_Record_List, error) {
+func (s View_Predicate) Lte() float64 {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		panic("Which() != lte")
+	}
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
+
+func (s View_Predicate) SetLte(v float64) {
+	capnp.Struct(s).SetUint16(0, 4)
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+func (s View_Predicate) Gt() float64 {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		panic("Which() != gt")
+	}
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
+
+func (s View_Predicate) SetGt(v float64) {
+	capnp.Struct(s).SetUint16(0, 5)
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+func (s View_Predicate) Gte() float64 {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		panic("Which() != gte")
+	}
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
+
+func (s View_Predicate) SetGte(v float64) {
+	capnp.Struct(s).SetUint16(0, 6)
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+// View_Predicate_List is a list of View_Predicate.
+type View_Predicate_List = capnp.StructList[View_Predicate]
+
+// NewView_Predicate creates a new list of View_Predicate.
+func NewView_Predicate_List(s *capnp.Segment, sz int32) (View_Predicate_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
-	return capnp.StructList[View_Record](l), err
+	return capnp.StructList[View_Predicate](l), err
 }
 
-// View_Record_Future is a wrapper for a View_Record promised by a client call.
-type View_Record_Future struct{ *capnp.Future }
+// View_Predicate_Future is a wrapper for a View_Predicate promised by a client call.
+type View_Predicate_Future struct{ *capnp.Future }
 
-func (f View_Record_Future) Struct() (View_Record, error) {
+func (f View_Predicate_Future) Struct() (View_Predicate, error) {
 	p, err := f.Future.Ptr()
-	return View_Record(p.Struct()), err
-}
-func (p View_Record_Future) Heartbeat() Heartbeat_Future {
-	return Heartbeat_Future{Future: p.Future.Field(1, nil)}
+	return View_Predicate(p.Struct()), err
 }
 
-type View_MaybeRecord capnp.Struct
-type View_MaybeRecord_Which uint16
+type View_Index capnp.Struct
+type View_Index_Which uint16
 
 const (
-	View_MaybeRecord_Which_nothing View_MaybeRecord_Which = 0
-	View_MaybeRecord_Which_just    View_MaybeRecord_Which = 1
+	View_Index_Which_peer   View_Index_Which = 0
+	View_Index_Which_server View_Index_Which = 1
+	View_Index_Which_host   View_Index_Which = 2
+	View_Index_Which_meta   View_Index_Which = 3
+	View_Index_Which_procs  View_Index_Which = 4
+	View_Index_Which_memory View_Index_Which = 5
+	View_Index_Which_cpu    View_Index_Which = 6
+	View_Index_Which_slots  View_Index_Which = 7
 )
 
-func (w View_MaybeRecord_Which) String() string {
-	const s = "nothingjust"
+func (w View_Index_Which) String() string {
+	const s = "peerserverhostmetaprocsmemorycpuslots"
 	switch w {
-	case View_MaybeRecord_Which_nothing:
-		return s[0:7]
-	case View_MaybeRecord_Which_just:
-		return s[7:11]
+	case View_Index_Which_peer:
+		return s[0:4]
+	case View_Index_Which_server:
+		return s[4:10]
+	case View_Index_Which_host:
+		return s[10:14]
+	case View_Index_Which_meta:
+		return s[14:18]
+	case View_Index_Which_procs:
+		return s[18:23]
+	case View_Index_Which_memory:
+		return s[23:29]
+	case View_Index_Which_cpu:
+		return s[29:32]
+	case View_Index_Which_slots:
+		return s[32:37]
 
 	}
-	return "View_MaybeRecord_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "View_Index_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// View_MaybeRecord_TypeID is the unique identifier for the type View_MaybeRecord.
-const View_MaybeRecord_TypeID = 0xd6a4f298bc0e2304
+// View_Index_TypeID is the unique identifier for the type View_Index.
+const View_Index_TypeID = 0xcc2d04cc26d4f6a5
 
-func NewView_MaybeRecord(s *capnp.Segment) (View_MaybeRecord, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_MaybeRecord(st), err
+func NewView_Index(s *capnp.Segment) (View_Index, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Index(st), err
 }
 
-func NewRootView_MaybeRecord(s *capnp.Segment) (View_MaybeRecord, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_MaybeRecord(st), err
+func NewRootView_Index(s *capnp.Segment) (View_Index, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Index(st), err
 }
 
-func ReadRootView_MaybeRecord(msg *capnp.Message) (View_MaybeRecord, error) {
+func ReadRootView_Index(msg *capnp.Message) (View_Index, error) {
 	root, err := msg.Root()
-	return View_MaybeRecord(root.Struct()), err
+	return View_Index(root.Struct()), err
 }
 
-func (s View_MaybeRecord) String() string {
-	str, _ := text.Marshal(0xd6a4f298bc0e2304, capnp.Struct(s))
+func (s View_Index) String() string {
+	str, _ := text.Marshal(0xcc2d04cc26d4f6a5, capnp.Struct(s))
 	return str
 }
 
-func (s View_MaybeRecord) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_Index) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_MaybeRecord) DecodeFromPtr(p capnp.Ptr) View_MaybeRecord {
-	return View_MaybeRecord(capnp.Struct{}.DecodeFromPtr(p))
+func (View_Index) DecodeFromPtr(p capnp.Ptr) View_Index {
+	return View_Index(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_MaybeRecord) ToPtr() capnp.Ptr {
+func (s View_Index) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s View_MaybeRecord) Which() View_MaybeRecord_Which {
-	return View_MaybeRecord_Which(capnp.Struct(s).Uint16(0))
+func (s View_Index) Which() View_Index_Which {
+	return View_Index_Which(capnp.Struct(s).Uint16(2))
 }
-func (s View_MaybeRecord) IsValid() bool {
+func (s View_Index) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_MaybeRecord) Message() *capnp.Message {
+func (s View_Index) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_MaybeRecord) Segment() *capnp.Segment {
+func (s View_Index) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_MaybeRecord) SetNothing() {
-	capnp.Struct(s).SetUint16(0, 0)
+func (s View_Index) Prefix() bool {
+	return capnp.Struct(s).Bit(0)
+}
 
+func (s View_Index) SetPrefix(v bool) {
+	capnp.Struct(s).SetBit(0, v)
 }
 
-func (s View_MaybeRecord) Just() (View_Record, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != just")
+func (s View_Index) Peer() (string, error) {
+	if capnp.Struct(s).Uint16(2) != 0 {
+		panic("Which() != peer")
 	}
 	p, err := capnp.Struct(s).Ptr(0)
-	return View_Record(p.Struct()), err
+	return p.Text(), err
 }
 
-func (s View_MaybeRecord) HasJust() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
+func (s View_Index) HasPeer() bool {
+	if capnp.Struct(s).Uint16(2) != 0 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_MaybeRecord) SetJust(v View_Record) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s View_Index) PeerBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
 }
 
-// NewJust sets the just field to a newly
-// allocated View_Record struct, preferring placement in s's segment.
-func (s View_MaybeRecord) NewJust() (View_Record, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewView_Record(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_Record{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s View_Index) SetPeer(v string) error {
+	capnp.Struct(s).SetUint16(2, 0)
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// View_MaybeRecord_List is a list of View_MaybeRecord.
-type View_MaybeRecord_List = capnp.StructList[View_MaybeRecord]
-
-// NewView_MaybeRecord creates a new list of View_MaybeRecord.
-func NewView_MaybeRecord_List(s *capnp.Segment, sz int32) (View_MaybeRecord_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[View_MaybeRecord](l), err
+func (s View_Index) Server() ([]byte, error) {
+	if capnp.Struct(s).Uint16(2) != 1 {
+		panic("Which() != server")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
 }
 
-// View_MaybeRecord_Future is a wrapper for a View_MaybeRecord promised by a client call.
-type View_MaybeRecord_Future struct{ *capnp.Future }
-
-func (f View_MaybeRecord_Future) Struct() (View_MaybeRecord, error) {
-	p, err := f.Future.Ptr()
-	return View_MaybeRecord(p.Struct()), err
-}
-func (p View_MaybeRecord_Future) Just() View_Record_Future {
-	return View_Record_Future{Future: p.Future.Field(0, nil)}
+func (s View_Index) HasServer() bool {
+	if capnp.Struct(s).Uint16(2) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-type View_lookup_Params capnp.Struct
-
-// View_lookup_Params_TypeID is the unique identifier for the type View_lookup_Params.
-const View_lookup_Params_TypeID = 0xd929e054f82b286c
-
-func NewView_lookup_Params(s *capnp.Segment) (View_lookup_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return View_lookup_Params(st), err
+func (s View_Index) SetServer(v []byte) error {
+	capnp.Struct(s).SetUint16(2, 1)
+	return capnp.Struct(s).SetData(0, v)
 }
 
-func NewRootView_lookup_Params(s *capnp.Segment) (View_lookup_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return View_lookup_Params(st), err
+func (s View_Index) Host() (string, error) {
+	if capnp.Struct(s).Uint16(2) != 2 {
+		panic("Which() != host")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
 }
 
-func ReadRootView_lookup_Params(msg *capnp.Message) (View_lookup_Params, error) {
-	root, err := msg.Root()
-	return View_lookup_Params(root.Struct()), err
+func (s View_Index) HasHost() bool {
+	if capnp.Struct(s).Uint16(2) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_lookup_Params) String() string {
-	str, _ := text.Marshal(0xd929e054f82b286c, capnp.Struct(s))
-	return str
+func (s View_Index) HostBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
 }
 
-func (s View_lookup_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s View_Index) SetHost(v string) error {
+	capnp.Struct(s).SetUint16(2, 2)
+	return capnp.Struct(s).SetText(0, v)
 }
 
-func (View_lookup_Params) DecodeFromPtr(p capnp.Ptr) View_lookup_Params {
-	return View_lookup_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (s View_Index) Meta() (string, error) {
+	if capnp.Struct(s).Uint16(2) != 3 {
+		panic("Which() != meta")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s View_Index) HasMeta() bool {
+	if capnp.Struct(s).Uint16(2) != 3 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Index) MetaBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s View_Index) SetMeta(v string) error {
+	capnp.Struct(s).SetUint16(2, 3)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s View_Index) Procs() uint32 {
+	if capnp.Struct(s).Uint16(2) != 4 {
+		panic("Which() != procs")
+	}
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s View_Index) SetProcs(v uint32) {
+	capnp.Struct(s).SetUint16(2, 4)
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+func (s View_Index) Memory() uint64 {
+	if capnp.Struct(s).Uint16(2) != 5 {
+		panic("Which() != memory")
+	}
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s View_Index) SetMemory(v uint64) {
+	capnp.Struct(s).SetUint16(2, 5)
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s View_Index) Cpu() float32 {
+	if capnp.Struct(s).Uint16(2) != 6 {
+		panic("Which() != cpu")
+	}
+	return math.Float32frombits(capnp.Struct(s).Uint32(4))
+}
+
+func (s View_Index) SetCpu(v float32) {
+	capnp.Struct(s).SetUint16(2, 6)
+	capnp.Struct(s).SetUint32(4, math.Float32bits(v))
+}
+
+func (s View_Index) Slots() uint32 {
+	if capnp.Struct(s).Uint16(2) != 7 {
+		panic("Which() != slots")
+	}
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s View_Index) SetSlots(v uint32) {
+	capnp.Struct(s).SetUint16(2, 7)
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+// View_Index_List is a list of View_Index.
+type View_Index_List = capnp.StructList[View_Index]
+
+// NewView_Index creates a new list of View_Index.
+func NewView_Index_List(s *capnp.Segment, sz int32) (View_Index_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[View_Index](l), err
+}
+
+// View_Index_Future is a wrapper for a View_Index promised by a client call.
+type View_Index_Future struct{ *capnp.Future }
+
+func (f View_Index_Future) Struct() (View_Index, error) {
+	p, err := f.Future.Ptr()
+	return View_Index(p.Struct()), err
+}
+
+type View_Record capnp.Struct
+
+// View_Record_TypeID is the unique identifier for the type View_Record.
+const View_Record_TypeID = 0xcdcf42beb2537d20
+
+func NewView_Record(s *capnp.Segment) (View_Record, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return View_Record(st), err
+}
+
+func NewRootView_Record(s *capnp.Segment) (View_Record, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return View_Record(st), err
+}
+
+func ReadRootView_Record(msg *capnp.Message) (View_Record, error) {
+	root, err := msg.Root()
+	return View_Record(root.Struct()), err
+}
+
+func (s View_Record) String() string {
+	str, _ := text.Marshal(0xcdcf42beb2537d20, capnp.Struct(s))
+	return str
+}
+
+func (s View_Record) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Record) DecodeFromPtr(p capnp.Ptr) View_Record {
+	return View_Record(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Record) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_Record) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Record) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Record) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Record) Peer() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s View_Record) HasPeer() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Record) PeerBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s View_Record) SetPeer(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s View_Record) Server() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s View_Record) SetServer(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+func (s View_Record) Seq() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s View_Record) SetSeq(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s View_Record) Heartbeat() (Heartbeat, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return Heartbeat(p.Struct()), err
+}
+
+func (s View_Record) HasHeartbeat() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s View_Record) SetHeartbeat(v Heartbeat) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewHeartbeat sets the heartbeat field to a newly
+// allocated Heartbeat struct, preferring placement in s's segment.
+func (s View_Record) NewHeartbeat() (Heartbeat, error) {
+	ss, err := NewHeartbeat(capnp.Struct(s).Segment())
+	if err != nil {
+		return Heartbeat{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Record_List is a list of View_Record.
+type View_Record_List = capnp.StructList[View_Record]
+
+// NewView_Record creates a new list of View_Record.
+func NewView_Record_List(s *capnp.Segment, sz int32) (View_Record_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	return capnp.StructList[View_Record](l), err
+}
+
+// View_Record_Future is a wrapper for a View_Record promised by a client call.
+type View_Record_Future struct{ *capnp.Future }
+
+func (f View_Record_Future) Struct() (View_Record, error) {
+	p, err := f.Future.Ptr()
+	return View_Record(p.Struct()), err
+}
+func (p View_Record_Future) Heartbeat() Heartbeat_Future {
+	return Heartbeat_Future{Future: p.Future.Field(1, nil)}
+}
+
+type View_MaybeRecord capnp.Struct
+type View_MaybeRecord_Which uint16
+
+const (
+	View_MaybeRecord_Which_nothing View_MaybeRecord_Which = 0
+	View_MaybeRecord_Which_just    View_MaybeRecord_Which = 1
+)
+
+func (w View_MaybeRecord_Which) String() string {
+	const s = "nothingjust"
+	switch w {
+	case View_MaybeRecord_Which_nothing:
+		return s[0:7]
+	case View_MaybeRecord_Which_just:
+		return s[7:11]
+
+	}
+	return "View_MaybeRecord_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// View_MaybeRecord_TypeID is the unique identifier for the type View_MaybeRecord.
+const View_MaybeRecord_TypeID = 0xd6a4f298bc0e2304
+
+func NewView_MaybeRecord(s *capnp.Segment) (View_MaybeRecord, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_MaybeRecord(st), err
+}
+
+func NewRootView_MaybeRecord(s *capnp.Segment) (View_MaybeRecord, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_MaybeRecord(st), err
+}
+
+func ReadRootView_MaybeRecord(msg *capnp.Message) (View_MaybeRecord, error) {
+	root, err := msg.Root()
+	return View_MaybeRecord(root.Struct()), err
+}
+
+func (s View_MaybeRecord) String() string {
+	str, _ := text.Marshal(0xd6a4f298bc0e2304, capnp.Struct(s))
+	return str
+}
+
+func (s View_MaybeRecord) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_MaybeRecord) DecodeFromPtr(p capnp.Ptr) View_MaybeRecord {
+	return View_MaybeRecord(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_MaybeRecord) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s View_MaybeRecord) Which() View_MaybeRecord_Which {
+	return View_MaybeRecord_Which(capnp.Struct(s).Uint16(0))
+}
+func (s View_MaybeRecord) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_MaybeRecord) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_MaybeRecord) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_MaybeRecord) SetNothing() {
+	capnp.Struct(s).SetUint16(0, 0)
+
+}
+
+func (s View_MaybeRecord) Just() (View_Record, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != just")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Record(p.Struct()), err
+}
+
+func (s View_MaybeRecord) HasJust() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_MaybeRecord) SetJust(v View_Record) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewJust sets the just field to a newly
+// allocated View_Record struct, preferring placement in s's segment.
+func (s View_MaybeRecord) NewJust() (View_Record, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	ss, err := NewView_Record(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Record{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_MaybeRecord_List is a list of View_MaybeRecord.
+type View_MaybeRecord_List = capnp.StructList[View_MaybeRecord]
+
+// NewView_MaybeRecord creates a new list of View_MaybeRecord.
+func NewView_MaybeRecord_List(s *capnp.Segment, sz int32) (View_MaybeRecord_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[View_MaybeRecord](l), err
+}
+
+// View_MaybeRecord_Future is a wrapper for a View_MaybeRecord promised by a client call.
+type View_MaybeRecord_Future struct{ *capnp.Future }
+
+func (f View_MaybeRecord_Future) Struct() (View_MaybeRecord, error) {
+	p, err := f.Future.Ptr()
+	return View_MaybeRecord(p.Struct()), err
+}
+func (p View_MaybeRecord_Future) Just() View_Record_Future {
+	return View_Record_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_lookup_Params capnp.Struct
+
+// View_lookup_Params_TypeID is the unique identifier for the type View_lookup_Params.
+const View_lookup_Params_TypeID = 0xd929e054f82b286c
+
+func NewView_lookup_Params(s *capnp.Segment) (View_lookup_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return View_lookup_Params(st), err
+}
+
+func NewRootView_lookup_Params(s *capnp.Segment) (View_lookup_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return View_lookup_Params(st), err
+}
+
+func ReadRootView_lookup_Params(msg *capnp.Message) (View_lookup_Params, error) {
+	root, err := msg.Root()
+	return View_lookup_Params(root.Struct()), err
+}
+
+func (s View_lookup_Params) String() string {
+	str, _ := text.Marshal(0xd929e054f82b286c, capnp.Struct(s))
+	return str
+}
+
+func (s View_lookup_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_lookup_Params) DecodeFromPtr(p capnp.Ptr) View_lookup_Params {
+	return View_lookup_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
 func (s View_lookup_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_lookup_Params) IsValid() bool {
+func (s View_lookup_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_lookup_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_lookup_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_lookup_Params) Selector() (View_Selector, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Selector(p.Struct()), err
+}
+
+func (s View_lookup_Params) HasSelector() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_lookup_Params) SetSelector(v View_Selector) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewSelector sets the selector field to a newly
+// allocated View_Selector struct, preferring placement in s's segment.
+func (s View_lookup_Params) NewSelector() (View_Selector, error) {
+	ss, err := NewView_Selector(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Selector{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_lookup_Params) Constraints() (View_Constraint_List, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return View_Constraint_List(p.List()), err
+}
+
+func (s View_lookup_Params) HasConstraints() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s View_lookup_Params) SetConstraints(v View_Constraint_List) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewConstraints sets the constraints field to a newly
+// allocated View_Constraint_List, preferring placement in s's segment.
+func (s View_lookup_Params) NewConstraints(n int32) (View_Constraint_List, error) {
+	l, err := NewView_Constraint_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return View_Constraint_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+
+// View_lookup_Params_List is a list of View_lookup_Params.
+type View_lookup_Params_List = capnp.StructList[View_lookup_Params]
+
+// NewView_lookup_Params creates a new list of View_lookup_Params.
+func NewView_lookup_Params_List(s *capnp.Segment, sz int32) (View_lookup_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[View_lookup_Params](l), err
+}
+
+// View_lookup_Params_Future is a wrapper for a View_lookup_Params promised by a client call.
+type View_lookup_Params_Future struct{ *capnp.Future }
+
+func (f View_lookup_Params_Future) Struct() (View_lookup_Params, error) {
+	p, err := f.Future.Ptr()
+	return View_lookup_Params(p.Struct()), err
+}
+func (p View_lookup_Params_Future) Selector() View_Selector_Future {
+	return View_Selector_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_lookup_Results capnp.Struct
+
+// View_lookup_Results_TypeID is the unique identifier for the type View_lookup_Results.
+const View_lookup_Results_TypeID = 0xe6df611247a8fc13
+
+func NewView_lookup_Results(s *capnp.Segment) (View_lookup_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_lookup_Results(st), err
+}
+
+func NewRootView_lookup_Results(s *capnp.Segment) (View_lookup_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_lookup_Results(st), err
+}
+
+func ReadRootView_lookup_Results(msg *capnp.Message) (View_lookup_Results, error) {
+	root, err := msg.Root()
+	return View_lookup_Results(root.Struct()), err
+}
+
+func (s View_lookup_Results) String() string {
+	str, _ := text.Marshal(0xe6df611247a8fc13, capnp.Struct(s))
+	return str
+}
+
+func (s View_lookup_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_lookup_Results) DecodeFromPtr(p capnp.Ptr) View_lookup_Results {
+	return View_lookup_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_lookup_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_lookup_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_lookup_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_lookup_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_lookup_Results) Result() (View_MaybeRecord, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_MaybeRecord(p.Struct()), err
+}
+
+func (s View_lookup_Results) HasResult() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_lookup_Results) SetResult(v View_MaybeRecord) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated View_MaybeRecord struct, preferring placement in s's segment.
+func (s View_lookup_Results) NewResult() (View_MaybeRecord, error) {
+	ss, err := NewView_MaybeRecord(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_MaybeRecord{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_lookup_Results_List is a list of View_lookup_Results.
+type View_lookup_Results_List = capnp.StructList[View_lookup_Results]
+
+// NewView_lookup_Results creates a new list of View_lookup_Results.
+func NewView_lookup_Results_List(s *capnp.Segment, sz int32) (View_lookup_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[View_lookup_Results](l), err
+}
+
+// View_lookup_Results_Future is a wrapper for a View_lookup_Results promised by a client call.
+type View_lookup_Results_Future struct{ *capnp.Future }
+
+func (f View_lookup_Results_Future) Struct() (View_lookup_Results, error) {
+	p, err := f.Future.Ptr()
+	return View_lookup_Results(p.Struct()), err
+}
+func (p View_lookup_Results_Future) Result() View_MaybeRecord_Future {
+	return View_MaybeRecord_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_iter_Params capnp.Struct
+
+// View_iter_Params_TypeID is the unique identifier for the type View_iter_Params.
+const View_iter_Params_TypeID = 0xf495a555c9344000
+
+func NewView_iter_Params(s *capnp.Segment) (View_iter_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return View_iter_Params(st), err
+}
+
+func NewRootView_iter_Params(s *capnp.Segment) (View_iter_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return View_iter_Params(st), err
+}
+
+func ReadRootView_iter_Params(msg *capnp.Message) (View_iter_Params, error) {
+	root, err := msg.Root()
+	return View_iter_Params(root.Struct()), err
+}
+
+func (s View_iter_Params) String() string {
+	str, _ := text.Marshal(0xf495a555c9344000, capnp.Struct(s))
+	return str
+}
+
+func (s View_iter_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_iter_Params) DecodeFromPtr(p capnp.Ptr) View_iter_Params {
+	return View_iter_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_iter_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_iter_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_lookup_Params) Message() *capnp.Message {
+func (s View_iter_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_lookup_Params) Segment() *capnp.Segment {
+func (s View_iter_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_lookup_Params) Selector() (View_Selector, error) {
-	p, err := capnp.Struct(s).Ptr(0)
+func (s View_iter_Params) Handler() View_Handler {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return View_Handler(p.Interface().Client())
+}
+
+func (s View_iter_Params) HasHandler() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_iter_Params) SetHandler(v View_Handler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+func (s View_iter_Params) Selector() (View_Selector, error) {
+	p, err := capnp.Struct(s).Ptr(1)
 	return View_Selector(p.Struct()), err
 }
 
-func (s View_lookup_Params) HasSelector() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s View_iter_Params) HasSelector() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s View_iter_Params) SetSelector(v View_Selector) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewSelector sets the selector field to a newly
+// allocated View_Selector struct, preferring placement in s's segment.
+func (s View_iter_Params) NewSelector() (View_Selector, error) {
+	ss, err := NewView_Selector(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Selector{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_iter_Params) Constraints() (View_Constraint_List, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return View_Constraint_List(p.List()), err
+}
+
+func (s View_iter_Params) HasConstraints() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s View_iter_Params) SetConstraints(v View_Constraint_List) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewConstraints sets the constraints field to a newly
+// allocated View_Constraint_List, preferring placement in s's segment.
+func (s View_iter_Params) NewConstraints(n int32) (View_Constraint_List, error) {
+	l, err := NewView_Constraint_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return View_Constraint_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+
+// View_iter_Params_List is a list of View_iter_Params.
+type View_iter_Params_List = capnp.StructList[View_iter_Params]
+
+// NewView_iter_Params creates a new list of View_iter_Params.
+func NewView_iter_Params_List(s *capnp.Segment, sz int32) (View_iter_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[View_iter_Params](l), err
+}
+
+// View_iter_Params_Future is a wrapper for a View_iter_Params promised by a client call.
+type View_iter_Params_Future struct{ *capnp.Future }
+
+func (f View_iter_Params_Future) Struct() (View_iter_Params, error) {
+	p, err := f.Future.Ptr()
+	return View_iter_Params(p.Struct()), err
+}
+func (p View_iter_Params_Future) Handler() View_Handler {
+	return View_Handler(p.Future.Field(0, nil).Client())
+}
+
+func (p View_iter_Params_Future) Selector() View_Selector_Future {
+	return View_Selector_Future{Future: p.Future.Field(1, nil)}
+}
+
+type View_iter_Results capnp.Struct
+
+// View_iter_Results_TypeID is the unique identifier for the type View_iter_Results.
+const View_iter_Results_TypeID = 0xe54acc44b61fd7ef
+
+func NewView_iter_Results(s *capnp.Segment) (View_iter_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_iter_Results(st), err
+}
+
+func NewRootView_iter_Results(s *capnp.Segment) (View_iter_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_iter_Results(st), err
+}
+
+func ReadRootView_iter_Results(msg *capnp.Message) (View_iter_Results, error) {
+	root, err := msg.Root()
+	return View_iter_Results(root.Struct()), err
+}
+
+func (s View_iter_Results) String() string {
+	str, _ := text.Marshal(0xe54acc44b61fd7ef, capnp.Struct(s))
+	return str
+}
+
+func (s View_iter_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_iter_Results) DecodeFromPtr(p capnp.Ptr) View_iter_Results {
+	return View_iter_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_iter_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_iter_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_iter_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_iter_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// View_iter_Results_List is a list of View_iter_Results.
+type View_iter_Results_List = capnp.StructList[View_iter_Results]
+
+// NewView_iter_Results creates a new list of View_iter_Results.
+func NewView_iter_Results_List(s *capnp.Segment, sz int32) (View_iter_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[View_iter_Results](l), err
+}
+
+// View_iter_Results_Future is a wrapper for a View_iter_Results promised by a client call.
+type View_iter_Results_Future struct{ *capnp.Future }
+
+func (f View_iter_Results_Future) Struct() (View_iter_Results, error) {
+	p, err := f.Future.Ptr()
+	return View_iter_Results(p.Struct()), err
+}
+
+type View_reverse_Params capnp.Struct
+
+// View_reverse_Params_TypeID is the unique identifier for the type View_reverse_Params.
+const View_reverse_Params_TypeID = 0x8b1fd983f1df482d
+
+func NewView_reverse_Params(s *capnp.Segment) (View_reverse_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_reverse_Params(st), err
+}
+
+func NewRootView_reverse_Params(s *capnp.Segment) (View_reverse_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_reverse_Params(st), err
+}
+
+func ReadRootView_reverse_Params(msg *capnp.Message) (View_reverse_Params, error) {
+	root, err := msg.Root()
+	return View_reverse_Params(root.Struct()), err
+}
+
+func (s View_reverse_Params) String() string {
+	str, _ := text.Marshal(0x8b1fd983f1df482d, capnp.Struct(s))
+	return str
+}
+
+func (s View_reverse_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_reverse_Params) DecodeFromPtr(p capnp.Ptr) View_reverse_Params {
+	return View_reverse_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_reverse_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_reverse_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_reverse_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_reverse_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// View_reverse_Params_List is a list of View_reverse_Params.
+type View_reverse_Params_List = capnp.StructList[View_reverse_Params]
+
+// NewView_reverse_Params creates a new list of View_reverse_Params.
+func NewView_reverse_Params_List(s *capnp.Segment, sz int32) (View_reverse_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[View_reverse_Params](l), err
+}
+
+// View_reverse_Params_Future is a wrapper for a View_reverse_Params promised by a client call.
+type View_reverse_Params_Future struct{ *capnp.Future }
+
+func (f View_reverse_Params_Future) Struct() (View_reverse_Params, error) {
+	p, err := f.Future.Ptr()
+	return View_reverse_Params(p.Struct()), err
+}
+
+type View_reverse_Results capnp.Struct
+
+// View_reverse_Results_TypeID is the unique identifier for the type View_reverse_Results.
+const View_reverse_Results_TypeID = 0xcc7efefbb528cd6c
+
+func NewView_reverse_Results(s *capnp.Segment) (View_reverse_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_reverse_Results(st), err
+}
+
+func NewRootView_reverse_Results(s *capnp.Segment) (View_reverse_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_reverse_Results(st), err
+}
+
+func ReadRootView_reverse_Results(msg *capnp.Message) (View_reverse_Results, error) {
+	root, err := msg.Root()
+	return View_reverse_Results(root.Struct()), err
+}
+
+func (s View_reverse_Results) String() string {
+	str, _ := text.Marshal(0xcc7efefbb528cd6c, capnp.Struct(s))
+	return str
+}
+
+func (s View_reverse_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s View_lookup_Params) SetSelector(v View_Selector) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (View_reverse_Results) DecodeFromPtr(p capnp.Ptr) View_reverse_Results {
+	return View_reverse_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// NewSelector sets the selector field to a newly
-// allocated View_Selector struct, preferring placement in s's segment.
-func (s View_lookup_Params) NewSelector() (View_Selector, error) {
-	ss, err := NewView_Selector(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_Selector{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s View_reverse_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_reverse_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s View_lookup_Params) Constraints() (View_Constraint_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return View_Constraint_List(p.List()), err
+func (s View_reverse_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s View_lookup_Params) HasConstraints() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s View_reverse_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_reverse_Results) View() View {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return View(p.Interface().Client())
 }
 
-func (s View_lookup_Params) SetConstraints(v View_Constraint_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+func (s View_reverse_Results) HasView() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// NewConstraints sets the constraints field to a newly
-// allocated View_Constraint_List, preferring placement in s's segment.
-func (s View_lookup_Params) NewConstraints(n int32) (View_Constraint_List, error) {
-	l, err := NewView_Constraint_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return View_Constraint_List{}, err
+func (s View_reverse_Results) SetView(v View) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
 	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
 }
 
-// View_lookup_Params_List is a list of View_lookup_Params.
-type View_lookup_Params_List = capnp.StructList[View_lookup_Params]
+// View_reverse_Results_List is a list of View_reverse_Results.
+type View_reverse_Results_List = capnp.StructList[View_reverse_Results]
 
-// NewView_lookup_Params creates a new list of View_lookup_Params.
-func NewView_lookup_Params_List(s *capnp.Segment, sz int32) (View_lookup_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[View_lookup_Params](l), err
+// NewView_reverse_Results creates a new list of View_reverse_Results.
+func NewView_reverse_Results_List(s *capnp.Segment, sz int32) (View_reverse_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[View_reverse_Results](l), err
 }
 
-// View_lookup_Params_Future is a wrapper for a View_lookup_Params promised by a client call.
-type View_lookup_Params_Future struct{ *capnp.Future }
+// View_reverse_Results_Future is a wrapper for a View_reverse_Results promised by a client call.
+type View_reverse_Results_Future struct{ *capnp.Future }
 
-func (f View_lookup_Params_Future) Struct() (View_lookup_Params, error) {
+func (f View_reverse_Results_Future) Struct() (View_reverse_Results, error) {
 	p, err := f.Future.Ptr()
-	return View_lookup_Params(p.Struct()), err
+	return View_reverse_Results(p.Struct()), err
 }
-func (p View_lookup_Params_Future) Selector() View_Selector_Future {
-	return View_Selector_Future{Future: p.Future.Field(0, nil)}
+func (p View_reverse_Results_Future) View() View {
+	return View(p.Future.Field(0, nil).Client())
 }
 
-type View_lookup_Results capnp.Struct
+type View_watch_Params capnp.Struct
 
-// View_lookup_Results_TypeID is the unique identifier for the type View_lookup_Results.
-const View_lookup_Results_TypeID = 0xe6df611247a8fc13
+// View_watch_Params_TypeID is the unique identifier for the type View_watch_Params.
+const View_watch_Params_TypeID = 0xce1f478309867723
 
-func NewView_lookup_Results(s *capnp.Segment) (View_lookup_Results, error) {
+func NewView_watch_Params(s *capnp.Segment) (View_watch_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_lookup_Results(st), err
+	return View_watch_Params(st), err
 }
 
-func NewRootView_lookup_Results(s *capnp.Segment) (View_lookup_Results, error) {
+func NewRootView_watch_Params(s *capnp.Segment) (View_watch_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_lookup_Results(st), err
+	return View_watch_Params(st), err
 }
 
-func ReadRootView_lookup_Results(msg *capnp.Message) (View_lookup_Results, error) {
+func ReadRootView_watch_Params(msg *capnp.Message) (View_watch_Params, error) {
 	root, err := msg.Root()
-	return View_lookup_Results(root.Struct()), err
+	return View_watch_Params(root.Struct()), err
 }
 
-func (s View_lookup_Results) String() string {
-	str, _ := text.Marshal(0xe6df611247a8fc13, capnp.Struct(s))
+func (s View_watch_Params) String() string {
+	str, _ := text.Marshal(0xce1f478309867723, capnp.Struct(s))
 	return str
 }
 
-func (s View_lookup_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_watch_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_lookup_Results) DecodeFromPtr(p capnp.Ptr) View_lookup_Results {
-	return View_lookup_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (View_watch_Params) DecodeFromPtr(p capnp.Ptr) View_watch_Params {
+	return View_watch_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_lookup_Results) ToPtr() capnp.Ptr {
+func (s View_watch_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_lookup_Results) IsValid() bool {
+func (s View_watch_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_lookup_Results) Message() *capnp.Message {
+func (s View_watch_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_lookup_Results) Segment() *capnp.Segment {
+func (s View_watch_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_lookup_Results) Result() (View_MaybeRecord, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return View_MaybeRecord(p.Struct()), err
+func (s View_watch_Params) Handler() View_EventHandler {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return View_EventHandler(p.Interface().Client())
 }
 
-func (s View_lookup_Results) HasResult() bool {
+func (s View_watch_Params) HasHandler() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_lookup_Results) SetResult(v View_MaybeRecord) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
-
-// NewResult sets the result field to a newly
-// allocated View_MaybeRecord struct, preferring placement in s's segment.
-func (s View_lookup_Results) NewResult() (View_MaybeRecord, error) {
-	ss, err := NewView_MaybeRecord(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_MaybeRecord{}, err
+func (s View_watch_Params) SetHandler(v View_EventHandler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
 }
 
-// View_lookup_Results_List is a list of View_lookup_Results.
-type View_lookup_Results_List = capnp.StructList[View_lookup_Results]
+// View_watch_Params_List is a list of View_watch_Params.
+type View_watch_Params_List = capnp.StructList[View_watch_Params]
 
-// NewView_lookup_Results creates a new list of View_lookup_Results.
-func NewView_lookup_Results_List(s *capnp.Segment, sz int32) (View_lookup_Results_List, error) {
+// NewView_watch_Params creates a new list of View_watch_Params.
+func NewView_watch_Params_List(s *capnp.Segment, sz int32) (View_watch_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[View_lookup_Results](l), err
+	return capnp.StructList[View_watch_Params](l), err
 }
 
-// View_lookup_Results_Future is a wrapper for a View_lookup_Results promised by a client call.
-type View_lookup_Results_Future struct{ *capnp.Future }
+// View_watch_Params_Future is a wrapper for a View_watch_Params promised by a client call.
+type View_watch_Params_Future struct{ *capnp.Future }
 
-func (f View_lookup_Results_Future) Struct() (View_lookup_Results, error) {
+func (f View_watch_Params_Future) Struct() (View_watch_Params, error) {
 	p, err := f.Future.Ptr()
-	return View_lookup_Results(p.Struct()), err
+	return View_watch_Params(p.Struct()), err
 }
-func (p View_lookup_Results_Future) Result() View_MaybeRecord_Future {
-	return View_MaybeRecord_Future{Future: p.Future.Field(0, nil)}
+func (p View_watch_Params_Future) Handler() View_EventHandler {
+	return View_EventHandler(p.Future.Field(0, nil).Client())
 }
 
-type View_iter_Params capnp.Struct
+type View_watch_Results capnp.Struct
 
-// View_iter_Params_TypeID is the unique identifier for the type View_iter_Params.
-const View_iter_Params_TypeID = 0xf495a555c9344000
+// View_watch_Results_TypeID is the unique identifier for the type View_watch_Results.
+const View_watch_Results_TypeID = 0xbd86f813590d15f1
 
-func NewView_iter_Params(s *capnp.Segment) (View_iter_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
-	return View_iter_Params(st), err
+func NewView_watch_Results(s *capnp.Segment) (View_watch_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_watch_Results(st), err
 }
 
-func NewRootView_iter_Params(s *capnp.Segment) (View_iter_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
-	return View_iter_Params(st), err
+func NewRootView_watch_Results(s *capnp.Segment) (View_watch_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_watch_Results(st), err
 }
 
-func ReadRootView_iter_Params(msg *capnp.Message) (View_iter_Params, error) {
+func ReadRootView_watch_Results(msg *capnp.Message) (View_watch_Results, error) {
 	root, err := msg.Root()
-	return View_iter_Params(root.Struct()), err
+	return View_watch_Results(root.Struct()), err
 }
 
-func (s View_iter_Params) String() string {
-	str, _ := text.Marshal(0xf495a555c9344000, capnp.Struct(s))
+func (s View_watch_Results) String() string {
+	str, _ := text.Marshal(0xbd86f813590d15f1, capnp.Struct(s))
 	return str
 }
 
-func (s View_iter_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_watch_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_iter_Params) DecodeFromPtr(p capnp.Ptr) View_iter_Params {
-	return View_iter_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (View_watch_Results) DecodeFromPtr(p capnp.Ptr) View_watch_Results {
+	return View_watch_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_iter_Params) ToPtr() capnp.Ptr {
+func (s View_watch_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_iter_Params) IsValid() bool {
+func (s View_watch_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_iter_Params) Message() *capnp.Message {
+func (s View_watch_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_iter_Params) Segment() *capnp.Segment {
+func (s View_watch_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_iter_Params) Handler() View_Handler {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return View_Handler(p.Interface().Client())
+
+// View_watch_Results_List is a list of View_watch_Results.
+type View_watch_Results_List = capnp.StructList[View_watch_Results]
+
+// NewView_watch_Results creates a new list of View_watch_Results.
+func NewView_watch_Results_List(s *capnp.Segment, sz int32) (View_watch_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[View_watch_Results](l), err
 }
 
-func (s View_iter_Params) HasHandler() bool {
-	return capnp.Struct(s).HasPtr(0)
+// View_watch_Results_Future is a wrapper for a View_watch_Results promised by a client call.
+type View_watch_Results_Future struct{ *capnp.Future }
+
+func (f View_watch_Results_Future) Struct() (View_watch_Results, error) {
+	p, err := f.Future.Ptr()
+	return View_watch_Results(p.Struct()), err
 }
 
-func (s View_iter_Params) SetHandler(v View_Handler) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+type Meta capnp.Client
+
+// Meta_TypeID is the unique identifier for the type Meta.
+const Meta_TypeID = 0xb3a29471ca27a89c
+
+func (c Meta) Get(ctx context.Context, params func(Meta_get_Params) error) (Meta_get_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb3a29471ca27a89c,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:Meta",
+			MethodName:    "get",
+		},
 	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Meta_get_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Meta_get_Results_Future{Future: ans.Future()}, release
+
 }
 
-func (s View_iter_Params) Selector() (View_Selector, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return View_Selector(p.Struct()), err
+func (c Meta) Update(ctx context.Context, params func(Meta_update_Params) error) (Meta_update_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb3a29471ca27a89c,
+			MethodID:      1,
+			InterfaceName: "cluster.capnp:Meta",
+			MethodName:    "update",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Meta_update_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Meta_update_Results_Future{Future: ans.Future()}, release
+
 }
 
-func (s View_iter_Params) HasSelector() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (c Meta) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
 }
 
-func (s View_iter_Params) SetSelector(v View_Selector) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Meta) String() string {
+	return "Meta(" + capnp.Client(c).String() + ")"
 }
 
-// NewSelector sets the selector field to a newly
-// allocated View_Selector struct, preferring placement in s's segment.
-func (s View_iter_Params) NewSelector() (View_Selector, error) {
-	ss, err := NewView_Selector(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_Selector{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Meta) AddRef() Meta {
+	return Meta(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Meta) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Meta) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Meta) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Meta) DecodeFromPtr(p capnp.Ptr) Meta {
+	return Meta(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Meta) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Meta) IsSame(other Meta) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Meta) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Meta) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
 
-func (s View_iter_Params) Constraints() (View_Constraint_List, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return View_Constraint_List(p.List()), err
+// A Meta_Server is a Meta with a local implementation.
+type Meta_Server interface {
+	Get(context.Context, Meta_get) error
+
+	Update(context.Context, Meta_update) error
 }
 
-func (s View_iter_Params) HasConstraints() bool {
-	return capnp.Struct(s).HasPtr(2)
+// Meta_NewServer creates a new Server from an implementation of Meta_Server.
+func Meta_NewServer(s Meta_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Meta_Methods(nil, s), s, c)
 }
 
-func (s View_iter_Params) SetConstraints(v View_Constraint_List) error {
-	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+// Meta_ServerToClient creates a new Client from an implementation of Meta_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Meta_ServerToClient(s Meta_Server) Meta {
+	return Meta(capnp.NewClient(Meta_NewServer(s)))
 }
 
-// NewConstraints sets the constraints field to a newly
-// allocated View_Constraint_List, preferring placement in s's segment.
-func (s View_iter_Params) NewConstraints(n int32) (View_Constraint_List, error) {
-	l, err := NewView_Constraint_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return View_Constraint_List{}, err
+// Meta_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Meta_Methods(methods []server.Method, s Meta_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
 	}
-	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
-	return l, err
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb3a29471ca27a89c,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:Meta",
+			MethodName:    "get",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Get(ctx, Meta_get{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb3a29471ca27a89c,
+			MethodID:      1,
+			InterfaceName: "cluster.capnp:Meta",
+			MethodName:    "update",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Update(ctx, Meta_update{call})
+		},
+	})
+
+	return methods
 }
 
-// View_iter_Params_List is a list of View_iter_Params.
-type View_iter_Params_List = capnp.StructList[View_iter_Params]
+// Meta_get holds the state for a server call to Meta.get.
+// See server.Call for documentation.
+type Meta_get struct {
+	*server.Call
+}
 
-// NewView_iter_Params creates a new list of View_iter_Params.
-func NewView_iter_Params_List(s *capnp.Segment, sz int32) (View_iter_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
-	return capnp.StructList[View_iter_Params](l), err
+// Args returns the call's arguments.
+func (c Meta_get) Args() Meta_get_Params {
+	return Meta_get_Params(c.Call.Args())
 }
 
-// View_iter_Params_Future is a wrapper for a View_iter_Params promised by a client call.
-type View_iter_Params_Future struct{ *capnp.Future }
+// AllocResults allocates the results struct.
+func (c Meta_get) AllocResults() (Meta_get_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Meta_get_Results(r), err
+}
 
-func (f View_iter_Params_Future) Struct() (View_iter_Params, error) {
-	p, err := f.Future.Ptr()
-	return View_iter_Params(p.Struct()), err
+// Meta_update holds the state for a server call to Meta.update.
+// See server.Call for documentation.
+type Meta_update struct {
+	*server.Call
 }
-func (p View_iter_Params_Future) Handler() View_Handler {
-	return View_Handler(p.Future.Field(0, nil).Client())
+
+// Args returns the call's arguments.
+func (c Meta_update) Args() Meta_update_Params {
+	return Meta_update_Params(c.Call.Args())
 }
 
-func (p View_iter_Params_Future) Selector() View_Selector_Future {
-	return View_Selector_Future{Future: p.Future.Field(1, nil)}
+// AllocResults allocates the results struct.
+func (c Meta_update) AllocResults() (Meta_update_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Meta_update_Results(r), err
 }
 
-type View_iter_Results capnp.Struct
+// Meta_List is a list of Meta.
+type Meta_List = capnp.CapList[Meta]
 
-// View_iter_Results_TypeID is the unique identifier for the type View_iter_Results.
-const View_iter_Results_TypeID = 0xe54acc44b61fd7ef
+// NewMeta creates a new list of Meta.
+func NewMeta_List(s *capnp.Segment, sz int32) (Meta_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Meta](l), err
+}
 
-func NewView_iter_Results(s *capnp.Segment) (View_iter_Results, error) {
+type Meta_get_Params capnp.Struct
+
+// Meta_get_Params_TypeID is the unique identifier for the type Meta_get_Params.
+const Meta_get_Params_TypeID = 0x88a8a6fa6e7fb3c3
+
+func NewMeta_get_Params(s *capnp.Segment) (Meta_get_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return View_iter_Results(st), err
+	return Meta_get_Params(st), err
 }
 
-func NewRootView_iter_Results(s *capnp.Segment) (View_iter_Results, error) {
+func NewRootMeta_get_Params(s *capnp.Segment) (Meta_get_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return View_iter_Results(st), err
+	return Meta_get_Params(st), err
 }
 
-func ReadRootView_iter_Results(msg *capnp.Message) (View_iter_Results, error) {
+func ReadRootMeta_get_Params(msg *capnp.Message) (Meta_get_Params, error) {
 	root, err := msg.Root()
-	return View_iter_Results(root.Struct()), err
+	return Meta_get_Params(root.Struct()), err
 }
 
-func (s View_iter_Results) String() string {
-	str, _ := text.Marshal(0xe54acc44b61fd7ef, capnp.Struct(s))
+func (s Meta_get_Params) String() string {
+	str, _ := text.Marshal(0x88a8a6fa6e7fb3c3, capnp.Struct(s))
 	return str
 }
 
-func (s View_iter_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Meta_get_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_iter_Results) DecodeFromPtr(p capnp.Ptr) View_iter_Results {
-	return View_iter_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Meta_get_Params) DecodeFromPtr(p capnp.Ptr) Meta_get_Params {
+	return Meta_get_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_iter_Results) ToPtr() capnp.Ptr {
+func (s Meta_get_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_iter_Results) IsValid() bool {
+func (s Meta_get_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_iter_Results) Message() *capnp.Message {
+func (s Meta_get_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_iter_Results) Segment() *capnp.Segment {
+func (s Meta_get_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 
-// View_iter_Results_List is a list of View_iter_Results.
-type View_iter_Results_List = capnp.StructList[View_iter_Results]
+// Meta_get_Params_List is a list of Meta_get_Params.
+type Meta_get_Params_List = capnp.StructList[Meta_get_Params]
 
-// NewView_iter_Results creates a new list of View_iter_Results.
-func NewView_iter_Results_List(s *capnp.Segment, sz int32) (View_iter_Results_List, error) {
+// NewMeta_get_Params creates a new list of Meta_get_Params.
+func NewMeta_get_Params_List(s *capnp.Segment, sz int32) (Meta_get_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[View_iter_Results](l), err
+	return capnp.StructList[Meta_get_Params](l), err
 }
 
-// View_iter_Results_Future is a wrapper for a View_iter_Results promised by a client call.
-type View_iter_Results_Future struct{ *capnp.Future }
+// Meta_get_Params_Future is a wrapper for a Meta_get_Params promised by a client call.
+type Meta_get_Params_Future struct{ *capnp.Future }
 
-func (f View_iter_Results_Future) Struct() (View_iter_Results, error) {
+func (f Meta_get_Params_Future) Struct() (Meta_get_Params, error) {
 	p, err := f.Future.Ptr()
-	return View_iter_Results(p.Struct()), err
+	return Meta_get_Params(p.Struct()), err
 }
 
-type View_reverse_Params capnp.Struct
+type Meta_get_Results capnp.Struct
 
-// View_reverse_Params_TypeID is the unique identifier for the type View_reverse_Params.
-const View_reverse_Params_TypeID = 0x8b1fd983f1df482d
+// Meta_get_Results_TypeID is the unique identifier for the type Meta_get_Results.
+const Meta_get_Results_TypeID = 0x94ee52f0aefdc1aa
 
-func NewView_reverse_Params(s *capnp.Segment) (View_reverse_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return View_reverse_Params(st), err
+func NewMeta_get_Results(s *capnp.Segment) (Meta_get_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Meta_get_Results(st), err
 }
 
-func NewRootView_reverse_Params(s *capnp.Segment) (View_reverse_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return View_reverse_Params(st), err
+func NewRootMeta_get_Results(s *capnp.Segment) (Meta_get_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Meta_get_Results(st), err
 }
 
-func ReadRootView_reverse_Params(msg *capnp.Message) (View_reverse_Params, error) {
+func ReadRootMeta_get_Results(msg *capnp.Message) (Meta_get_Results, error) {
 	root, err := msg.Root()
-	return View_reverse_Params(root.Struct()), err
+	return Meta_get_Results(root.Struct()), err
 }
 
-func (s View_reverse_Params) String() string {
-	str, _ := text.Marshal(0x8b1fd983f1df482d, capnp.Struct(s))
+func (s Meta_get_Results) String() string {
+	str, _ := text.Marshal(0x94ee52f0aefdc1aa, capnp.Struct(s))
 	return str
 }
 
-func (s View_reverse_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Meta_get_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_reverse_Params) DecodeFromPtr(p capnp.Ptr) View_reverse_Params {
-	return View_reverse_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (Meta_get_Results) DecodeFromPtr(p capnp.Ptr) Meta_get_Results {
+	return Meta_get_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_reverse_Params) ToPtr() capnp.Ptr {
+func (s Meta_get_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_reverse_Params) IsValid() bool {
+func (s Meta_get_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_reverse_Params) Message() *capnp.Message {
+func (s Meta_get_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_reverse_Params) Segment() *capnp.Segment {
+func (s Meta_get_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s Meta_get_Results) Fields() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
 
-// View_reverse_Params_List is a list of View_reverse_Params.
-type View_reverse_Params_List = capnp.StructList[View_reverse_Params]
+func (s Meta_get_Results) HasFields() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewView_reverse_Params creates a new list of View_reverse_Params.
-func NewView_reverse_Params_List(s *capnp.Segment, sz int32) (View_reverse_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[View_reverse_Params](l), err
+func (s Meta_get_Results) SetFields(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// View_reverse_Params_Future is a wrapper for a View_reverse_Params promised by a client call.
-type View_reverse_Params_Future struct{ *capnp.Future }
+// NewFields sets the fields field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Meta_get_Results) NewFields(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
 
-func (f View_reverse_Params_Future) Struct() (View_reverse_Params, error) {
+// Meta_get_Results_List is a list of Meta_get_Results.
+type Meta_get_Results_List = capnp.StructList[Meta_get_Results]
+
+// NewMeta_get_Results creates a new list of Meta_get_Results.
+func NewMeta_get_Results_List(s *capnp.Segment, sz int32) (Meta_get_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Meta_get_Results](l), err
+}
+
+// Meta_get_Results_Future is a wrapper for a Meta_get_Results promised by a client call.
+type Meta_get_Results_Future struct{ *capnp.Future }
+
+func (f Meta_get_Results_Future) Struct() (Meta_get_Results, error) {
 	p, err := f.Future.Ptr()
-	return View_reverse_Params(p.Struct()), err
+	return Meta_get_Results(p.Struct()), err
 }
 
-type View_reverse_Results capnp.Struct
+type Meta_update_Params capnp.Struct
 
-// View_reverse_Results_TypeID is the unique identifier for the type View_reverse_Results.
-const View_reverse_Results_TypeID = 0xcc7efefbb528cd6c
+// Meta_update_Params_TypeID is the unique identifier for the type Meta_update_Params.
+const Meta_update_Params_TypeID = 0xba09d7f6dd6adf0a
 
-func NewView_reverse_Results(s *capnp.Segment) (View_reverse_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_reverse_Results(st), err
+func NewMeta_update_Params(s *capnp.Segment) (Meta_update_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Meta_update_Params(st), err
 }
 
-func NewRootView_reverse_Results(s *capnp.Segment) (View_reverse_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_reverse_Results(st), err
+func NewRootMeta_update_Params(s *capnp.Segment) (Meta_update_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Meta_update_Params(st), err
 }
 
-func ReadRootView_reverse_Results(msg *capnp.Message) (View_reverse_Results, error) {
+func ReadRootMeta_update_Params(msg *capnp.Message) (Meta_update_Params, error) {
 	root, err := msg.Root()
-	return View_reverse_Results(root.Struct()), err
+	return Meta_update_Params(root.Struct()), err
 }
 
-func (s View_reverse_Results) String() string {
-	str, _ := text.Marshal(0xcc7efefbb528cd6c, capnp.Struct(s))
+func (s Meta_update_Params) String() string {
+	str, _ := text.Marshal(0xba09d7f6dd6adf0a, capnp.Struct(s))
 	return str
 }
 
-func (s View_reverse_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Meta_update_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_reverse_Results) DecodeFromPtr(p capnp.Ptr) View_reverse_Results {
-	return View_reverse_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Meta_update_Params) DecodeFromPtr(p capnp.Ptr) Meta_update_Params {
+	return Meta_update_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_reverse_Results) ToPtr() capnp.Ptr {
+func (s Meta_update_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_reverse_Results) IsValid() bool {
+func (s Meta_update_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_reverse_Results) Message() *capnp.Message {
+func (s Meta_update_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_reverse_Results) Segment() *capnp.Segment {
+func (s Meta_update_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_reverse_Results) View() View {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return View(p.Interface().Client())
+func (s Meta_update_Params) Set() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
 }
 
-func (s View_reverse_Results) HasView() bool {
+func (s Meta_update_Params) HasSet() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_reverse_Results) SetView(v View) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+func (s Meta_update_Params) SetSet(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewSet sets the set field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Meta_update_Params) NewSet(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
 	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s Meta_update_Params) Unset() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return capnp.TextList(p.List()), err
 }
 
-// View_reverse_Results_List is a list of View_reverse_Results.
-type View_reverse_Results_List = capnp.StructList[View_reverse_Results]
+func (s Meta_update_Params) HasUnset() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
 
-// NewView_reverse_Results creates a new list of View_reverse_Results.
-func NewView_reverse_Results_List(s *capnp.Segment, sz int32) (View_reverse_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[View_reverse_Results](l), err
+func (s Meta_update_Params) SetUnset(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
 }
 
-// View_reverse_Results_Future is a wrapper for a View_reverse_Results promised by a client call.
-type View_reverse_Results_Future struct{ *capnp.Future }
+// NewUnset sets the unset field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Meta_update_Params) NewUnset(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
 
-func (f View_reverse_Results_Future) Struct() (View_reverse_Results, error) {
+// Meta_update_Params_List is a list of Meta_update_Params.
+type Meta_update_Params_List = capnp.StructList[Meta_update_Params]
+
+// NewMeta_update_Params creates a new list of Meta_update_Params.
+func NewMeta_update_Params_List(s *capnp.Segment, sz int32) (Meta_update_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Meta_update_Params](l), err
+}
+
+// Meta_update_Params_Future is a wrapper for a Meta_update_Params promised by a client call.
+type Meta_update_Params_Future struct{ *capnp.Future }
+
+func (f Meta_update_Params_Future) Struct() (Meta_update_Params, error) {
 	p, err := f.Future.Ptr()
-	return View_reverse_Results(p.Struct()), err
+	return Meta_update_Params(p.Struct()), err
 }
-func (p View_reverse_Results_Future) View() View {
-	return View(p.Future.Field(0, nil).Client())
+
+type Meta_update_Results capnp.Struct
+
+// Meta_update_Results_TypeID is the unique identifier for the type Meta_update_Results.
+const Meta_update_Results_TypeID = 0x9ec50e44b8b8e505
+
+func NewMeta_update_Results(s *capnp.Segment) (Meta_update_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Meta_update_Results(st), err
+}
+
+func NewRootMeta_update_Results(s *capnp.Segment) (Meta_update_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Meta_update_Results(st), err
+}
+
+func ReadRootMeta_update_Results(msg *capnp.Message) (Meta_update_Results, error) {
+	root, err := msg.Root()
+	return Meta_update_Results(root.Struct()), err
+}
+
+func (s Meta_update_Results) String() string {
+	str, _ := text.Marshal(0x9ec50e44b8b8e505, capnp.Struct(s))
+	return str
+}
+
+func (s Meta_update_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Meta_update_Results) DecodeFromPtr(p capnp.Ptr) Meta_update_Results {
+	return Meta_update_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Meta_update_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Meta_update_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Meta_update_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Meta_update_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Meta_update_Results_List is a list of Meta_update_Results.
+type Meta_update_Results_List = capnp.StructList[Meta_update_Results]
+
+// NewMeta_update_Results creates a new list of Meta_update_Results.
+func NewMeta_update_Results_List(s *capnp.Segment, sz int32) (Meta_update_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Meta_update_Results](l), err
 }
 
-const schema_fcf6ac08e448a6ac = "x\xda\xacV\x7fh\x1c\xd5\x1a\xfd\xce\xbd\xb3\x99\xddG" +
-	"6\x9b\xc9\xa4\xf0\xe8\xa3\xa4\xedK\x1fM\x1e)mS" +
-	"\x05\x83\x92\x10\x1b\x9aF\x0b\xb9i\x15,\x05\x9dln" +
-	"\xbb\xab\xfb#\x9d\x99\xfc\x10\x8cQl\xa1V+\x16-" +
-	"\xb4\x85*\x88-\x06+\xa5\x85V\x0aV\xc4\x7fDI" +
-	"\x95\x8a(\x15JE\xac\xfegm\x91Vk\x9a\x91;" +
-	"\xb3\xb3\xb3I\xd6\xe0\x1f\xfe7\x99\xf9r\xbf\xf3\x9ds" +
-	"\xbesw\xedC\xbcK[\x97\xbc\xae\x13\x13;b5" +
-	"\xde\xd1\xe6\x99\xed\xed\xbf,\xdbOF\x1d\xf7N\x9e\xe8" +
-	"\xfd!~\xf2\xd6\x0c\x11\xcc+\xfc\xa8y\x8d\xef\"2" +
-	"\xef\xd1>1\xdf\xd3t\"\xaf\xad\xf7\xea\x8d\x17.7" +
-	"\xbdD\x86\x09\"\xf5\xaa\xfd\x90\xd6\x00\xd2\xbc\x0boL" +
-	"\x9d\xfb<\x7f\xfe\x152\xfe\x03\xa2\x18\xd4\xa7\x09\xad\x03" +
-	"\x04s\xaf\xd6I\xf0\x96\xdf\xbe\xf7\xb0\xbe\xdb\x9d\"Q" +
-	"\x07\x165\x8a1\x9d\xc8<\xae}\x1ct0\xa7\xb4\x9f" +
-	"\x08\xde\xd6\xe9[\x83\xcb\x1f0\xdf%a\x82E\x10{" +
-	"\xa03h\xe6\x81\xd8M\xf3HLU\x1f\x8a\x8d\x11\xbc" +
-	"\xfd\x97\x1a\xde\xbf\xfd&;\xa3\xaa1\xa7\x9a\x13\x997" +
-	"b\xdf\x9bw\xfd\xea\xdfc\xa7\x08\xde\xf1[_\xfdo" +
-	"Zk\x9b^X\xad\x01\xe6\xc1\x9a\xcf\xccc5\x0a\xfd" +
-	"\x91\x9a&\x10\xbc\xdc\xc5\xd5g\xff\x98}v:\x189" +
-	"\x18lJ_\xaa\x06;\xad\xfb\x83Ml=\xf3a\xf7" +
-	"\x17\x17\xe7a\x0d\x06\xbb\xa4\x7fi^\xd1\xd5\xd3e]" +
-	"\x0d\xa6\xfd\xb7\xee\x83\xc37\xdf\xfezasFd\x9e" +
-	"\x8e\xdf1/\xc4U\xf5\xf9\xb8\x1a,\xb7\xfa\xff\xbfm" +
-	"\xfb\xae\xe5r\xa9\xb7:\xb1}I\xe2_\xaa\xf7\xb2\x84" +
-	"*\xb8\xfeM\xd3\xb9\x8d\xd3}\xd7*\xf48\x98`J" +
-	"\x0fs\xe6\x9dM\x0d\xd6\xd5\x1f+a\x8f$\x1a\xd4\xbf" +
-	"N$\x14l~\xff[g\xd2'^\xfb\x99\x0c\x93G" +
-	"@\x08\xe6\xb1\xc4\xb7\xe6T\xc2W%\xb1\xc9\xbc\xa8\x9e" +
-	"f\xbb6|\xfa\xc8\xf1C\xbf\x06gq\xf5\xedl\xe2" +
-	"\x0e\xc1<\x9f8E\x8fy\xe9\xdc\x88\xe3J{\x0d\xd2" +
-	"\xd6pa\xb8\xe3\xd1,\x97c\xe2\xdf\xa8\xec\xd1\xd2\x1d" +
-	"\xa9d\xac\xea\x8b\x046Vm\x8f\x141V\xad\x8f\xe8" +
-	"4VtDt\x19\xcb\x06'{\xad\xc2PN\xda\xde" +
-	"V\x99\x93i\xb7h\x13\x91\xf7`\xb1\xe0\xb8\xb6\x95%" +
-	"^p\x9b6\x17\x86\xe4x\xe7\x80L\x17\xed!o\x8b" +
-	"\xf5\xf4\xa0\x1c\x90i\xd2\x8b\xf6\x90\xa8\xe51\xa22\x9f" +
-	"\x08\xd91D\x071\xa3G\x07\xc2\x11+(\xbd\xaf\x95" +
-	"\x98\xd1\xa6\x83\x95m\x8f\xd0\x0c\xc6\x8anb\xc6\x12\xbd" +
-	"3W,>52\xdc\x85T\xd6\x95v\x17&m9" +
-	"*mGv\xa1\x1f(\xf3\xc2C^\xe4\xd8\x9aRA" +
-	"s\xbfe[y8UkJ\x83\xae\xb1ez\xb4\xb9" +
-	"\xd3\xaft\x84\xc65\"\x0dDF\xb2\x83H\xc49D" +
-	"#C\xa7\xedO\x8b\xfa\x887\x02\xea)j\xce\x82\x83" +
-	"{\xa5e\xbb\x83\xd2r\xa9\x1f\x10\xf5\xe5\xc3\xac\x95D" +
-	"b\x07\x87\xc80\x18@#\xd4K\xa9:<\xc1!r" +
-	"\x0c`\x8d`DF\xb6\x95H\x0cq\x88a\x06\x83\xa3" +
-	"\x11\x9c\xc8\xc8\xab\x97\x19\x0e\xb1\x87Aw\xdd\x1c\xe2\xc4" +
-	"\x10't:\xd2\x1e\x956\x12\xc4\x90 \xa42E\xc7" +
-	"E-1\xd4\x12Ry\xe9Z\xa8#\xf4s\xf8\xef\xea" +
-	"\x16\xa2\xf5i\xf0\xb5m\xb2\xadl\xc1U\x98\xe3\\\xab" +
-	"\xf5<\x1ft\xcbz\"\xd1\xcc!\xd62$1\xeb\x05" +
-	"\xa8\xdb\x96\x12\x89\xd5\x1cb\x03CS.\x9b\xcf\xbaa" +
-	"\x7f\xee\x16Q\x1f\xd9\xac:C~O\xdf[\xba[\xb4" +
-	"U\xc7\xda\xa8c\x8f\xa2\xa9\x8bC<\\\xd9q\xb3\xc2" +
-	"\xb1\x91C\xf43$\xd9]/`j\x8b\"\xa5\x97C" +
-	"lc\xd0\xad\\\x8ej\x9a\xf2\x96\x9b\xce,\x84\x90\xda" +
-	"i\x17\xf3\x7f\x13\x99on\xf2\xd5k,\xab7\xa1\x84" +
-	"\x1a\x0f\xf8O\xc2+\xc1z^\x01x\x86C\xecS\xb0" +
-	"fK\xb0\xf6\xaa\xda\xe78\xc4\xcb\x0cI\xae\xc0*\x05" +
-	"_T\xb5{8\xc4\xab\x0cIm\xc6k\x84Fd\x1c" +
-	"Po\xf7q\x88\xd7\x19:\x87m\xb93;\x0e\x10\x03" +
-	"\x08\xa9a)\xedP\xcbP\xe7$1$\xab\xea\\\xfa" +
-	"c\xd1]\x18\x90\xceH\x8e\xbbs<\xde\x1ay<5" +
-	"\x9a\x95c0*C\x0a\xc6_\xb0\xe4/?\x86\xe6y" +
-	"\xbc5\xf2x5\x8b\x1b\x0c%\x8f\xaf\xac\xe6\xf1\x01\"" +
-	"\x91\xe3\x10\xe3\xac\xfa\xf0%\x93\xe9\x8e\xdc\x1d>{\x99" +
-	"\xd2\xba\x11\\\xb5\x9b\xe1\xdd\xb7\x88\xbe~^\x95\xc2k" +
-	"\xbe\xdd\xbb\xab\xda\xbd5\xb2\xfbd\xa1\xe8f\xb2\x85]" +
-	"T\x93zr\xc4q\x17\x89\x83J\xfe\x83\xf0\x0a\xa2\xc8" +
-	"\xf1\xf9\x0e\x19k\xe9\x8b\xce.\xa7\xc2\xbaA\"\xb16" +
-	"\xd8\x01\xcf\x89B\x18\xf5Q\xb6\x87\xdd\xc2X\xd6\x0b\xae" +
-	"\x13\xeez}\x94\xfa\x849[\xafW\x80RA\x1a8" +
-	"\xc2u(,X\x08:\xa8\xc0\"\xb9\xe8\x7f\xaf\x8f\xae" +
-	"\x90E\xb8\xf7\x03\x97K\x7f\xe95\xff\xa6\x08\x7f\xce\xa0" +
-	"p\xfa\xa3\xb1\xf6\xa3\x8f\x1f1\x0cu\x1b\xc4\xf4\x94\x0a" +
-	"\xe5\xb9\xf1\xce\xe6\xa3\x0f#\xbb\xb6\x0c\xad\xa7;\x8a\x8f" +
-	"2\x9f\x9b\xfb\xa2\x9c0X)f\x85\"\xb9?\xf0\xe5" +
-	"d&\xb8\x08`D7i\xc9\xfb\xff\x04\xfd\x7f\x06\x00" +
-	"\x00\xff\xff[\xe1\xb8\xd5"
+// Meta_update_Results_Future is a wrapper for a Meta_update_Results promised by a client call.
+type Meta_update_Results_Future struct{ *capnp.Future }
+
+func (f Meta_update_Results_Future) Struct() (Meta_update_Results, error) {
+	p, err := f.Future.Ptr()
+	return Meta_update_Results(p.Struct()), err
+}
+
+const schema_fcf6ac08e448a6ac = "x\xda\xacX}lUg\x19\x7f\x9e\xf7=\xf7\x9e[" +
+	"\xda\xdb{\xdf\x1e\x88n\xa6\xb9\xc8\xba\x095\x10h\xb7" +
+	"E\x1aIK\x85\x00\x0d\x98\xbe\xed\xa6a\xd1\xe8\xe9\xed" +
+	"\x0b\xbd\xec~\xb4\xe7\x9e\xb6\x90\x88l\xd9\x87s[\x8c" +
+	"\xd1\x19\x91\xe84s# \x8b\x8bL\xe74b\xa6\x86" +
+	"\x18H\x99V\x87\x8ce\x0d`@\x07\x19\x13\x08_e" +
+	"m\x8fy\xce\xbd\xe7\x83\xdb\x0b\xc9\xa2\xff\x9d\xfb\xbe\xcf" +
+	"y\xbe~\xcf\xf3{\x9es\x97\x9e\x8bth\xcb\xe2\x89" +
+	":`\xf2\xd9H\xd4\x99\x18\xbd\x7f\xcb\xcc\xe97\x1e\x01" +
+	"apgW\xd3\xd4C\xad\x17\x1a\x9f\x06\xc0\xd6\xf3\x11" +
+	"\x86\xc6dD\x070\xaeD\xd6\x18\x8dQ\x1d\xc0\xf9\xd3" +
+	"\xab;\xf27v\xefy\x0a\x84\x81\x00\x1a]F\xa2\x97" +
+	"@\x0b\xde\x14\xf5\xdcyy\xf7\xda\xd3\xb1\x97\xafN\x01" +
+	"\xa0q6\xb2\xcb\xb8\x18\xf9>@k.\xaa\xa3\x11\xd7" +
+	"I\xcd\xe2\xb5'.>v<\xf5L\xa0\xa6\xf5J\xb4" +
+	"\x01As\x0e<\xbf\xf7\xb57s\xbf\xf9\x16\x88O " +
+	"@\x04\xe9j\"\xda\x86\x80\xc6\x99h;\xa0\xb3\xfeZ" +
+	"\xd7>{<\xf7\x1d\x90\x06\xb2\xc0\xecj\xa6\xbb\xce\xe8" +
+	"\xe7\x0cAO\xadq\xfd\xcf\x08\xe8\xfc\xec\x0f\xd3?\xbf" +
+	"\xd0\xf3\xc1s%K\xae>c<v\x03\xd08\x1a#" +
+	"u\x913\xaf\xbf\xbe\xaa\xfe\xe0\xf3!O\"5\xae'" +
+	"\xdb\xff\xfe\xcb\x9f\x1e\xbb\xe7\xf3{\xc9\x10\x86\x0c\xa1\xce" +
+	"\x01\x8c\xf3\xb1\xc3\xc6d\xccMN\xec\xdf\x80\xce\xfck" +
+	"\xf7\xef\xd4\x87\xec\xbd \xeb\x91\x05\x09\x88p\x129T" +
+	"\xf3Gc\xbc\xe6c\x00\xad\xc7kR\xe4\xd5\xcao?" +
+	"\xb1t(]\xbb\x0f\xe4\xc7\x91\x07\xef\xba\xf9\x9c\x9cs" +
+	"\xce\x88\xd4\xd2\x13\xd6\x92\xe6\xde\xb1\xab}\xf3W\x18\xfb" +
+	"*\x03F\x9d\xa3f\x1c\xad\xbdd\x9cr\xa5'j_" +
+	"\x01t\x9e\x1eo\xf8\xf5\xb5\x1f\xb3\xfd\xd5\xbd\x1e\xae\xfb" +
+	"\xa7\xf1h\x1dIo\xaf#\xe9\x1f\xee\xf9\xd4\xe1\xa1\xe7" +
+	"^xu\x16j\xf3\xe2\xbb\x8c\xc68\x09\xde\x11\xff\x86" +
+	"\x91\xa1'g\xce\x89-\x13W\x8f\xd5\xfc\xb6\x9cJF" +
+	"\xb9\x92\xf19\x04\xcd\xc6\xf8(\xa0sq^|\xa3q" +
+	"\xfd\xc9\x03\xa1\\\x1e\xa5{\xcdy\xe9\xea[\xf7\x8ci" +
+	"\x8b\xc7f\xc7\x10C4\x0e\xc4\x0f\x1b\x87\xc8D\xeb\xc1" +
+	"\xb8\x83\x80N\xf6\xc8\xc2_}8\xf3\xf5\xb1\x10h\xad" +
+	"\x07\x13w\x92\xa5#\x09Bm\xfe\xf6\xde\xfd\xbf\xef\xfc" +
+	"\xcb\x91\x0a}\xaeO\xc6\xf9\xc4\xdf\x8c\xc9\x84\x0bM\x82" +
+	"\x12x\xd7\xe8\x935\x8f\xadI\xbd\x19\xd6v$\xc9H" +
+	"\xdbx\x92\xb4iw\xd5\xffn\xe7\xa5\x17\xff1;g" +
+	"\x0c\xc0\xb8\x98\xbcaL']d\x92\x14ev\xe1\xa7" +
+	"\xaf?pr\xd1\xf1p\x1a\x1e\x14n\x1a\xbe,H`" +
+	"\xfb\x17\xd7\xa7\xba\x9e\x89\xbd\x03b\xbeoo\\\xf4\x91" +
+	"\xc0\x84 {\xff9\x96zm\xd5X\xd7\x99P\x9e\xa6" +
+	"\x05\xa3<\x19S{\xd64\x98'\xfe\x15v\xf5\x94h" +
+	"\xa0W\xcf\xba\xaf\xf2\xcf\xbe\xb0?\xbd\xfb\xbb\x1fTv" +
+	"\xabQ\xd3\xf0\x8e1\xaf\x81\xbc\x14\x0dk\x8c\xe5\xf44" +
+	"\xd3q\xef\xa1\x07_\xfa\xde\xe5\x92.\xb7\x10?\xd9@" +
+	"\x95\x7fw\xc3+\xb0\xd1Ig\x87\x8b\xb6\xb2\x96\xf0\xb4" +
+	"9\x98\x1fl\xfbBF\x8d.Y=\xa2\xf2\xf6Z3" +
+	"\xdf\x9fU\x16t#J\x8dG\x00\xfc\x880\xff\x8b7" +
+	"F[w}\xe5\x07B4\x03\x13\x11=a\xa9\xf4H" +
+	"\x07v#\xfa\xeaXI\xdd\x06e\x9bK6+\xbb\xa9" +
+	";eZf\xae\xe8\xdf\xa3g\x8e\xabQ\xd9\x84\xe1\x90" +
+	"Vv\x86\xd8h\xc5\x96\xa0\x03\xc5\x8a\x96\xa0\xb0\xc5\xf2" +
+	"\xae\xa0'\xc4\xf2\x87\x02F\x10\xcb{\x82J\x13\xcb[" +
+	"\x822\x11\xf7\xb5\x05(\x8be};\xca1:^\xc0" +
+	"\x90\xa0\x9f)\xf7\xa7\xd3\xab\xb2*m\x17,\x00p>" +
+	"W\xc8\x17m\xcb\xcc\x00\xcf\xdbN\xb7\xa5\xfa3i\xd3" +
+	"\x06T\xa9u\xf9~\xb5\xb5\xbdG\xa5\x0bV\xbf\xb3\xc1" +
+	"\xdc\xd6\xa7zT\x1a\xf4\x82\xd5/\x93n\xd2\xbc:A" +
+	"\x0fT1\xd4\x06L(\x1d\xd1C&T\x09\x1b)\xa1" +
+	"\x1btd>7\xa2\xd7\x05be'0q\x9f\x8e\xdc" +
+	"/e\xf4ZM,j\x01&\x1a\xf5\xf6l\xa1\xf0\xf0" +
+	"\xf0`\x07&2\xb6\xb2:p\x87\xa5F\x94UT\x1d" +
+	"\x98\x1a5\xed\xf4\xc0\xcd\x18\x85!/\x0b6u\x13L" +
+	"X\xac*S\xce\xd6\x12B\xbb\xa9\xdd\x95,J\x8dk" +
+	"\x00\x1a\x02\x88x\x1b\x80\x8cq\x94s\x19\xb6[nF" +
+	"0\x19$\x1f\x10\x930\xab@\\\xc5nB\x13i\xd3" +
+	"VTl\xf3}\x8d\xe3\x0b\x00\xe4\x18G\xf96\xc38" +
+	":\xce\\\xa4\xd3\xa3d\xe7\xaf\x1c\xe5\xbb\x0c\x1b\xd9\x8c" +
+	"\x83s\x91\x01\x88\xe3w\x02\xc8\xb78\xca\x93\x0c\x1b\xf9" +
+	"4\x1ds\x001A\xd2os\x94\xa7\x196jSt" +
+	"\xac\x01\x88S$\xfd.G\xf9\x1e\xc3\xc6\xc8\x87t\x1c" +
+	"\x01\x10g\xc8\xe2I\x8e\xf2}\x86\x8d\xd1\x1bt\x1c\x05" +
+	"\x10gI\xfa4Gy\x81a\xa3>I\xc7:\x808" +
+	"O\xd2\xefq\x94\x97\x19\xea\x0f\xabmX\x07\x0c\xeb\x00" +
+	"\xdb\xd5\xd6L\xd1.B\x94\xab!\xffl\xd0R\x9b2" +
+	"[\xbd\x9f<kc-0\xac\x05\xd4\xb3\xb6\xf2\x9e\xf9" +
+	"\xe6\xe0xsp|\xcb\xb6\xeaiW\xc5\xe1\xac]\x15" +
+	"\x86&\x86\xed\x9b2*\xdb_\xc4z\xc0n\x8e\xae\xed" +
+	"z\x98U\x00\xae\xb6\xe1\xc1~\xd3VM=\xae>," +
+	"VZ\x0cx\x01\\FH\xfa\xf6L\xb2\xf7%\x8er" +
+	" \x0c\x92j\x06\x90_\xe5(\xb3\x0c\xe3l\xc6)a" +
+	"\x94!\xd9~\x8er\x90a\x9cO;%\x88r-\x00" +
+	"r\x80\xa3\xb4oS8\x89-\x85L\x1e\xa2\xed%?" +
+	"!\x9a\xca*sDA\xb4\xd2\xd1\xb5\xca\xb4\xec>e" +
+	"\xda 5\x0c\x8dX\x81\xcd\x89\xf5\x05\xb3_\xce\xf5=" +
+	"\xdfN\xf0m\xe5(\x1fg(\x10K\x8e?J.~" +
+	"\x8d\xa3|\x8a!\xb2\x92\xdbOP0\x8fp\x94\xcf2" +
+	"\x14\xbc\\X\xdf\xa4\xc3\xc79\xca\x9f0\x14\x1a+\x95" +
+	"\xd5\x8f\xe8p'G\xf9\"C\xdd\xb6\xb3\x18\x03\x861" +
+	"\xc0\xf6\xa2\xb2F\x94\x855\xc0\xb0\x0601P(\xda" +
+	"^)$r\xca6+ Jd\x0b&%\xc1w\xbf" +
+	"z\xf7\xf8\xc1.qc\xab\x00\xa6%\x00\xc6\x0fO\xb5" +
+	"\x05\xb8\x08Vn\x9d\xcc\x82\x00\x16\xc1\xb5\xd9\xa8\xa4\x06" +
+	"\xadB\xba\xe8\x07\x93S\xb9\x82\xb5\xcd\x0bFO\x0f\x0e" +
+	"\xe3\x1c`8\x070U\xcc\x16l_\xb0j\x11\xb9T" +
+	"\x9a\xb2\xccL\xde&\x7f\xeb\xb8V\xe78\xae\xc3\xab\xc9" +
+	"b\x07G\xb9\x9e*i\xa6\\I\xeb\xa8\xf7Vq\x94" +
+	"\xddTI\xd3\xe5J\xda@\xb2k9\xca\x07\x18\xa6\xb2" +
+	"\x99\\\xc6\xf6\xdc\xe1v\x01\x93\xc1\x00(%.5:" +
+	"\xa0,\x85\xc9`J\xdc\x86\x8e\\\xe2\xd7\xed\x82U\xe9" +
+	"\xdf\x82\xaa\xfe\xb5T\xf5\xaf9\xf0O7\xb3Y\x88\xa6" +
+	"r\xc4\xc0\xb3]Kl\xb2\x0a\xb9\xd9\xc7\x95\x93r\x83" +
+	"\xe2\xb6I\x0e\xc5x$\xb4\x81\xa3\xb7\xd9\x8ae\x0b\x80" +
+	"\x89\xbbuD\x7fCCo\xab\x15w\xd0\xc8\x89\xeb\xfa" +
+	"few`\xb9\x85\xaa\xce\x820\x15\x94\x08\xde%\x13" +
+	"\xaf\xa4\x16Q\x06\x9a8\xca\x8ePI\xad\xa0\x04|\xa6" +
+	"\x1cjQ\xd9\x15\xa5\x9c\x1a\xce\xcf>\xac:_\xdc\x11" +
+	"Uf\xa0\"@Ul\xdcy[\xa2\xa0&\xdf\xad\xf3" +
+	"m\x01\x0f\x07\x14t\x91 x\x9f\xa3\xbc\x1e\xa6\xa0+" +
+	"${\x81\xa3\x9c\x0aS\xd0$\xc9^\xe6\xd8\x83\x0c\xe3" +
+	"\xda\x94S\xea\xe6i:\xbd\xce\xb1W\xc3\xf0\x940\x10" +
+	")\xe4)\x8e\xbd1\x0c\xcf\x09#\x82m\x00=\xc8\xb1" +
+	"\xb7\x0e\xc3\x93\xc2\xa8\xc1\x05\x00\xbd\x1a\xdd$\xe9&v" +
+	"\x9dnb\x00F\x9ct\xf5\xc6\xe8f.2\x7fL " +
+	"0D\xc0\xc4\xa0R\x96?B\xca$\x12\x07\x86\xf1\xaa" +
+	"$R\xfe\xf1\xbf\xb6k\xb5\xc5\xc0\x85\x85\xdf<i\x9a" +
+	"\x83\x81\x9f\x18\xc9\xa8Q\x14\xe1e\x14\xc5-\xfa\xcb\xdd" +
+	"\x96\xb0\x92\xad\x9a\x03\xb6\xfa\x08dU&\xe3\\\x0f\x80" +
+	"\xccr\x94[Y\xf5\x9cy\xc1\x17\xd5\x90\xf7\xec\x0c\x94" +
+	"\xd9\x13\xd0\xc6d\xf0\xf9U\xd1\x7f\xb3+\xb4\xdc\x18\xe1" +
+	"Lt\x06\x99\xd81PZ\x93P\x04k\xecm\x92\xe1" +
+	"\xee\x8c\xe5\x05\xb2\xd4\xde>\xdf,\xea,w\xdb\xd20" +
+	"\xdf,\xa6D-\xe4(\xefe\xb8#_\xb0\x072\xf9" +
+	"\xcd\x10Ml\x19.\xda\xb7Y\xb7\xc2Q\x94\x96\xc4\xaa" +
+	"\xfd\xdd\x15\xe8\xf6\xfb{Y\x1f\x80\\Z\xa2=\xa7\x18" +
+	",\xc5\x98\x0c\xb6q\xcf\x9a\xb7&\xeby\xdb\xdf:\x92" +
+	"\xc1\x9e\x0exS\xf3k\xb7\xf8\xe6(m\x98\xdd\xa6\xa5" +
+	"Wl\x98-A\x9aS\x8a^\xc0d\xf0yP\x11\xb1" +
+	"\x1eRN\xdb\xb0O,\x9e\xc0\xec\x8cx\xcb\xcf\xad\x97" +
+	"Z\xf7>\x19|D\xdcf\x8a\xb8\xb1pe\x05\xdfO" +
+	"\xde\x9f\x1a\x1f\xe1\xfb)\xf0\xde\xdb\xb7\xeb|\xd7Vw" +
+	"\x06\xe3\xc8\x07k]W0w\x04+\xef/\x92\x10\xec" +
+	".\xf5Q\xa8<\xfdO\xaery\xfe?\xb0\xfd\xef\x00" +
+	"|H\xe2i"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_fcf6ac08e448a6ac,
 		Nodes: []uint64{
+			0x80c0e4fe6a3677dd,
+			0x88a8a6fa6e7fb3c3,
 			0x8a1df0335afc249a,
 			0x8b1fd983f1df482d,
 			0x8eb96dceb6a99ebd,
+			0x926dd174ab4af74c,
+			0x94ee52f0aefdc1aa,
+			0x9ec50e44b8b8e505,
+			0xa94e26d7a3b4d37d,
 			0xa97471079836f720,
+			0xab0b637130859041,
 			0xab133d2062f6cc53,
 			0xb2029ff7b712d18a,
+			0xb3a29471ca27a89c,
+			0xba09d7f6dd6adf0a,
+			0xbd86f813590d15f1,
 			0xcc2d04cc26d4f6a5,
 			0xcc7efefbb528cd6c,
 			0xcdcf42beb2537d20,
+			0xce1f478309867723,
 			0xd6a4f298bc0e2304,
 			0xd929e054f82b286c,
+			0xda088b4a1f4c577d,
 			0xe54acc44b61fd7ef,
 			0xe6df611247a8fc13,
 			0xee93a663b2a23c03,
@@ -9,8 +9,10 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	capstore "github.com/wetware/pkg/api/capstore"
 	cluster "github.com/wetware/pkg/api/cluster"
 	process "github.com/wetware/pkg/api/process"
+	pubsub "github.com/wetware/pkg/api/pubsub"
 )
 
 type Signer capnp.Client
@@ -672,12 +674,12 @@ type Session_local Session
 const Session_TypeID = 0xc65521f186b6e059
 
 func NewSession(s *capnp.Segment) (Session, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 10})
 	return Session(st), err
 }
 
 func NewRootSession(s *capnp.Segment) (Session, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 10})
 	return Session(st), err
 }
 
@@ -788,12 +790,137 @@ func (s Session_local) SetHost(v string) error {
 	return capnp.Struct(s).SetText(2, v)
 }
 
+func (s Session) Executor() Executor {
+	p, _ := capnp.Struct(s).Ptr(3)
+	return Executor(p.Interface().Client())
+}
+
+func (s Session) HasExecutor() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Session) SetExecutor(v Executor) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(3, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(3, in.ToPtr())
+}
+
+func (s Session) PubSub() pubsub.Router {
+	p, _ := capnp.Struct(s).Ptr(4)
+	return pubsub.Router(p.Interface().Client())
+}
+
+func (s Session) HasPubSub() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s Session) SetPubSub(v pubsub.Router) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(4, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(4, in.ToPtr())
+}
+
+func (s Session) CapStore() capstore.CapStore {
+	p, _ := capnp.Struct(s).Ptr(5)
+	return capstore.CapStore(p.Interface().Client())
+}
+
+func (s Session) HasCapStore() bool {
+	return capnp.Struct(s).HasPtr(5)
+}
+
+func (s Session) SetCapStore(v capstore.CapStore) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(5, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(5, in.ToPtr())
+}
+
+func (s Session) Anchor() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(6)
+	return p.Interface().Client()
+}
+
+func (s Session) HasAnchor() bool {
+	return capnp.Struct(s).HasPtr(6)
+}
+
+func (s Session) SetAnchor(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(6, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(6, in.ToPtr())
+}
+func (s Session) Meta() cluster.Meta {
+	p, _ := capnp.Struct(s).Ptr(7)
+	return cluster.Meta(p.Interface().Client())
+}
+
+func (s Session) HasMeta() bool {
+	return capnp.Struct(s).HasPtr(7)
+}
+
+func (s Session) SetMeta(v cluster.Meta) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(7, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(7, in.ToPtr())
+}
+
+func (s Session) Boot() process.BootContext {
+	p, _ := capnp.Struct(s).Ptr(8)
+	return process.BootContext(p.Interface().Client())
+}
+
+func (s Session) HasBoot() bool {
+	return capnp.Struct(s).HasPtr(8)
+}
+
+func (s Session) SetBoot(v process.BootContext) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(8, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(8, in.ToPtr())
+}
+
+func (s Session) Scheduler() Scheduler {
+	p, _ := capnp.Struct(s).Ptr(9)
+	return Scheduler(p.Interface().Client())
+}
+
+func (s Session) HasScheduler() bool {
+	return capnp.Struct(s).HasPtr(9)
+}
+
+func (s Session) SetScheduler(v Scheduler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(9, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(9, in.ToPtr())
+}
+
 // Session_List is a list of Session.
 type Session_List = capnp.StructList[Session]
 
 // NewSession creates a new list of Session.
 func NewSession_List(s *capnp.Segment, sz int32) (Session_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 10}, sz)
 	return capnp.StructList[Session](l), err
 }
 
@@ -817,6 +944,32 @@ func (f Session_local_Future) Struct() (Session_local, error) {
 	p, err := f.Future.Ptr()
 	return Session_local(p.Struct()), err
 }
+func (p Session_Future) Executor() Executor {
+	return Executor(p.Future.Field(3, nil).Client())
+}
+
+func (p Session_Future) PubSub() pubsub.Router {
+	return pubsub.Router(p.Future.Field(4, nil).Client())
+}
+
+func (p Session_Future) CapStore() capstore.CapStore {
+	return capstore.CapStore(p.Future.Field(5, nil).Client())
+}
+
+func (p Session_Future) Anchor() capnp.Client {
+	return p.Future.Field(6, nil).Client()
+}
+func (p Session_Future) Meta() cluster.Meta {
+	return cluster.Meta(p.Future.Field(7, nil).Client())
+}
+
+func (p Session_Future) Boot() process.BootContext {
+	return process.BootContext(p.Future.Field(8, nil).Client())
+}
+
+func (p Session_Future) Scheduler() Scheduler {
+	return Scheduler(p.Future.Field(9, nil).Client())
+}
 
 type Executor capnp.Client
 
@@ -834,7 +987,7 @@ func (c Executor) Exec(ctx context.Context, params func(Executor_exec_Params) er
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 3}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 5}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_exec_Params(s)) }
 	}
 
@@ -854,7 +1007,7 @@ func (c Executor) ExecCached(ctx context.Context, params func(Executor_execCache
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 3}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 5}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_execCached_Params(s)) }
 	}
 
@@ -863,6 +1016,66 @@ func (c Executor) ExecCached(ctx context.Context, params func(Executor_execCache
 
 }
 
+func (c Executor) Ps(ctx context.Context, params func(Executor_ps_Params) error) (Executor_ps_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      2,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "ps",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_ps_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Executor_ps_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Executor) Watch(ctx context.Context, params func(Executor_watch_Params) error) (Executor_watch_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      3,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "watch",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_watch_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Executor_watch_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Executor) Supervise(ctx context.Context, params func(Executor_supervise_Params) error) (Executor_supervise_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      4,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "supervise",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 2}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_supervise_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Executor_supervise_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c Executor) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -939,6 +1152,12 @@ type Executor_Server interface {
 	Exec(context.Context, Executor_exec) error
 
 	ExecCached(context.Context, Executor_execCached) error
+
+	Ps(context.Context, Executor_ps) error
+
+	Watch(context.Context, Executor_watch) error
+
+	Supervise(context.Context, Executor_supervise) error
 }
 
 // Executor_NewServer creates a new Server from an implementation of Executor_Server.
@@ -957,7 +1176,7 @@ func Executor_ServerToClient(s Executor_Server) Executor {
 // This can be used to create a more complicated Server.
 func Executor_Methods(methods []server.Method, s Executor_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+		methods = make([]server.Method, 0, 5)
 	}
 
 	methods = append(methods, server.Method{
@@ -984,6 +1203,42 @@ func Executor_Methods(methods []server.Method, s Executor_Server) []server.Metho
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      2,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "ps",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Ps(ctx, Executor_ps{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      3,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "watch",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Watch(ctx, Executor_watch{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      4,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "supervise",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Supervise(ctx, Executor_supervise{call})
+		},
+	})
+
 	return methods
 }
 
@@ -1021,6 +1276,57 @@ func (c Executor_execCached) AllocResults() (Executor_execCached_Results, error)
 	return Executor_execCached_Results(r), err
 }
 
+// Executor_ps holds the state for a server call to Executor.ps.
+// See server.Call for documentation.
+type Executor_ps struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_ps) Args() Executor_ps_Params {
+	return Executor_ps_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_ps) AllocResults() (Executor_ps_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_ps_Results(r), err
+}
+
+// Executor_watch holds the state for a server call to Executor.watch.
+// See server.Call for documentation.
+type Executor_watch struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_watch) Args() Executor_watch_Params {
+	return Executor_watch_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_watch) AllocResults() (Executor_watch_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_watch_Results(r), err
+}
+
+// Executor_supervise holds the state for a server call to Executor.supervise.
+// See server.Call for documentation.
+type Executor_supervise struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_supervise) Args() Executor_supervise_Params {
+	return Executor_supervise_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_supervise) AllocResults() (Executor_supervise_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_supervise_Results(r), err
+}
+
 // Executor_List is a list of Executor.
 type Executor_List = capnp.CapList[Executor]
 
@@ -1036,12 +1342,12 @@ type Executor_exec_Params capnp.Struct
 const Executor_exec_Params_TypeID = 0x969e88e97ed79d94
 
 func NewExecutor_exec_Params(s *capnp.Segment) (Executor_exec_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
 	return Executor_exec_Params(st), err
 }
 
 func NewRootExecutor_exec_Params(s *capnp.Segment) (Executor_exec_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
 	return Executor_exec_Params(st), err
 }
 
@@ -1145,13 +1451,60 @@ func (s Executor_exec_Params) NewArgs(n int32) (capnp.TextList, error) {
 	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
 	return l, err
 }
+func (s Executor_exec_Params) Limits() (process.Limits, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return process.Limits(p.Struct()), err
+}
+
+func (s Executor_exec_Params) HasLimits() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Executor_exec_Params) SetLimits(v process.Limits) error {
+	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+}
+
+// NewLimits sets the limits field to a newly
+// allocated process.Limits struct, preferring placement in s's segment.
+func (s Executor_exec_Params) NewLimits() (process.Limits, error) {
+	ss, err := process.NewLimits(capnp.Struct(s).Segment())
+	if err != nil {
+		return process.Limits{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Executor_exec_Params) Caps() (process.Cap_List, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return process.Cap_List(p.List()), err
+}
+
+func (s Executor_exec_Params) HasCaps() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s Executor_exec_Params) SetCaps(v process.Cap_List) error {
+	return capnp.Struct(s).SetPtr(4, v.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated process.Cap_List, preferring placement in s's segment.
+func (s Executor_exec_Params) NewCaps(n int32) (process.Cap_List, error) {
+	l, err := process.NewCap_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return process.Cap_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(4, l.ToPtr())
+	return l, err
+}
 
 // Executor_exec_Params_List is a list of Executor_exec_Params.
 type Executor_exec_Params_List = capnp.StructList[Executor_exec_Params]
 
 // NewExecutor_exec_Params creates a new list of Executor_exec_Params.
 func NewExecutor_exec_Params_List(s *capnp.Segment, sz int32) (Executor_exec_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
 	return capnp.StructList[Executor_exec_Params](l), err
 }
 
@@ -1165,6 +1518,9 @@ func (f Executor_exec_Params_Future) Struct() (Executor_exec_Params, error) {
 func (p Executor_exec_Params_Future) Session() Session_Future {
 	return Session_Future{Future: p.Future.Field(0, nil)}
 }
+func (p Executor_exec_Params_Future) Limits() process.Limits_Future {
+	return process.Limits_Future{Future: p.Future.Field(3, nil)}
+}
 
 type Executor_exec_Results capnp.Struct
 
@@ -1257,12 +1613,12 @@ type Executor_execCached_Params capnp.Struct
 const Executor_execCached_Params_TypeID = 0xb52aad0122df1319
 
 func NewExecutor_execCached_Params(s *capnp.Segment) (Executor_execCached_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
 	return Executor_execCached_Params(st), err
 }
 
 func NewRootExecutor_execCached_Params(s *capnp.Segment) (Executor_execCached_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
 	return Executor_execCached_Params(st), err
 }
 
@@ -1366,13 +1722,60 @@ func (s Executor_execCached_Params) NewArgs(n int32) (capnp.TextList, error) {
 	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
 	return l, err
 }
+func (s Executor_execCached_Params) Limits() (process.Limits, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return process.Limits(p.Struct()), err
+}
+
+func (s Executor_execCached_Params) HasLimits() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Executor_execCached_Params) SetLimits(v process.Limits) error {
+	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+}
+
+// NewLimits sets the limits field to a newly
+// allocated process.Limits struct, preferring placement in s's segment.
+func (s Executor_execCached_Params) NewLimits() (process.Limits, error) {
+	ss, err := process.NewLimits(capnp.Struct(s).Segment())
+	if err != nil {
+		return process.Limits{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Executor_execCached_Params) Caps() (process.Cap_List, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return process.Cap_List(p.List()), err
+}
+
+func (s Executor_execCached_Params) HasCaps() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s Executor_execCached_Params) SetCaps(v process.Cap_List) error {
+	return capnp.Struct(s).SetPtr(4, v.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated process.Cap_List, preferring placement in s's segment.
+func (s Executor_execCached_Params) NewCaps(n int32) (process.Cap_List, error) {
+	l, err := process.NewCap_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return process.Cap_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(4, l.ToPtr())
+	return l, err
+}
 
 // Executor_execCached_Params_List is a list of Executor_execCached_Params.
 type Executor_execCached_Params_List = capnp.StructList[Executor_execCached_Params]
 
 // NewExecutor_execCached_Params creates a new list of Executor_execCached_Params.
 func NewExecutor_execCached_Params_List(s *capnp.Segment, sz int32) (Executor_execCached_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
 	return capnp.StructList[Executor_execCached_Params](l), err
 }
 
@@ -1386,6 +1789,9 @@ func (f Executor_execCached_Params_Future) Struct() (Executor_execCached_Params,
 func (p Executor_execCached_Params_Future) Session() Session_Future {
 	return Session_Future{Future: p.Future.Field(0, nil)}
 }
+func (p Executor_execCached_Params_Future) Limits() process.Limits_Future {
+	return process.Limits_Future{Future: p.Future.Field(3, nil)}
+}
 
 type Executor_execCached_Results capnp.Struct
 
@@ -1472,67 +1878,1550 @@ func (p Executor_execCached_Results_Future) Process() process.Process {
 	return process.Process(p.Future.Field(0, nil).Client())
 }
 
-const schema_e82706a772b0927b = "x\xda\xacUQh\x1cU\x14\xbd\xe7\xbdMg\xb3\x9b" +
-	"d\xf32+\xda\x8f\x1aZ7\xa8\x11C\x9b|\x08\xf9" +
-	"hBm\xa8)\x06\xf7\xa5\xed\x87\xa1\xa8\xe3\xecc\xb3" +
-	"0\x99\xd9\xccl\xda\x06\xa9\xb1 Z\x84 \xa9Fj" +
-	"\x8b~T\xaa\x154\xfaSAA\xb0P\x04\xfd\x91\"" +
-	"X\x14\xa5\x8a\x1f*\xf8\xd1\xa8A\xd4\x86\x917\xc9d" +
-	"v\xdb&E\xf0k\x1f\xfb\xee\x9e{\xee9\xf7\xbc\xdd" +
-	">\xc9\x07S;Z\xbfh#&\xdfi\xda\x14\xfe\xfe" +
-	"b9\xb7\xfb\xc7G\x9e!\x91\xe5\xe1S'\xde\xf7\xdf" +
-	"\xdat\xf7\xcfD0G\x8c3\xe6\x01\xc3 2\xa5\xf1" +
-	"\xbcyV\x9fBw\xe7\x9bo/\x8c\xcc\x1d#\x91\x07" +
-	"Q\x13\x0c\xa2\xbeY\xa3\x1f\x04s\xde\x18 \x84;\x97" +
-	".\x1e<\xba\xeb\xb1\x97Ht\xc4\x05\xe6y\xe37\x82" +
-	"\xf9at\xff\xf2k\x97\x9f\xfe\xe5\xf8\xeb\xaf\x90\xccC" +
-	"\x17p\x8d\xf0\x9d\xc14\xc2\x0f\xc6O\x84p\xeb\xf4\xd2" +
-	"\xfc\xd8\xc2\xc2\xe9\xfa\x16\x9f\xa5;t\xc1\xa5\xb4\x86\xb8" +
-	"pq\xf8\xd5{\xf2\xd9s$M |\xf4\xfb\x0f\x9e" +
-	"[\xdcz\xe0S\xba\x8d\x1b 2\x17\xd3\x9f\x13\xcc\xa5" +
-	"\xf4{\x84p\xb3ye\x1b\xde\xed>\xdf\xd0l\xb6\xb9" +
-	"7\xa2\xdb\xac\x9b-\x7f\xfc\xd1\xf2\xe2\x1b\x17>\xb9a" +
-	"\xf8\xe9\xcc\x19\xf3XF\xb3?\x9a\xd9c\x9e\xd5\xa7\xa4" +
-	"\x95\xcc\x02Iu\x04k\xcefN\x99\xf3\xd1\x0f\xe62" +
-	"\x87\x09\xa1qz\xd8\x9b\xbcv\xf2\xab\xfa1.e2" +
-	"\xba\xf5\xd7\x19=F\xfb\xb7Wn\xef8)\x7f\xadW" +
-	"\xea\xaf\xcc\xdf\x04s9\xbao{`\xb0\xfc\xc7\x9d\xfb" +
-	"\xfe\xac\x07\xd8\x92\x8d\x00\xba\xb2\xba\xe0\xf1\xb9\xcb3\xf2" +
-	"\xbe\x7f\x96o\xe0>\x94=a\x8ed5\xdepv\x8f" +
-	"9\x995(\xa4\xbd\xa1\xed\xf9\xaa\xc7\xb6\xaap\xab\xfd" +
-	"CG\x94=e\xd4<\xbf\x08\xc84o\"Z3\x06" +
-	"1q\xb1\xa3\x9b\x98\xe82\x90\xe8\x88\xd8~\xb1y\x8c" +
-	"\x98\x10FN\x1dQ\xf6 B\xfd\xf1\xa0e\x8f\x13W" +
-	"\xa5A\x14\x81\xb5n<\xeeV\xf3\xfc\x9e\xb8L\x95\x0a" +
-	"\xa3\x03*\x98rj\x81L\xf1\x14Q\x0aD\xa2u\x17" +
-	"\x91Ls\xc8<\xc3L\xd5\xf7l\x15\x04\x10a\xdf\xa6" +
-	"\xaes_^\xbd\xeb\x1b\"@P\x82\xcc\xdcj\xff\xbe" +
-	"J\xd9U~OP)\xbb\x85b\xa7\xe5[\x13\x0d\x80" +
-	"\xa3D\xb2\x85C\xde\xc1\x10\xda\xe3\x96\xe3(\xb7LP" +
-	"h%\x86V\xda\x80d\xa1\x18a\x91l_\x03\xb34" +
-	"\xbb\x83\x1cr\x9cA\x00\xd1B\x09\xb5\x97H\x968d" +
-	"\x95\x01,\x0fF$&\xba\x89\xe48\x87\xac1\x08\xce" +
-	"\xf2\xe0DbR\x7f\xe9p\xc8\xe3\x0c3\x81\x0a\x82\x8a" +
-	"\xe7\xa2=\xd9(\x02\xda\x09\xe1\x93\xd35e{%E" +
-	"D1\xc9\\\xb5Z)!M\x0ciB\xce\xf2\xcb\x01" +
-	"\xda\x08E\x0e\xb4\x10\xd3\xc7\x86)\xf6+\x7f\xa2\xe2Z" +
-	"N\x8f\xe3\x95+na4\x12\x19\xeb\xaa\xbc>\x93\x06" +
-	"\x95W\xaaz\x1c\xcf\xb6\x9cH\xd2T\xfb\xaa\x00Cz" +
-	"\xaeA\x0e\xf90\x83\x16E\x0b0\xdcO$ws\xc8" +
-	"\"\x83`\xab\x02\x8c\xe8\xc2\x878\xe4~\x86\\U)" +
-	"?\xa2\xdfB\x18\x08\x94\x7fH\xf9h&\x86fBn" +
-	"\xdc\x0bj\xf1\xdd-\xb7(\xf6\xfcV6m#\x92O" +
-	"pH'\xb1\xa9\xd2\x9dX\xb7f\xd3\x9aw\xcfn$" +
-	"\x8eaWJ\xff\xdd\x1e\xc4\xf6\x18\xae\xe5\xe8\xdc\xa5\xa2" +
-	"\xdc\xc51G\xfc\xee\x09\xd1KL4\x19\x9d\x91\x85\x8d" +
-	"iB\xec\x06\xf7\xdc\x95\xe8\xc6s\xdf\xab\x99\x178\xe4" +
-	"vm\x04\xea^Iq\x7f/\xb1\xdc\xa1\x8a:\x0c\x11" +
-	"\x9e*\\\x1b\xeb\xbb\xba\xe5\x85\xd50uF\x96n\x90" +
-	"\x83\x95\x05\x0a\x88\xfe\xbf\x9c\xde,\xf9\xfd\x09\xe2\x80." +
-	"R\xa5\x9bf\xf4\xba\xed^\x0d\xe9\xba\xdc,\xdb\xf6\xa6" +
-	"\xdc\x1aD\xf2T^\xc7\x0d17\xf8\x89#\xf1_\x18" +
-	"\xe2\x17Z\x88\xee\xc8\x91\x9c\xa6\x16\x19\xf2o\x00\x00\x00" +
-	"\xff\xff=\x0f\xd8\xce"
+type Executor_ps_Params capnp.Struct
+
+// Executor_ps_Params_TypeID is the unique identifier for the type Executor_ps_Params.
+const Executor_ps_Params_TypeID = 0xffdf64593702d802
+
+func NewExecutor_ps_Params(s *capnp.Segment) (Executor_ps_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_ps_Params(st), err
+}
+
+func NewRootExecutor_ps_Params(s *capnp.Segment) (Executor_ps_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_ps_Params(st), err
+}
+
+func ReadRootExecutor_ps_Params(msg *capnp.Message) (Executor_ps_Params, error) {
+	root, err := msg.Root()
+	return Executor_ps_Params(root.Struct()), err
+}
+
+func (s Executor_ps_Params) String() string {
+	str, _ := text.Marshal(0xffdf64593702d802, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_ps_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_ps_Params) DecodeFromPtr(p capnp.Ptr) Executor_ps_Params {
+	return Executor_ps_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_ps_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_ps_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_ps_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_ps_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Executor_ps_Params_List is a list of Executor_ps_Params.
+type Executor_ps_Params_List = capnp.StructList[Executor_ps_Params]
+
+// NewExecutor_ps_Params creates a new list of Executor_ps_Params.
+func NewExecutor_ps_Params_List(s *capnp.Segment, sz int32) (Executor_ps_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Executor_ps_Params](l), err
+}
+
+// Executor_ps_Params_Future is a wrapper for a Executor_ps_Params promised by a client call.
+type Executor_ps_Params_Future struct{ *capnp.Future }
+
+func (f Executor_ps_Params_Future) Struct() (Executor_ps_Params, error) {
+	p, err := f.Future.Ptr()
+	return Executor_ps_Params(p.Struct()), err
+}
+
+type Executor_ps_Results capnp.Struct
+
+// Executor_ps_Results_TypeID is the unique identifier for the type Executor_ps_Results.
+const Executor_ps_Results_TypeID = 0xd13bb87cc9defbdd
+
+func NewExecutor_ps_Results(s *capnp.Segment) (Executor_ps_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_ps_Results(st), err
+}
+
+func NewRootExecutor_ps_Results(s *capnp.Segment) (Executor_ps_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_ps_Results(st), err
+}
+
+func ReadRootExecutor_ps_Results(msg *capnp.Message) (Executor_ps_Results, error) {
+	root, err := msg.Root()
+	return Executor_ps_Results(root.Struct()), err
+}
+
+func (s Executor_ps_Results) String() string {
+	str, _ := text.Marshal(0xd13bb87cc9defbdd, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_ps_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_ps_Results) DecodeFromPtr(p capnp.Ptr) Executor_ps_Results {
+	return Executor_ps_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_ps_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_ps_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_ps_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_ps_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_ps_Results) Procs() (process.Info_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return process.Info_List(p.List()), err
+}
+
+func (s Executor_ps_Results) HasProcs() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Executor_ps_Results) SetProcs(v process.Info_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewProcs sets the procs field to a newly
+// allocated process.Info_List, preferring placement in s's segment.
+func (s Executor_ps_Results) NewProcs(n int32) (process.Info_List, error) {
+	l, err := process.NewInfo_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return process.Info_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// Executor_ps_Results_List is a list of Executor_ps_Results.
+type Executor_ps_Results_List = capnp.StructList[Executor_ps_Results]
+
+// NewExecutor_ps_Results creates a new list of Executor_ps_Results.
+func NewExecutor_ps_Results_List(s *capnp.Segment, sz int32) (Executor_ps_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Executor_ps_Results](l), err
+}
+
+// Executor_ps_Results_Future is a wrapper for a Executor_ps_Results promised by a client call.
+type Executor_ps_Results_Future struct{ *capnp.Future }
+
+func (f Executor_ps_Results_Future) Struct() (Executor_ps_Results, error) {
+	p, err := f.Future.Ptr()
+	return Executor_ps_Results(p.Struct()), err
+}
+
+type Executor_watch_Params capnp.Struct
+
+// Executor_watch_Params_TypeID is the unique identifier for the type Executor_watch_Params.
+const Executor_watch_Params_TypeID = 0x8b1d7e7251a6624c
+
+func NewExecutor_watch_Params(s *capnp.Segment) (Executor_watch_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_watch_Params(st), err
+}
+
+func NewRootExecutor_watch_Params(s *capnp.Segment) (Executor_watch_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_watch_Params(st), err
+}
+
+func ReadRootExecutor_watch_Params(msg *capnp.Message) (Executor_watch_Params, error) {
+	root, err := msg.Root()
+	return Executor_watch_Params(root.Struct()), err
+}
+
+func (s Executor_watch_Params) String() string {
+	str, _ := text.Marshal(0x8b1d7e7251a6624c, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_watch_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_watch_Params) DecodeFromPtr(p capnp.Ptr) Executor_watch_Params {
+	return Executor_watch_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_watch_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_watch_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_watch_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_watch_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_watch_Params) Handler() process.EventHandler {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return process.EventHandler(p.Interface().Client())
+}
+
+func (s Executor_watch_Params) HasHandler() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Executor_watch_Params) SetHandler(v process.EventHandler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Executor_watch_Params_List is a list of Executor_watch_Params.
+type Executor_watch_Params_List = capnp.StructList[Executor_watch_Params]
+
+// NewExecutor_watch_Params creates a new list of Executor_watch_Params.
+func NewExecutor_watch_Params_List(s *capnp.Segment, sz int32) (Executor_watch_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Executor_watch_Params](l), err
+}
+
+// Executor_watch_Params_Future is a wrapper for a Executor_watch_Params promised by a client call.
+type Executor_watch_Params_Future struct{ *capnp.Future }
+
+func (f Executor_watch_Params_Future) Struct() (Executor_watch_Params, error) {
+	p, err := f.Future.Ptr()
+	return Executor_watch_Params(p.Struct()), err
+}
+func (p Executor_watch_Params_Future) Handler() process.EventHandler {
+	return process.EventHandler(p.Future.Field(0, nil).Client())
+}
+
+type Executor_watch_Results capnp.Struct
+
+// Executor_watch_Results_TypeID is the unique identifier for the type Executor_watch_Results.
+const Executor_watch_Results_TypeID = 0x9315a840d2a15700
+
+func NewExecutor_watch_Results(s *capnp.Segment) (Executor_watch_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_watch_Results(st), err
+}
+
+func NewRootExecutor_watch_Results(s *capnp.Segment) (Executor_watch_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_watch_Results(st), err
+}
+
+func ReadRootExecutor_watch_Results(msg *capnp.Message) (Executor_watch_Results, error) {
+	root, err := msg.Root()
+	return Executor_watch_Results(root.Struct()), err
+}
+
+func (s Executor_watch_Results) String() string {
+	str, _ := text.Marshal(0x9315a840d2a15700, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_watch_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_watch_Results) DecodeFromPtr(p capnp.Ptr) Executor_watch_Results {
+	return Executor_watch_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_watch_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_watch_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_watch_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_watch_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Executor_watch_Results_List is a list of Executor_watch_Results.
+type Executor_watch_Results_List = capnp.StructList[Executor_watch_Results]
+
+// NewExecutor_watch_Results creates a new list of Executor_watch_Results.
+func NewExecutor_watch_Results_List(s *capnp.Segment, sz int32) (Executor_watch_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Executor_watch_Results](l), err
+}
+
+// Executor_watch_Results_Future is a wrapper for a Executor_watch_Results promised by a client call.
+type Executor_watch_Results_Future struct{ *capnp.Future }
+
+func (f Executor_watch_Results_Future) Struct() (Executor_watch_Results, error) {
+	p, err := f.Future.Ptr()
+	return Executor_watch_Results(p.Struct()), err
+}
+
+type Executor_supervise_Params capnp.Struct
+
+// Executor_supervise_Params_TypeID is the unique identifier for the type Executor_supervise_Params.
+const Executor_supervise_Params_TypeID = 0xbe2475e52b796657
+
+func NewExecutor_supervise_Params(s *capnp.Segment) (Executor_supervise_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Executor_supervise_Params(st), err
+}
+
+func NewRootExecutor_supervise_Params(s *capnp.Segment) (Executor_supervise_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Executor_supervise_Params(st), err
+}
+
+func ReadRootExecutor_supervise_Params(msg *capnp.Message) (Executor_supervise_Params, error) {
+	root, err := msg.Root()
+	return Executor_supervise_Params(root.Struct()), err
+}
+
+func (s Executor_supervise_Params) String() string {
+	str, _ := text.Marshal(0xbe2475e52b796657, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_supervise_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_supervise_Params) DecodeFromPtr(p capnp.Ptr) Executor_supervise_Params {
+	return Executor_supervise_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_supervise_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_supervise_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_supervise_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_supervise_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_supervise_Params) Session() (Session, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Session(p.Struct()), err
+}
+
+func (s Executor_supervise_Params) HasSession() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Executor_supervise_Params) SetSession(v Session) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewSession sets the session field to a newly
+// allocated Session struct, preferring placement in s's segment.
+func (s Executor_supervise_Params) NewSession() (Session, error) {
+	ss, err := NewSession(capnp.Struct(s).Segment())
+	if err != nil {
+		return Session{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Executor_supervise_Params) Ppid() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Executor_supervise_Params) SetPpid(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Executor_supervise_Params) Config() (process.Supervisor_Config, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return process.Supervisor_Config(p.Struct()), err
+}
+
+func (s Executor_supervise_Params) HasConfig() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Executor_supervise_Params) SetConfig(v process.Supervisor_Config) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewConfig sets the config field to a newly
+// allocated process.Supervisor_Config struct, preferring placement in s's segment.
+func (s Executor_supervise_Params) NewConfig() (process.Supervisor_Config, error) {
+	ss, err := process.NewSupervisor_Config(capnp.Struct(s).Segment())
+	if err != nil {
+		return process.Supervisor_Config{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Executor_supervise_Params_List is a list of Executor_supervise_Params.
+type Executor_supervise_Params_List = capnp.StructList[Executor_supervise_Params]
+
+// NewExecutor_supervise_Params creates a new list of Executor_supervise_Params.
+func NewExecutor_supervise_Params_List(s *capnp.Segment, sz int32) (Executor_supervise_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Executor_supervise_Params](l), err
+}
+
+// Executor_supervise_Params_Future is a wrapper for a Executor_supervise_Params promised by a client call.
+type Executor_supervise_Params_Future struct{ *capnp.Future }
+
+func (f Executor_supervise_Params_Future) Struct() (Executor_supervise_Params, error) {
+	p, err := f.Future.Ptr()
+	return Executor_supervise_Params(p.Struct()), err
+}
+func (p Executor_supervise_Params_Future) Session() Session_Future {
+	return Session_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Executor_supervise_Params_Future) Config() process.Supervisor_Config_Future {
+	return process.Supervisor_Config_Future{Future: p.Future.Field(1, nil)}
+}
+
+type Executor_supervise_Results capnp.Struct
+
+// Executor_supervise_Results_TypeID is the unique identifier for the type Executor_supervise_Results.
+const Executor_supervise_Results_TypeID = 0x9dbddd0e637e25ac
+
+func NewExecutor_supervise_Results(s *capnp.Segment) (Executor_supervise_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_supervise_Results(st), err
+}
+
+func NewRootExecutor_supervise_Results(s *capnp.Segment) (Executor_supervise_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_supervise_Results(st), err
+}
+
+func ReadRootExecutor_supervise_Results(msg *capnp.Message) (Executor_supervise_Results, error) {
+	root, err := msg.Root()
+	return Executor_supervise_Results(root.Struct()), err
+}
+
+func (s Executor_supervise_Results) String() string {
+	str, _ := text.Marshal(0x9dbddd0e637e25ac, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_supervise_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_supervise_Results) DecodeFromPtr(p capnp.Ptr) Executor_supervise_Results {
+	return Executor_supervise_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_supervise_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_supervise_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_supervise_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_supervise_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_supervise_Results) Supervisor() process.Supervisor {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return process.Supervisor(p.Interface().Client())
+}
+
+func (s Executor_supervise_Results) HasSupervisor() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Executor_supervise_Results) SetSupervisor(v process.Supervisor) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Executor_supervise_Results_List is a list of Executor_supervise_Results.
+type Executor_supervise_Results_List = capnp.StructList[Executor_supervise_Results]
+
+// NewExecutor_supervise_Results creates a new list of Executor_supervise_Results.
+func NewExecutor_supervise_Results_List(s *capnp.Segment, sz int32) (Executor_supervise_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Executor_supervise_Results](l), err
+}
+
+// Executor_supervise_Results_Future is a wrapper for a Executor_supervise_Results promised by a client call.
+type Executor_supervise_Results_Future struct{ *capnp.Future }
+
+func (f Executor_supervise_Results_Future) Struct() (Executor_supervise_Results, error) {
+	p, err := f.Future.Ptr()
+	return Executor_supervise_Results(p.Struct()), err
+}
+func (p Executor_supervise_Results_Future) Supervisor() process.Supervisor {
+	return process.Supervisor(p.Future.Field(0, nil).Client())
+}
+
+type Scheduler capnp.Client
+
+// Scheduler_TypeID is the unique identifier for the type Scheduler.
+const Scheduler_TypeID = 0xc1c86c7ca202ebf7
+
+func (c Scheduler) Exec(ctx context.Context, params func(Scheduler_exec_Params) error) (Scheduler_exec_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc1c86c7ca202ebf7,
+			MethodID:      0,
+			InterfaceName: "core.capnp:Scheduler",
+			MethodName:    "exec",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 6}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Scheduler_exec_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Scheduler_exec_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Scheduler) ExecCached(ctx context.Context, params func(Scheduler_execCached_Params) error) (Scheduler_execCached_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc1c86c7ca202ebf7,
+			MethodID:      1,
+			InterfaceName: "core.capnp:Scheduler",
+			MethodName:    "execCached",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 6}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Scheduler_execCached_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Scheduler_execCached_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Scheduler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Scheduler) String() string {
+	return "Scheduler(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Scheduler) AddRef() Scheduler {
+	return Scheduler(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Scheduler) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Scheduler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Scheduler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Scheduler) DecodeFromPtr(p capnp.Ptr) Scheduler {
+	return Scheduler(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Scheduler) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Scheduler) IsSame(other Scheduler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Scheduler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Scheduler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Scheduler_Server is a Scheduler with a local implementation.
+type Scheduler_Server interface {
+	Exec(context.Context, Scheduler_exec) error
+
+	ExecCached(context.Context, Scheduler_execCached) error
+}
+
+// Scheduler_NewServer creates a new Server from an implementation of Scheduler_Server.
+func Scheduler_NewServer(s Scheduler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Scheduler_Methods(nil, s), s, c)
+}
+
+// Scheduler_ServerToClient creates a new Client from an implementation of Scheduler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Scheduler_ServerToClient(s Scheduler_Server) Scheduler {
+	return Scheduler(capnp.NewClient(Scheduler_NewServer(s)))
+}
+
+// Scheduler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Scheduler_Methods(methods []server.Method, s Scheduler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc1c86c7ca202ebf7,
+			MethodID:      0,
+			InterfaceName: "core.capnp:Scheduler",
+			MethodName:    "exec",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Exec(ctx, Scheduler_exec{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc1c86c7ca202ebf7,
+			MethodID:      1,
+			InterfaceName: "core.capnp:Scheduler",
+			MethodName:    "execCached",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ExecCached(ctx, Scheduler_execCached{call})
+		},
+	})
+
+	return methods
+}
+
+// Scheduler_exec holds the state for a server call to Scheduler.exec.
+// See server.Call for documentation.
+type Scheduler_exec struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Scheduler_exec) Args() Scheduler_exec_Params {
+	return Scheduler_exec_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Scheduler_exec) AllocResults() (Scheduler_exec_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_exec_Results(r), err
+}
+
+// Scheduler_execCached holds the state for a server call to Scheduler.execCached.
+// See server.Call for documentation.
+type Scheduler_execCached struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Scheduler_execCached) Args() Scheduler_execCached_Params {
+	return Scheduler_execCached_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Scheduler_execCached) AllocResults() (Scheduler_execCached_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_execCached_Results(r), err
+}
+
+// Scheduler_List is a list of Scheduler.
+type Scheduler_List = capnp.CapList[Scheduler]
+
+// NewScheduler creates a new list of Scheduler.
+func NewScheduler_List(s *capnp.Segment, sz int32) (Scheduler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Scheduler](l), err
+}
+
+type Scheduler_Placement capnp.Struct
+
+// Scheduler_Placement_TypeID is the unique identifier for the type Scheduler_Placement.
+const Scheduler_Placement_TypeID = 0xca4205ad3ed74c37
+
+func NewScheduler_Placement(s *capnp.Segment) (Scheduler_Placement, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Scheduler_Placement(st), err
+}
+
+func NewRootScheduler_Placement(s *capnp.Segment) (Scheduler_Placement, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Scheduler_Placement(st), err
+}
+
+func ReadRootScheduler_Placement(msg *capnp.Message) (Scheduler_Placement, error) {
+	root, err := msg.Root()
+	return Scheduler_Placement(root.Struct()), err
+}
+
+func (s Scheduler_Placement) String() string {
+	str, _ := text.Marshal(0xca4205ad3ed74c37, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_Placement) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_Placement) DecodeFromPtr(p capnp.Ptr) Scheduler_Placement {
+	return Scheduler_Placement(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_Placement) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_Placement) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_Placement) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_Placement) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_Placement) Selector() (cluster.View_Selector, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return cluster.View_Selector(p.Struct()), err
+}
+
+func (s Scheduler_Placement) HasSelector() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_Placement) SetSelector(v cluster.View_Selector) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewSelector sets the selector field to a newly
+// allocated cluster.View_Selector struct, preferring placement in s's segment.
+func (s Scheduler_Placement) NewSelector() (cluster.View_Selector, error) {
+	ss, err := cluster.NewView_Selector(capnp.Struct(s).Segment())
+	if err != nil {
+		return cluster.View_Selector{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_Placement) Constraints() (cluster.View_Constraint_List, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return cluster.View_Constraint_List(p.List()), err
+}
+
+func (s Scheduler_Placement) HasConstraints() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Scheduler_Placement) SetConstraints(v cluster.View_Constraint_List) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewConstraints sets the constraints field to a newly
+// allocated cluster.View_Constraint_List, preferring placement in s's segment.
+func (s Scheduler_Placement) NewConstraints(n int32) (cluster.View_Constraint_List, error) {
+	l, err := cluster.NewView_Constraint_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return cluster.View_Constraint_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+
+// Scheduler_Placement_List is a list of Scheduler_Placement.
+type Scheduler_Placement_List = capnp.StructList[Scheduler_Placement]
+
+// NewScheduler_Placement creates a new list of Scheduler_Placement.
+func NewScheduler_Placement_List(s *capnp.Segment, sz int32) (Scheduler_Placement_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Scheduler_Placement](l), err
+}
+
+// Scheduler_Placement_Future is a wrapper for a Scheduler_Placement promised by a client call.
+type Scheduler_Placement_Future struct{ *capnp.Future }
+
+func (f Scheduler_Placement_Future) Struct() (Scheduler_Placement, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_Placement(p.Struct()), err
+}
+func (p Scheduler_Placement_Future) Selector() cluster.View_Selector_Future {
+	return cluster.View_Selector_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Scheduler_exec_Params capnp.Struct
+
+// Scheduler_exec_Params_TypeID is the unique identifier for the type Scheduler_exec_Params.
+const Scheduler_exec_Params_TypeID = 0xb968a90226d58125
+
+func NewScheduler_exec_Params(s *capnp.Segment) (Scheduler_exec_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 6})
+	return Scheduler_exec_Params(st), err
+}
+
+func NewRootScheduler_exec_Params(s *capnp.Segment) (Scheduler_exec_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 6})
+	return Scheduler_exec_Params(st), err
+}
+
+func ReadRootScheduler_exec_Params(msg *capnp.Message) (Scheduler_exec_Params, error) {
+	root, err := msg.Root()
+	return Scheduler_exec_Params(root.Struct()), err
+}
+
+func (s Scheduler_exec_Params) String() string {
+	str, _ := text.Marshal(0xb968a90226d58125, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_exec_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_exec_Params) DecodeFromPtr(p capnp.Ptr) Scheduler_exec_Params {
+	return Scheduler_exec_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_exec_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_exec_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_exec_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_exec_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_exec_Params) Session() (Session, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Session(p.Struct()), err
+}
+
+func (s Scheduler_exec_Params) HasSession() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_exec_Params) SetSession(v Session) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewSession sets the session field to a newly
+// allocated Session struct, preferring placement in s's segment.
+func (s Scheduler_exec_Params) NewSession() (Session, error) {
+	ss, err := NewSession(capnp.Struct(s).Segment())
+	if err != nil {
+		return Session{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_exec_Params) Bytecode() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s Scheduler_exec_Params) HasBytecode() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Scheduler_exec_Params) SetBytecode(v []byte) error {
+	return capnp.Struct(s).SetData(1, v)
+}
+
+func (s Scheduler_exec_Params) Args() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return capnp.TextList(p.List()), err
+}
+
+func (s Scheduler_exec_Params) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Scheduler_exec_Params) SetArgs(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Scheduler_exec_Params) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_exec_Params) Limits() (process.Limits, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return process.Limits(p.Struct()), err
+}
+
+func (s Scheduler_exec_Params) HasLimits() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Scheduler_exec_Params) SetLimits(v process.Limits) error {
+	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+}
+
+// NewLimits sets the limits field to a newly
+// allocated process.Limits struct, preferring placement in s's segment.
+func (s Scheduler_exec_Params) NewLimits() (process.Limits, error) {
+	ss, err := process.NewLimits(capnp.Struct(s).Segment())
+	if err != nil {
+		return process.Limits{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_exec_Params) Caps() (process.Cap_List, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return process.Cap_List(p.List()), err
+}
+
+func (s Scheduler_exec_Params) HasCaps() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s Scheduler_exec_Params) SetCaps(v process.Cap_List) error {
+	return capnp.Struct(s).SetPtr(4, v.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated process.Cap_List, preferring placement in s's segment.
+func (s Scheduler_exec_Params) NewCaps(n int32) (process.Cap_List, error) {
+	l, err := process.NewCap_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return process.Cap_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(4, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_exec_Params) Placement() (Scheduler_Placement, error) {
+	p, err := capnp.Struct(s).Ptr(5)
+	return Scheduler_Placement(p.Struct()), err
+}
+
+func (s Scheduler_exec_Params) HasPlacement() bool {
+	return capnp.Struct(s).HasPtr(5)
+}
+
+func (s Scheduler_exec_Params) SetPlacement(v Scheduler_Placement) error {
+	return capnp.Struct(s).SetPtr(5, capnp.Struct(v).ToPtr())
+}
+
+// NewPlacement sets the placement field to a newly
+// allocated Scheduler_Placement struct, preferring placement in s's segment.
+func (s Scheduler_exec_Params) NewPlacement() (Scheduler_Placement, error) {
+	ss, err := NewScheduler_Placement(capnp.Struct(s).Segment())
+	if err != nil {
+		return Scheduler_Placement{}, err
+	}
+	err = capnp.Struct(s).SetPtr(5, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Scheduler_exec_Params_List is a list of Scheduler_exec_Params.
+type Scheduler_exec_Params_List = capnp.StructList[Scheduler_exec_Params]
+
+// NewScheduler_exec_Params creates a new list of Scheduler_exec_Params.
+func NewScheduler_exec_Params_List(s *capnp.Segment, sz int32) (Scheduler_exec_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 6}, sz)
+	return capnp.StructList[Scheduler_exec_Params](l), err
+}
+
+// Scheduler_exec_Params_Future is a wrapper for a Scheduler_exec_Params promised by a client call.
+type Scheduler_exec_Params_Future struct{ *capnp.Future }
+
+func (f Scheduler_exec_Params_Future) Struct() (Scheduler_exec_Params, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_exec_Params(p.Struct()), err
+}
+func (p Scheduler_exec_Params_Future) Session() Session_Future {
+	return Session_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Scheduler_exec_Params_Future) Limits() process.Limits_Future {
+	return process.Limits_Future{Future: p.Future.Field(3, nil)}
+}
+func (p Scheduler_exec_Params_Future) Placement() Scheduler_Placement_Future {
+	return Scheduler_Placement_Future{Future: p.Future.Field(5, nil)}
+}
+
+type Scheduler_exec_Results capnp.Struct
+
+// Scheduler_exec_Results_TypeID is the unique identifier for the type Scheduler_exec_Results.
+const Scheduler_exec_Results_TypeID = 0xb4d6d1f144b28e83
+
+func NewScheduler_exec_Results(s *capnp.Segment) (Scheduler_exec_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_exec_Results(st), err
+}
+
+func NewRootScheduler_exec_Results(s *capnp.Segment) (Scheduler_exec_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_exec_Results(st), err
+}
+
+func ReadRootScheduler_exec_Results(msg *capnp.Message) (Scheduler_exec_Results, error) {
+	root, err := msg.Root()
+	return Scheduler_exec_Results(root.Struct()), err
+}
+
+func (s Scheduler_exec_Results) String() string {
+	str, _ := text.Marshal(0xb4d6d1f144b28e83, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_exec_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_exec_Results) DecodeFromPtr(p capnp.Ptr) Scheduler_exec_Results {
+	return Scheduler_exec_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_exec_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_exec_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_exec_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_exec_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_exec_Results) Process() process.Process {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return process.Process(p.Interface().Client())
+}
+
+func (s Scheduler_exec_Results) HasProcess() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_exec_Results) SetProcess(v process.Process) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Scheduler_exec_Results_List is a list of Scheduler_exec_Results.
+type Scheduler_exec_Results_List = capnp.StructList[Scheduler_exec_Results]
+
+// NewScheduler_exec_Results creates a new list of Scheduler_exec_Results.
+func NewScheduler_exec_Results_List(s *capnp.Segment, sz int32) (Scheduler_exec_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Scheduler_exec_Results](l), err
+}
+
+// Scheduler_exec_Results_Future is a wrapper for a Scheduler_exec_Results promised by a client call.
+type Scheduler_exec_Results_Future struct{ *capnp.Future }
+
+func (f Scheduler_exec_Results_Future) Struct() (Scheduler_exec_Results, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_exec_Results(p.Struct()), err
+}
+func (p Scheduler_exec_Results_Future) Process() process.Process {
+	return process.Process(p.Future.Field(0, nil).Client())
+}
+
+type Scheduler_execCached_Params capnp.Struct
+
+// Scheduler_execCached_Params_TypeID is the unique identifier for the type Scheduler_execCached_Params.
+const Scheduler_execCached_Params_TypeID = 0xc757b1156daf6cf7
+
+func NewScheduler_execCached_Params(s *capnp.Segment) (Scheduler_execCached_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 6})
+	return Scheduler_execCached_Params(st), err
+}
+
+func NewRootScheduler_execCached_Params(s *capnp.Segment) (Scheduler_execCached_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 6})
+	return Scheduler_execCached_Params(st), err
+}
+
+func ReadRootScheduler_execCached_Params(msg *capnp.Message) (Scheduler_execCached_Params, error) {
+	root, err := msg.Root()
+	return Scheduler_execCached_Params(root.Struct()), err
+}
+
+func (s Scheduler_execCached_Params) String() string {
+	str, _ := text.Marshal(0xc757b1156daf6cf7, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_execCached_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_execCached_Params) DecodeFromPtr(p capnp.Ptr) Scheduler_execCached_Params {
+	return Scheduler_execCached_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_execCached_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_execCached_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_execCached_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_execCached_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_execCached_Params) Session() (Session, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Session(p.Struct()), err
+}
+
+func (s Scheduler_execCached_Params) HasSession() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_execCached_Params) SetSession(v Session) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewSession sets the session field to a newly
+// allocated Session struct, preferring placement in s's segment.
+func (s Scheduler_execCached_Params) NewSession() (Session, error) {
+	ss, err := NewSession(capnp.Struct(s).Segment())
+	if err != nil {
+		return Session{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_execCached_Params) Cid() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s Scheduler_execCached_Params) HasCid() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Scheduler_execCached_Params) SetCid(v []byte) error {
+	return capnp.Struct(s).SetData(1, v)
+}
+
+func (s Scheduler_execCached_Params) Args() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return capnp.TextList(p.List()), err
+}
+
+func (s Scheduler_execCached_Params) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Scheduler_execCached_Params) SetArgs(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Scheduler_execCached_Params) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_execCached_Params) Limits() (process.Limits, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return process.Limits(p.Struct()), err
+}
+
+func (s Scheduler_execCached_Params) HasLimits() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Scheduler_execCached_Params) SetLimits(v process.Limits) error {
+	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+}
+
+// NewLimits sets the limits field to a newly
+// allocated process.Limits struct, preferring placement in s's segment.
+func (s Scheduler_execCached_Params) NewLimits() (process.Limits, error) {
+	ss, err := process.NewLimits(capnp.Struct(s).Segment())
+	if err != nil {
+		return process.Limits{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_execCached_Params) Caps() (process.Cap_List, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return process.Cap_List(p.List()), err
+}
+
+func (s Scheduler_execCached_Params) HasCaps() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s Scheduler_execCached_Params) SetCaps(v process.Cap_List) error {
+	return capnp.Struct(s).SetPtr(4, v.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated process.Cap_List, preferring placement in s's segment.
+func (s Scheduler_execCached_Params) NewCaps(n int32) (process.Cap_List, error) {
+	l, err := process.NewCap_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return process.Cap_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(4, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_execCached_Params) Placement() (Scheduler_Placement, error) {
+	p, err := capnp.Struct(s).Ptr(5)
+	return Scheduler_Placement(p.Struct()), err
+}
+
+func (s Scheduler_execCached_Params) HasPlacement() bool {
+	return capnp.Struct(s).HasPtr(5)
+}
+
+func (s Scheduler_execCached_Params) SetPlacement(v Scheduler_Placement) error {
+	return capnp.Struct(s).SetPtr(5, capnp.Struct(v).ToPtr())
+}
+
+// NewPlacement sets the placement field to a newly
+// allocated Scheduler_Placement struct, preferring placement in s's segment.
+func (s Scheduler_execCached_Params) NewPlacement() (Scheduler_Placement, error) {
+	ss, err := NewScheduler_Placement(capnp.Struct(s).Segment())
+	if err != nil {
+		return Scheduler_Placement{}, err
+	}
+	err = capnp.Struct(s).SetPtr(5, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Scheduler_execCached_Params_List is a list of Scheduler_execCached_Params.
+type Scheduler_execCached_Params_List = capnp.StructList[Scheduler_execCached_Params]
+
+// NewScheduler_execCached_Params creates a new list of Scheduler_execCached_Params.
+func NewScheduler_execCached_Params_List(s *capnp.Segment, sz int32) (Scheduler_execCached_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 6}, sz)
+	return capnp.StructList[Scheduler_execCached_Params](l), err
+}
+
+// Scheduler_execCached_Params_Future is a wrapper for a Scheduler_execCached_Params promised by a client call.
+type Scheduler_execCached_Params_Future struct{ *capnp.Future }
+
+func (f Scheduler_execCached_Params_Future) Struct() (Scheduler_execCached_Params, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_execCached_Params(p.Struct()), err
+}
+func (p Scheduler_execCached_Params_Future) Session() Session_Future {
+	return Session_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Scheduler_execCached_Params_Future) Limits() process.Limits_Future {
+	return process.Limits_Future{Future: p.Future.Field(3, nil)}
+}
+func (p Scheduler_execCached_Params_Future) Placement() Scheduler_Placement_Future {
+	return Scheduler_Placement_Future{Future: p.Future.Field(5, nil)}
+}
+
+type Scheduler_execCached_Results capnp.Struct
+
+// Scheduler_execCached_Results_TypeID is the unique identifier for the type Scheduler_execCached_Results.
+const Scheduler_execCached_Results_TypeID = 0xa65228fc64d94417
+
+func NewScheduler_execCached_Results(s *capnp.Segment) (Scheduler_execCached_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_execCached_Results(st), err
+}
+
+func NewRootScheduler_execCached_Results(s *capnp.Segment) (Scheduler_execCached_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_execCached_Results(st), err
+}
+
+func ReadRootScheduler_execCached_Results(msg *capnp.Message) (Scheduler_execCached_Results, error) {
+	root, err := msg.Root()
+	return Scheduler_execCached_Results(root.Struct()), err
+}
+
+func (s Scheduler_execCached_Results) String() string {
+	str, _ := text.Marshal(0xa65228fc64d94417, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_execCached_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_execCached_Results) DecodeFromPtr(p capnp.Ptr) Scheduler_execCached_Results {
+	return Scheduler_execCached_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_execCached_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_execCached_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_execCached_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_execCached_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_execCached_Results) Process() process.Process {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return process.Process(p.Interface().Client())
+}
+
+func (s Scheduler_execCached_Results) HasProcess() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_execCached_Results) SetProcess(v process.Process) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Scheduler_execCached_Results_List is a list of Scheduler_execCached_Results.
+type Scheduler_execCached_Results_List = capnp.StructList[Scheduler_execCached_Results]
+
+// NewScheduler_execCached_Results creates a new list of Scheduler_execCached_Results.
+func NewScheduler_execCached_Results_List(s *capnp.Segment, sz int32) (Scheduler_execCached_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Scheduler_execCached_Results](l), err
+}
+
+// Scheduler_execCached_Results_Future is a wrapper for a Scheduler_execCached_Results promised by a client call.
+type Scheduler_execCached_Results_Future struct{ *capnp.Future }
+
+func (f Scheduler_execCached_Results_Future) Struct() (Scheduler_execCached_Results, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_execCached_Results(p.Struct()), err
+}
+func (p Scheduler_execCached_Results_Future) Process() process.Process {
+	return process.Process(p.Future.Field(0, nil).Client())
+}
+
+const schema_e82706a772b0927b = "x\xda\xbcXkl\x14\xd7\x15>\xe7\xce\xae\xd7\xe6\xe1" +
+	"\xf5\xf5\xac\xe5G\xb0\x0d~\x040\x8a\x15p+TW" +
+	"\xb0\x8ekD]au\xaf\x1d\x82\x8c\xaaV\xe3\xf5\xc4" +
+	"\xdej\xbd\xb3\x9eYC\xac\x12(\xaaH+*\xb5\"" +
+	"\xa1\xc8$\xa1\x0d\x14\xa8Iy\xa4IH\xa1\x0aQP" +
+	")MP\xa2B\x1b\x02\xb4v\x08m\xa8H\x9b(8" +
+	"\x0d%$\xc0Tg<3;\xebG\x08m\x85\xfd\xc3" +
+	"\xa3\x993\xe7\x9e\xf3\xdd\xef\xfb\xee\x19\xdf{-\xa7\xc1" +
+	"7\x7f\xbaV\x0b\xacm\x06\xf3g\x99\xff\xfaqW\xb0" +
+	"\xe9o_\xff.\xf0\xa9\x92\xf9\x9dG\x9f\xd1\x7f\x915" +
+	"\xfb\x12\x00\xca\xa5\x85;\xe4\xea\xc2\x00@\xdd\xac\xc2\xa5" +
+	"(\x8f\xd0\xa5\x99X\xbc{\xcf\xfe\x96M\xeb\x81\x87\x10" +
+	"\xc0\x8f\xf4\xf8\\a=\x02\xca\x17\x0a\xc3\x80\xe6\xb2\x8e" +
+	"\xddB_[\xfaCo\x00\x16M\xa1\x80\x9c\xa20\xe0" +
+	"\xcd\x15\xdb\xff\xd80X\xf0\x98\xf5\xd8GO\xe7\x16\xe5" +
+	"#\xf8\xcc\xc5W\x8e}\xe3\xe1\xc6o>\x06<\xdfy" +
+	"Q\xe6E\x1f\x02\xca\x05\xf4\x9e\xb9y\xdb\x99\xb5\xef\xfe" +
+	"\xe0\xa7[@\x84\x90\x02\xfc\xf4\xee\x97\x8a\x18\x02\xd6-" +
+	"*Z\x81\x80\xe6\xac\xfe+?Y\xb9\x7f\xff\x13\xde\xb5" +
+	"\xf7\x15\xe7\xd3\xda\x07\x8b)\xc7\xde\xea\xb5\xd1\xdc\xe1#" +
+	"\xdb\xbc\x01\xa7\x8b\x17P\xc0\xb0\x15P\xd8t\xae\xf3\xfa" +
+	"\x9c\xd6\xdd\xc0\x0b\xd2\xd5\x974Z\xd5\x97P\xc0\xd1c" +
+	"\xcd[\xe7\x84\xa6\x0e\x82\x90\x11\xcd\xf6\xb7_xdd" +
+	"\xd6\xf2\xe3P0%\x80\x00\xf2\xdc\x92\x13\x80\xf2=%" +
+	"\x07\x00\xcd\xef\xfd\xe8\xd9\xa6\x91So>\xefM\xf5\xbb" +
+	"\x12\xab\x98\xd7\xadT\xc5\xf2\xf9\x0a\xdcWs0\xa3\xa1" +
+	"\xf7K\xa8\x9a\xba+%VC\xd5\xebO\xdf\xcd\xf6t" +
+	"\x1f\xb6sdQ\x842\x83\xc0\xac\x8b\xcd\xb0\"V<" +
+	"\xd8?\xefb_\xd5KN\x12F!\xc3\xa55\xb4\xcc" +
+	"\xc5R\xaa\xe3\xc6\x91\xdf\xdc\x18\xf9\xf9\xd1\x97\xc7\xed\xef" +
+	"\xc3e;\xe4\x0de\x04\xf3\xfa\xb2\xa5\xf2\x1e\xba2\xaf" +
+	"\xfe\x93\xedX\x13\x7f\xe5\xe8\xb8\xe0MeO\xcb[\xcb" +
+	"\x0a\x01\xe4]e\xdf\x97\xafY\xc1n\xf7b*b:" +
+	"\xda?\x85r^({\\~\x97\xc2\xea.\x96\x99T" +
+	"\xe9\xd5\xf8\x81\x9e\x82_\xad\xf8\xbd\xb7\x97\xd33\x899" +
+	"u\xc33\xad^\x16.;\xb3x\x9f\xbf\xf1\x04E\xa4" +
+	"+\xb1Z\x92[*>\x91\xdb+\xe8jy\xc5j@" +
+	"s\xf8\xd3\xb7^]s\xe8\xcb\xa7<;)\xbfZ\xf1" +
+	"\x09\x81[A\xe0\x06\x9eh\xd6z\xaf\x0f\xbc\xe9\xdd\xe9" +
+	"\x1b\x15\x16\x0d\xfd\x95\x14\x907t\xbe0\x7f@\xbc\xe7" +
+	"\xa5[u%%\x98k=\xcf]\xd8\xd0\xf5QY\xdb" +
+	"Uo\x82\xe6J+\x81\xb0\x02\xbe\xb5\xe9\xcc:1\xef" +
+	"\xd3\x1b\xe3\xa0\xea\xad|T\xee\xaf\xa4|}\x95K\xe5" +
+	"mte\xb2\xb3la{\xe7y\x13\\\xda\xcb\x1b*" +
+	"?\x04\xd3\xfe}\xc9\x8cj\xbaZ\x1bU\x92\x98H\xd6" +
+	"/yH\x8d\xf6\x05R\x9a\x1eA\x14!\xc9\x0f\xe0\x92" +
+	"\x1f\x9d\xbe\xf8\xa6\x1a`|C\x00\xd3<BG\x9b\xbc" +
+	"\x7f%0\xde\x1b@\xe6\xae\x8b\x0e^\\-\x01\xc6\xdb" +
+	"\x03(\xb9JEp$\xd9\xb2\x00\x18\xbf/\x80>\x97" +
+	"V\xe8(\x86\x7f\xb1\x15\x18\xbf'\x10T\x1fR\xa3\x0d" +
+	"h\xd2\x9f\xaf(\xd1n\x90\xd4\xce\x06\x94\x92F\x03\x96" +
+	"\xafVR\xd1\xee\x064\x8d\xbe\xa4\xaa\xaf\x8a\x19\x80j" +
+	"\x03F\x10\xdd\xe6$\xa7\xb9\x94\xa6\xd7:\x19\xd4\xce\xaa" +
+	"\xd6\xb0j\xf4\xc5S\x86\xf0I>\x00\x1f\x02\xf0\xe9\x8d" +
+	"\x00\"[B\x11b\xb8.\xa9kQ\xd50\x90\x9bu" +
+	"Y\xd5\x83\x7f\xba\\\xf9g\x00D\x0e\x93d\xb6\xea\xa8" +
+	"\x8a(\xba\xd2c\x00L\x96\xb4[It\xc6U\x1d\xb9" +
+	"\xf9\xc2\xcdW\xf4\xa1\xfb\x9a\xfe\xf19\x92\xb6Z\x85\xa2" +
+	"\xe1\xc6\xb0D\xb2\xbe-\xd6\x95P\xf5Z#\xd6\x95\xa8" +
+	"\x8a\x94[\xcbz\x17m\x05\x10\xd3$\x14E\x0c\xcdh" +
+	"\xb7\x12\x8f\xab\x89.@\x15\xa7\x03\xc3\xe9\xf0\x19\xe88" +
+	"-\x88\"7\xd9V\xea`\xb3\x84\xe2)\x86\x1c\xd1\xd2" +
+	"<\xdf\xf65\x00\xf1\xa4\x84b\x90!\xb2\x102\x00\xbe" +
+	"\xab\x06@<%\xa1\xd8\xcb\x90K,\x84\x12\x00\xdfC" +
+	"7wJ(^f\xc8}R\x08}\x00\xfcH=\x80" +
+	"8$\xa18\xc6\x90\xfb}!\xf4\x03\xf0\xa3\x14\xf9\xa2" +
+	"\x84\xe2,\xc3u\x86j\x181-\x81yi\xc5\x03b" +
+	"\x1e\xa0\xd9\xd1\x9fR\xa3Z\xa7\x0a\x00N;\xc1d2" +
+	"\xd6\x89\xd9\xc00\x1b0\xa8\xe8]\x06\xe6\x02F$\xc4" +
+	"i\xc0\xe82\x1c\x8f\xf5\xc4R\x06\xe6\x99\xdf\xde\xf2F" +
+	"I\xfd\xf6\xf6\x03v\xb6`TI\xba\xc1y\xe6\xa1\xa7" +
+	"\xf7\xee\\\xfe\xc0\xf1!z\x9a;\x06\xa6\xfbU\xbd'" +
+	"\x96P\xe2\xb5q\xad+\x96pwe\xb2\xad\x9e\xbc\x81" +
+	"\x09\xb1w\xf8\xabV\xb5\xaa\xe5\xe3\x88\xb9\xd2\xb3\x9dN" +
+	"$H\x1a\x11\xe9\xfc\xc0]\xbd\xdd[\xfe\xf2\x87\xb1D" +
+	"\xf2\x11I\x88\xe9}q5\x93\xf8\xa3\xbc\x87\xff\x9e\xf8" +
+	"\x16\xffF\xdb\xab\x8dkQ%nU\xe7\xcb\xb3\xa9\xb1" +
+	"\x84\xf6\xb1AB\xb1\x8c!\xe2(5\x9ai\xc3\x9b$" +
+	"\x14\x11\x86\x9c\xd9\xd4h\xa1\xc0\xafJ(\xeeg\x18L" +
+	"\xaa\xaanm\xd74\xc0\xb0\xa1\xea\xabT\x1ds\x80a" +
+	"\x0e`\xb0[3R\xce\xb3\x0c\xf82\x1b\xbc\xe5\x9e\xdc" +
+	"\xa6\xa6=\xa09\x12\xbb\x95**\x00\xc4\x80\x84bg" +
+	"Z\x15\xdbk\xd2JqU\xe1J\xe5E\x8f*\x0e\x13" +
+	"H\xcf\xdbRqTq\xa4\xc6\x96\xca\x1b\x9fE\xaa@" +
+	"4\xd6yG\xd50\x06y\xd7\xf8n\xc368\xb3\x11" +
+	"\xca\x00C\x92F\x11\xca\x00\xc3\xe7\xb3}\xc3\x03\x06\xf7" +
+	"\xfbG\x11:EfwRB1t\xdb\xbe\xf1\x7f\x04" +
+	"'\x19W\xa2j\x8f\x9a\x00La^z\xac\xf8|\x9a" +
+	"\x8f(A\x8b^\xd3\\\xf4\x964f\x8a\x88\xee5\xd7" +
+	"xEd+\xab\xa5>-\xa2\xc9\xbb\xcf\xe0D8\xaa" +
+	"%\x1e\x8cua\x9e\xc9\x8f?p2\xb1f\xf6\xd0\xd8" +
+	"2\xd1\xf1\xbb@B\x89\xd3D\xe0\xb3&\x02g>A" +
+	"g\xe8\xe5\x9cNo\x7f\xa0\xdc\xf2\xc4\xcc\x83\x17\x1d\x96" +
+	"\x04\x89&\xc2\x87\xdea\x0b[\xcdH\x1a0\x91m\xa5" +
+	"w&Ot\xc6X>\x9f\x06\x8e\xea\x00\xa6'9t" +
+	"\xa6e^L\x03\x07\x9fd8\x18W\x08\x01#i\x09" +
+	"\xea\xa5\xca\x05\xf9}\x02\xf4\x92\x84\xe2#\x86\xf4\x93\x9e" +
+	"\xb4\xf9\x085\xc6la\x9e#\xd6\x9e\x95P\xbcC\x04" +
+	"\xb5\x85y\x81\x80\x1f\x92P\\\"\x82\xfaC\x98\x05\xc0" +
+	"/R\xe4;\x12\x8a\xcbD\xd0\xac\x10\x06h\x99\xfa\xf4" +
+	"2<+\x10\xc2l\x00>Bk\xbf'\xa1\xf8\x98!" +
+	"\x0fd\x870\x07\x80_\xa1\x9b\x97%\x14\xd7\x19\xf2\xec" +
+	"\x9c\x10N\x01\xe0\xd7\x88\xdf\x1fK\xd8\xe6C\x86\xc1U" +
+	"1u5r\xf3\xf1\xaa\xeb+\xeb.\x97n\xb4\x0d\xac" +
+	"\xdc\xf2aS\xb5\xa9\x05\x00\xc8\xd3_Y\xa31\xe1d" +
+	"_G[_\x07r\xb3^[u\xd7\xdf\x9f\x8b\xbc\xe5" +
+	"\xba\x9f\x92lKi\xba:\xfa\xda\xf1%\x1fl|d" +
+	"\xea\xae\xbf:\xaf)\x89h\xb7\xa6c\xbeO\x02\xc4|" +
+	"\xc0`\x8f\x9aR\x90\x9bO\x0e\xce>\xd1\xbby\xc7s" +
+	"v\\\xb0C\xd3R\xc8\xcd\xdc\x81\xb7oV\xce\xeb\xf8" +
+	"\xc0In\xd8N\x01H\xc7\x95;bO`\xbc\x13\x1e" +
+	"W\xe1\xc8m;/gc\xad\xf7\x90\xc7X\x0e\xd2n" +
+	"<\xe3X\xafm,\x87kl\xb79\xe91\x96\xd7\x09" +
+	"\xf8\xd7n5\x90dX\xef\x1d7\x14\x96\x81[$\xae" +
+	"\x84\xad\x97RD\xf4l\x17\xb2\xb9D\xcb9\x12\x8a/" +
+	"x \x9b\xdf\x01 \xee\x1d\xb5\x18\xd3P\xe3j\xd4f" +
+	"N\x9e\xb9\xf1T\xfe\xaf\xaf\xfe\x8c=\x9b^-a\xa4" +
+	"t%\x06\x81D\xcaSr\xdbk\xff\xee\x98\xb9H\xfe" +
+	"\xe5\xd8\x03\x82y].iL8k/\xb0\xcf\xe5*" +
+	"\x86\xe5t.{\xd2\xde\xbdq\xd1\xf6]\xe1\x82\xdfN" +
+	"t\xeed\x0e\xab\xce,\xf3\xbf\x0e3\x9eaz\xa2Z" +
+	"\xeb\xd3\x19\xc3\x14\xa4vN8H\x8f\x99\x10o\xf51" +
+	"\xa0D\xa3Z_\x82$\xe3~\xd6\x8d\xa9\x0d\x9d\xdaP" +
+	"O\x9b\xb0\xf3?\x0bt\xbe&9\xaf\xb1L8H\xa5" +
+	"eZ\xdf\xd8\x8d\xb0\xc7\x98\xff\x0c\x00\xc0\xe4\x96\x8e"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -1540,17 +3429,29 @@ func RegisterSchema(reg *schemas.Registry) {
 		Nodes: []uint64{
 			0x804fe3440f678ff3,
 			0x81914daea9a63e6e,
+			0x8b1d7e7251a6624c,
+			0x9315a840d2a15700,
 			0x935e427d5cc4f53e,
 			0x969e88e97ed79d94,
 			0x9baeae5a95f57921,
+			0x9dbddd0e637e25ac,
+			0xa65228fc64d94417,
 			0xa80b14289949c4c1,
+			0xb4d6d1f144b28e83,
 			0xb52aad0122df1319,
+			0xb968a90226d58125,
+			0xbe2475e52b796657,
 			0xc0c1a3f1fdbabdfd,
+			0xc1c86c7ca202ebf7,
 			0xc65521f186b6e059,
+			0xc757b1156daf6cf7,
+			0xca4205ad3ed74c37,
+			0xd13bb87cc9defbdd,
 			0xd698fc716f499b07,
 			0xec51981217dfdc10,
 			0xf7531ef46740370e,
 			0xfdfb2b517fd7915f,
+			0xffdf64593702d802,
 		},
 		Compressed: true,
 	})
@@ -8,7 +8,9 @@ import (
 	fc "capnproto.org/go/capnp/v3/flowcontrol"
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
+	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
+	strconv "strconv"
 )
 
 type BytecodeCache capnp.Client
@@ -731,6 +733,94 @@ func (f BytecodeCache_has_Results_Future) Struct() (BytecodeCache_has_Results, e
 	return BytecodeCache_has_Results(p.Struct()), err
 }
 
+type Limits capnp.Struct
+
+// Limits_TypeID is the unique identifier for the type Limits.
+const Limits_TypeID = 0xaf59a13a1ad4966a
+
+func NewLimits(s *capnp.Segment) (Limits, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Limits(st), err
+}
+
+func NewRootLimits(s *capnp.Segment) (Limits, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Limits(st), err
+}
+
+func ReadRootLimits(msg *capnp.Message) (Limits, error) {
+	root, err := msg.Root()
+	return Limits(root.Struct()), err
+}
+
+func (s Limits) String() string {
+	str, _ := text.Marshal(0xaf59a13a1ad4966a, capnp.Struct(s))
+	return str
+}
+
+func (s Limits) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Limits) DecodeFromPtr(p capnp.Ptr) Limits {
+	return Limits(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Limits) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Limits) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Limits) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Limits) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Limits) MemoryPages() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Limits) SetMemoryPages(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Limits) Timeout() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Limits) SetTimeout(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s Limits) Calls() uint64 {
+	return capnp.Struct(s).Uint64(16)
+}
+
+func (s Limits) SetCalls(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
+}
+
+// Limits_List is a list of Limits.
+type Limits_List = capnp.StructList[Limits]
+
+// NewLimits creates a new list of Limits.
+func NewLimits_List(s *capnp.Segment, sz int32) (Limits_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Limits](l), err
+}
+
+// Limits_Future is a wrapper for a Limits promised by a client call.
+type Limits_Future struct{ *capnp.Future }
+
+func (f Limits_Future) Struct() (Limits, error) {
+	p, err := f.Future.Ptr()
+	return Limits(p.Struct()), err
+}
+
 type Process capnp.Client
 
 // Process_TypeID is the unique identifier for the type Process.
@@ -767,7 +857,7 @@ func (c Process) Kill(ctx context.Context, params func(Process_kill_Params) erro
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_kill_Params(s)) }
 	}
 
@@ -776,6 +866,66 @@ func (c Process) Kill(ctx context.Context, params func(Process_kill_Params) erro
 
 }
 
+func (c Process) Stdout(ctx context.Context, params func(Process_stdout_Params) error) (Process_stdout_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xda23f0d3a8250633,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stdout",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_stdout_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Process_stdout_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Process) Stderr(ctx context.Context, params func(Process_stderr_Params) error) (Process_stderr_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xda23f0d3a8250633,
+			MethodID:      3,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stderr",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_stderr_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Process_stderr_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Process) Stdin(ctx context.Context, params func(Process_stdin_Params) error) (Process_stdin_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xda23f0d3a8250633,
+			MethodID:      4,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stdin",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_stdin_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Process_stdin_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c Process) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -852,6 +1002,12 @@ type Process_Server interface {
 	Wait(context.Context, Process_wait) error
 
 	Kill(context.Context, Process_kill) error
+
+	Stdout(context.Context, Process_stdout) error
+
+	Stderr(context.Context, Process_stderr) error
+
+	Stdin(context.Context, Process_stdin) error
 }
 
 // Process_NewServer creates a new Server from an implementation of Process_Server.
@@ -870,7 +1026,7 @@ func Process_ServerToClient(s Process_Server) Process {
 // This can be used to create a more complicated Server.
 func Process_Methods(methods []server.Method, s Process_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+		methods = make([]server.Method, 0, 5)
 	}
 
 	methods = append(methods, server.Method{
@@ -897,6 +1053,42 @@ func Process_Methods(methods []server.Method, s Process_Server) []server.Method
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xda23f0d3a8250633,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stdout",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Stdout(ctx, Process_stdout{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xda23f0d3a8250633,
+			MethodID:      3,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stderr",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Stderr(ctx, Process_stderr{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xda23f0d3a8250633,
+			MethodID:      4,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stdin",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Stdin(ctx, Process_stdin{call})
+		},
+	})
+
 	return methods
 }
 
@@ -913,7 +1105,7 @@ func (c Process_wait) Args() Process_wait_Params {
 
 // AllocResults allocates the results struct.
 func (c Process_wait) AllocResults() (Process_wait_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 1})
 	return Process_wait_Results(r), err
 }
 
@@ -934,408 +1126,3945 @@ func (c Process_kill) AllocResults() (Process_kill_Results, error) {
 	return Process_kill_Results(r), err
 }
 
-// Process_List is a list of Process.
-type Process_List = capnp.CapList[Process]
-
-// NewProcess creates a new list of Process.
-func NewProcess_List(s *capnp.Segment, sz int32) (Process_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[Process](l), err
+// Process_stdout holds the state for a server call to Process.stdout.
+// See server.Call for documentation.
+type Process_stdout struct {
+	*server.Call
 }
 
-type Process_wait_Params capnp.Struct
-
-// Process_wait_Params_TypeID is the unique identifier for the type Process_wait_Params.
-const Process_wait_Params_TypeID = 0xf9694ae208dbb3e3
-
-func NewProcess_wait_Params(s *capnp.Segment) (Process_wait_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Process_wait_Params(st), err
+// Args returns the call's arguments.
+func (c Process_stdout) Args() Process_stdout_Params {
+	return Process_stdout_Params(c.Call.Args())
 }
 
-func NewRootProcess_wait_Params(s *capnp.Segment) (Process_wait_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Process_wait_Params(st), err
+// AllocResults allocates the results struct.
+func (c Process_stdout) AllocResults() (Process_stdout_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdout_Results(r), err
 }
 
-func ReadRootProcess_wait_Params(msg *capnp.Message) (Process_wait_Params, error) {
-	root, err := msg.Root()
-	return Process_wait_Params(root.Struct()), err
+// Process_stderr holds the state for a server call to Process.stderr.
+// See server.Call for documentation.
+type Process_stderr struct {
+	*server.Call
 }
 
-func (s Process_wait_Params) String() string {
-	str, _ := text.Marshal(0xf9694ae208dbb3e3, capnp.Struct(s))
-	return str
+// Args returns the call's arguments.
+func (c Process_stderr) Args() Process_stderr_Params {
+	return Process_stderr_Params(c.Call.Args())
 }
 
-func (s Process_wait_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// AllocResults allocates the results struct.
+func (c Process_stderr) AllocResults() (Process_stderr_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stderr_Results(r), err
 }
 
-func (Process_wait_Params) DecodeFromPtr(p capnp.Ptr) Process_wait_Params {
-	return Process_wait_Params(capnp.Struct{}.DecodeFromPtr(p))
+// Process_stdin holds the state for a server call to Process.stdin.
+// See server.Call for documentation.
+type Process_stdin struct {
+	*server.Call
 }
 
-func (s Process_wait_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Process_wait_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+// Args returns the call's arguments.
+func (c Process_stdin) Args() Process_stdin_Params {
+	return Process_stdin_Params(c.Call.Args())
 }
 
-func (s Process_wait_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// AllocResults allocates the results struct.
+func (c Process_stdin) AllocResults() (Process_stdin_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdin_Results(r), err
 }
 
-func (s Process_wait_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Process_List is a list of Process.
+type Process_List = capnp.CapList[Process]
+
+// NewProcess creates a new list of Process.
+func NewProcess_List(s *capnp.Segment, sz int32) (Process_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Process](l), err
 }
 
-// Process_wait_Params_List is a list of Process_wait_Params.
-type Process_wait_Params_List = capnp.StructList[Process_wait_Params]
+type Process_Writer capnp.Client
 
-// NewProcess_wait_Params creates a new list of Process_wait_Params.
-func NewProcess_wait_Params_List(s *capnp.Segment, sz int32) (Process_wait_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Process_wait_Params](l), err
-}
+// Process_Writer_TypeID is the unique identifier for the type Process_Writer.
+const Process_Writer_TypeID = 0x99f538bdc3c3d387
 
-// Process_wait_Params_Future is a wrapper for a Process_wait_Params promised by a client call.
-type Process_wait_Params_Future struct{ *capnp.Future }
+func (c Process_Writer) Write(ctx context.Context, params func(Process_Writer_write_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x99f538bdc3c3d387,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Process.Writer",
+			MethodName:    "write",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_Writer_write_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
 
-func (f Process_wait_Params_Future) Struct() (Process_wait_Params, error) {
-	p, err := f.Future.Ptr()
-	return Process_wait_Params(p.Struct()), err
 }
 
-type Process_wait_Results capnp.Struct
+func (c Process_Writer) Close(ctx context.Context, params func(Process_Writer_close_Params) error) (Process_Writer_close_Results_Future, capnp.ReleaseFunc) {
 
-// Process_wait_Results_TypeID is the unique identifier for the type Process_wait_Results.
-const Process_wait_Results_TypeID = 0xd72ab4a0243047ac
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x99f538bdc3c3d387,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Process.Writer",
+			MethodName:    "close",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_Writer_close_Params(s)) }
+	}
 
-func NewProcess_wait_Results(s *capnp.Segment) (Process_wait_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Process_wait_Results(st), err
-}
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Process_Writer_close_Results_Future{Future: ans.Future()}, release
 
-func NewRootProcess_wait_Results(s *capnp.Segment) (Process_wait_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Process_wait_Results(st), err
 }
 
-func ReadRootProcess_wait_Results(msg *capnp.Message) (Process_wait_Results, error) {
-	root, err := msg.Root()
-	return Process_wait_Results(root.Struct()), err
+func (c Process_Writer) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
 }
 
-func (s Process_wait_Results) String() string {
-	str, _ := text.Marshal(0xd72ab4a0243047ac, capnp.Struct(s))
-	return str
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Process_Writer) String() string {
+	return "Process_Writer(" + capnp.Client(c).String() + ")"
 }
 
-func (s Process_wait_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Process_Writer) AddRef() Process_Writer {
+	return Process_Writer(capnp.Client(c).AddRef())
 }
 
-func (Process_wait_Results) DecodeFromPtr(p capnp.Ptr) Process_wait_Results {
-	return Process_wait_Results(capnp.Struct{}.DecodeFromPtr(p))
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Process_Writer) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Process_Writer) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Process_Writer) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Process_Writer) DecodeFromPtr(p capnp.Ptr) Process_Writer {
+	return Process_Writer(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Process_Writer) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Process_Writer) IsSame(other Process_Writer) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Process_Writer) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Process_Writer) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Process_Writer_Server is a Process_Writer with a local implementation.
+type Process_Writer_Server interface {
+	Write(context.Context, Process_Writer_write) error
+
+	Close(context.Context, Process_Writer_close) error
+}
+
+// Process_Writer_NewServer creates a new Server from an implementation of Process_Writer_Server.
+func Process_Writer_NewServer(s Process_Writer_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Process_Writer_Methods(nil, s), s, c)
+}
+
+// Process_Writer_ServerToClient creates a new Client from an implementation of Process_Writer_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Process_Writer_ServerToClient(s Process_Writer_Server) Process_Writer {
+	return Process_Writer(capnp.NewClient(Process_Writer_NewServer(s)))
+}
+
+// Process_Writer_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Process_Writer_Methods(methods []server.Method, s Process_Writer_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x99f538bdc3c3d387,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Process.Writer",
+			MethodName:    "write",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Write(ctx, Process_Writer_write{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x99f538bdc3c3d387,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Process.Writer",
+			MethodName:    "close",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Close(ctx, Process_Writer_close{call})
+		},
+	})
+
+	return methods
+}
+
+// Process_Writer_write holds the state for a server call to Process_Writer.write.
+// See server.Call for documentation.
+type Process_Writer_write struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_Writer_write) Args() Process_Writer_write_Params {
+	return Process_Writer_write_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Process_Writer_write) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// Process_Writer_close holds the state for a server call to Process_Writer.close.
+// See server.Call for documentation.
+type Process_Writer_close struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_Writer_close) Args() Process_Writer_close_Params {
+	return Process_Writer_close_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Process_Writer_close) AllocResults() (Process_Writer_close_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_Writer_close_Results(r), err
+}
+
+// Process_Writer_List is a list of Process_Writer.
+type Process_Writer_List = capnp.CapList[Process_Writer]
+
+// NewProcess_Writer creates a new list of Process_Writer.
+func NewProcess_Writer_List(s *capnp.Segment, sz int32) (Process_Writer_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Process_Writer](l), err
+}
+
+type Process_Writer_write_Params capnp.Struct
+
+// Process_Writer_write_Params_TypeID is the unique identifier for the type Process_Writer_write_Params.
+const Process_Writer_write_Params_TypeID = 0xea82702ef3ce149a
+
+func NewProcess_Writer_write_Params(s *capnp.Segment) (Process_Writer_write_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_Writer_write_Params(st), err
+}
+
+func NewRootProcess_Writer_write_Params(s *capnp.Segment) (Process_Writer_write_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_Writer_write_Params(st), err
+}
+
+func ReadRootProcess_Writer_write_Params(msg *capnp.Message) (Process_Writer_write_Params, error) {
+	root, err := msg.Root()
+	return Process_Writer_write_Params(root.Struct()), err
+}
+
+func (s Process_Writer_write_Params) String() string {
+	str, _ := text.Marshal(0xea82702ef3ce149a, capnp.Struct(s))
+	return str
+}
+
+func (s Process_Writer_write_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_Writer_write_Params) DecodeFromPtr(p capnp.Ptr) Process_Writer_write_Params {
+	return Process_Writer_write_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_Writer_write_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_Writer_write_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_Writer_write_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_Writer_write_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Process_Writer_write_Params) Data() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Process_Writer_write_Params) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Process_Writer_write_Params) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// Process_Writer_write_Params_List is a list of Process_Writer_write_Params.
+type Process_Writer_write_Params_List = capnp.StructList[Process_Writer_write_Params]
+
+// NewProcess_Writer_write_Params creates a new list of Process_Writer_write_Params.
+func NewProcess_Writer_write_Params_List(s *capnp.Segment, sz int32) (Process_Writer_write_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Process_Writer_write_Params](l), err
+}
+
+// Process_Writer_write_Params_Future is a wrapper for a Process_Writer_write_Params promised by a client call.
+type Process_Writer_write_Params_Future struct{ *capnp.Future }
+
+func (f Process_Writer_write_Params_Future) Struct() (Process_Writer_write_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_Writer_write_Params(p.Struct()), err
+}
+
+type Process_Writer_close_Params capnp.Struct
+
+// Process_Writer_close_Params_TypeID is the unique identifier for the type Process_Writer_close_Params.
+const Process_Writer_close_Params_TypeID = 0xe3651e8b6fa9c0c0
+
+func NewProcess_Writer_close_Params(s *capnp.Segment) (Process_Writer_close_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_Writer_close_Params(st), err
+}
+
+func NewRootProcess_Writer_close_Params(s *capnp.Segment) (Process_Writer_close_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_Writer_close_Params(st), err
+}
+
+func ReadRootProcess_Writer_close_Params(msg *capnp.Message) (Process_Writer_close_Params, error) {
+	root, err := msg.Root()
+	return Process_Writer_close_Params(root.Struct()), err
+}
+
+func (s Process_Writer_close_Params) String() string {
+	str, _ := text.Marshal(0xe3651e8b6fa9c0c0, capnp.Struct(s))
+	return str
+}
+
+func (s Process_Writer_close_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_Writer_close_Params) DecodeFromPtr(p capnp.Ptr) Process_Writer_close_Params {
+	return Process_Writer_close_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_Writer_close_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_Writer_close_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_Writer_close_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_Writer_close_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_Writer_close_Params_List is a list of Process_Writer_close_Params.
+type Process_Writer_close_Params_List = capnp.StructList[Process_Writer_close_Params]
+
+// NewProcess_Writer_close_Params creates a new list of Process_Writer_close_Params.
+func NewProcess_Writer_close_Params_List(s *capnp.Segment, sz int32) (Process_Writer_close_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_Writer_close_Params](l), err
+}
+
+// Process_Writer_close_Params_Future is a wrapper for a Process_Writer_close_Params promised by a client call.
+type Process_Writer_close_Params_Future struct{ *capnp.Future }
+
+func (f Process_Writer_close_Params_Future) Struct() (Process_Writer_close_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_Writer_close_Params(p.Struct()), err
+}
+
+type Process_Writer_close_Results capnp.Struct
+
+// Process_Writer_close_Results_TypeID is the unique identifier for the type Process_Writer_close_Results.
+const Process_Writer_close_Results_TypeID = 0xbeefb36f3bb69a38
+
+func NewProcess_Writer_close_Results(s *capnp.Segment) (Process_Writer_close_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_Writer_close_Results(st), err
+}
+
+func NewRootProcess_Writer_close_Results(s *capnp.Segment) (Process_Writer_close_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_Writer_close_Results(st), err
+}
+
+func ReadRootProcess_Writer_close_Results(msg *capnp.Message) (Process_Writer_close_Results, error) {
+	root, err := msg.Root()
+	return Process_Writer_close_Results(root.Struct()), err
+}
+
+func (s Process_Writer_close_Results) String() string {
+	str, _ := text.Marshal(0xbeefb36f3bb69a38, capnp.Struct(s))
+	return str
+}
+
+func (s Process_Writer_close_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_Writer_close_Results) DecodeFromPtr(p capnp.Ptr) Process_Writer_close_Results {
+	return Process_Writer_close_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_Writer_close_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_Writer_close_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_Writer_close_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_Writer_close_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_Writer_close_Results_List is a list of Process_Writer_close_Results.
+type Process_Writer_close_Results_List = capnp.StructList[Process_Writer_close_Results]
+
+// NewProcess_Writer_close_Results creates a new list of Process_Writer_close_Results.
+func NewProcess_Writer_close_Results_List(s *capnp.Segment, sz int32) (Process_Writer_close_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_Writer_close_Results](l), err
+}
+
+// Process_Writer_close_Results_Future is a wrapper for a Process_Writer_close_Results promised by a client call.
+type Process_Writer_close_Results_Future struct{ *capnp.Future }
+
+func (f Process_Writer_close_Results_Future) Struct() (Process_Writer_close_Results, error) {
+	p, err := f.Future.Ptr()
+	return Process_Writer_close_Results(p.Struct()), err
+}
+
+type Process_wait_Params capnp.Struct
+
+// Process_wait_Params_TypeID is the unique identifier for the type Process_wait_Params.
+const Process_wait_Params_TypeID = 0xf9694ae208dbb3e3
+
+func NewProcess_wait_Params(s *capnp.Segment) (Process_wait_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_wait_Params(st), err
+}
+
+func NewRootProcess_wait_Params(s *capnp.Segment) (Process_wait_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_wait_Params(st), err
+}
+
+func ReadRootProcess_wait_Params(msg *capnp.Message) (Process_wait_Params, error) {
+	root, err := msg.Root()
+	return Process_wait_Params(root.Struct()), err
+}
+
+func (s Process_wait_Params) String() string {
+	str, _ := text.Marshal(0xf9694ae208dbb3e3, capnp.Struct(s))
+	return str
+}
+
+func (s Process_wait_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_wait_Params) DecodeFromPtr(p capnp.Ptr) Process_wait_Params {
+	return Process_wait_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_wait_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_wait_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_wait_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_wait_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_wait_Params_List is a list of Process_wait_Params.
+type Process_wait_Params_List = capnp.StructList[Process_wait_Params]
+
+// NewProcess_wait_Params creates a new list of Process_wait_Params.
+func NewProcess_wait_Params_List(s *capnp.Segment, sz int32) (Process_wait_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_wait_Params](l), err
+}
+
+// Process_wait_Params_Future is a wrapper for a Process_wait_Params promised by a client call.
+type Process_wait_Params_Future struct{ *capnp.Future }
+
+func (f Process_wait_Params_Future) Struct() (Process_wait_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_wait_Params(p.Struct()), err
+}
+
+type Process_wait_Results capnp.Struct
+
+// Process_wait_Results_TypeID is the unique identifier for the type Process_wait_Results.
+const Process_wait_Results_TypeID = 0xd72ab4a0243047ac
+
+func NewProcess_wait_Results(s *capnp.Segment) (Process_wait_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Process_wait_Results(st), err
+}
+
+func NewRootProcess_wait_Results(s *capnp.Segment) (Process_wait_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Process_wait_Results(st), err
+}
+
+func ReadRootProcess_wait_Results(msg *capnp.Message) (Process_wait_Results, error) {
+	root, err := msg.Root()
+	return Process_wait_Results(root.Struct()), err
+}
+
+func (s Process_wait_Results) String() string {
+	str, _ := text.Marshal(0xd72ab4a0243047ac, capnp.Struct(s))
+	return str
+}
+
+func (s Process_wait_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_wait_Results) DecodeFromPtr(p capnp.Ptr) Process_wait_Results {
+	return Process_wait_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
 func (s Process_wait_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Process_wait_Results) IsValid() bool {
+func (s Process_wait_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_wait_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_wait_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Process_wait_Results) ExitCode() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Process_wait_Results) SetExitCode(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Process_wait_Results) Killed() bool {
+	return capnp.Struct(s).Bit(32)
+}
+
+func (s Process_wait_Results) SetKilled(v bool) {
+	capnp.Struct(s).SetBit(32, v)
+}
+
+func (s Process_wait_Results) Status() (ExitStatus, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return ExitStatus(p.Struct()), err
+}
+
+func (s Process_wait_Results) HasStatus() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Process_wait_Results) SetStatus(v ExitStatus) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewStatus sets the status field to a newly
+// allocated ExitStatus struct, preferring placement in s's segment.
+func (s Process_wait_Results) NewStatus() (ExitStatus, error) {
+	ss, err := NewExitStatus(capnp.Struct(s).Segment())
+	if err != nil {
+		return ExitStatus{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Process_wait_Results_List is a list of Process_wait_Results.
+type Process_wait_Results_List = capnp.StructList[Process_wait_Results]
+
+// NewProcess_wait_Results creates a new list of Process_wait_Results.
+func NewProcess_wait_Results_List(s *capnp.Segment, sz int32) (Process_wait_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Process_wait_Results](l), err
+}
+
+// Process_wait_Results_Future is a wrapper for a Process_wait_Results promised by a client call.
+type Process_wait_Results_Future struct{ *capnp.Future }
+
+func (f Process_wait_Results_Future) Struct() (Process_wait_Results, error) {
+	p, err := f.Future.Ptr()
+	return Process_wait_Results(p.Struct()), err
+}
+func (p Process_wait_Results_Future) Status() ExitStatus_Future {
+	return ExitStatus_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Process_kill_Params capnp.Struct
+
+// Process_kill_Params_TypeID is the unique identifier for the type Process_kill_Params.
+const Process_kill_Params_TypeID = 0xeea7ae19b02f5d47
+
+func NewProcess_kill_Params(s *capnp.Segment) (Process_kill_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_kill_Params(st), err
+}
+
+func NewRootProcess_kill_Params(s *capnp.Segment) (Process_kill_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_kill_Params(st), err
+}
+
+func ReadRootProcess_kill_Params(msg *capnp.Message) (Process_kill_Params, error) {
+	root, err := msg.Root()
+	return Process_kill_Params(root.Struct()), err
+}
+
+func (s Process_kill_Params) String() string {
+	str, _ := text.Marshal(0xeea7ae19b02f5d47, capnp.Struct(s))
+	return str
+}
+
+func (s Process_kill_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_kill_Params) DecodeFromPtr(p capnp.Ptr) Process_kill_Params {
+	return Process_kill_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_kill_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_kill_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_kill_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_kill_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Process_kill_Params) Grace() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Process_kill_Params) SetGrace(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// Process_kill_Params_List is a list of Process_kill_Params.
+type Process_kill_Params_List = capnp.StructList[Process_kill_Params]
+
+// NewProcess_kill_Params creates a new list of Process_kill_Params.
+func NewProcess_kill_Params_List(s *capnp.Segment, sz int32) (Process_kill_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Process_kill_Params](l), err
+}
+
+// Process_kill_Params_Future is a wrapper for a Process_kill_Params promised by a client call.
+type Process_kill_Params_Future struct{ *capnp.Future }
+
+func (f Process_kill_Params_Future) Struct() (Process_kill_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_kill_Params(p.Struct()), err
+}
+
+type Process_kill_Results capnp.Struct
+
+// Process_kill_Results_TypeID is the unique identifier for the type Process_kill_Results.
+const Process_kill_Results_TypeID = 0xc53168b273d497ee
+
+func NewProcess_kill_Results(s *capnp.Segment) (Process_kill_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_kill_Results(st), err
+}
+
+func NewRootProcess_kill_Results(s *capnp.Segment) (Process_kill_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_kill_Results(st), err
+}
+
+func ReadRootProcess_kill_Results(msg *capnp.Message) (Process_kill_Results, error) {
+	root, err := msg.Root()
+	return Process_kill_Results(root.Struct()), err
+}
+
+func (s Process_kill_Results) String() string {
+	str, _ := text.Marshal(0xc53168b273d497ee, capnp.Struct(s))
+	return str
+}
+
+func (s Process_kill_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_kill_Results) DecodeFromPtr(p capnp.Ptr) Process_kill_Results {
+	return Process_kill_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_kill_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_kill_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_kill_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_kill_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_kill_Results_List is a list of Process_kill_Results.
+type Process_kill_Results_List = capnp.StructList[Process_kill_Results]
+
+// NewProcess_kill_Results creates a new list of Process_kill_Results.
+func NewProcess_kill_Results_List(s *capnp.Segment, sz int32) (Process_kill_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_kill_Results](l), err
+}
+
+// Process_kill_Results_Future is a wrapper for a Process_kill_Results promised by a client call.
+type Process_kill_Results_Future struct{ *capnp.Future }
+
+func (f Process_kill_Results_Future) Struct() (Process_kill_Results, error) {
+	p, err := f.Future.Ptr()
+	return Process_kill_Results(p.Struct()), err
+}
+
+type Process_stdout_Params capnp.Struct
+
+// Process_stdout_Params_TypeID is the unique identifier for the type Process_stdout_Params.
+const Process_stdout_Params_TypeID = 0xd22f75df06c187e8
+
+func NewProcess_stdout_Params(s *capnp.Segment) (Process_stdout_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdout_Params(st), err
+}
+
+func NewRootProcess_stdout_Params(s *capnp.Segment) (Process_stdout_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdout_Params(st), err
+}
+
+func ReadRootProcess_stdout_Params(msg *capnp.Message) (Process_stdout_Params, error) {
+	root, err := msg.Root()
+	return Process_stdout_Params(root.Struct()), err
+}
+
+func (s Process_stdout_Params) String() string {
+	str, _ := text.Marshal(0xd22f75df06c187e8, capnp.Struct(s))
+	return str
+}
+
+func (s Process_stdout_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_stdout_Params) DecodeFromPtr(p capnp.Ptr) Process_stdout_Params {
+	return Process_stdout_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_stdout_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_stdout_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_stdout_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_stdout_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Process_stdout_Params) Writer() Process_Writer {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Process_Writer(p.Interface().Client())
+}
+
+func (s Process_stdout_Params) HasWriter() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Process_stdout_Params) SetWriter(v Process_Writer) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Process_stdout_Params_List is a list of Process_stdout_Params.
+type Process_stdout_Params_List = capnp.StructList[Process_stdout_Params]
+
+// NewProcess_stdout_Params creates a new list of Process_stdout_Params.
+func NewProcess_stdout_Params_List(s *capnp.Segment, sz int32) (Process_stdout_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Process_stdout_Params](l), err
+}
+
+// Process_stdout_Params_Future is a wrapper for a Process_stdout_Params promised by a client call.
+type Process_stdout_Params_Future struct{ *capnp.Future }
+
+func (f Process_stdout_Params_Future) Struct() (Process_stdout_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_stdout_Params(p.Struct()), err
+}
+func (p Process_stdout_Params_Future) Writer() Process_Writer {
+	return Process_Writer(p.Future.Field(0, nil).Client())
+}
+
+type Process_stdout_Results capnp.Struct
+
+// Process_stdout_Results_TypeID is the unique identifier for the type Process_stdout_Results.
+const Process_stdout_Results_TypeID = 0x9d6074459fa0602b
+
+func NewProcess_stdout_Results(s *capnp.Segment) (Process_stdout_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdout_Results(st), err
+}
+
+func NewRootProcess_stdout_Results(s *capnp.Segment) (Process_stdout_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdout_Results(st), err
+}
+
+func ReadRootProcess_stdout_Results(msg *capnp.Message) (Process_stdout_Results, error) {
+	root, err := msg.Root()
+	return Process_stdout_Results(root.Struct()), err
+}
+
+func (s Process_stdout_Results) String() string {
+	str, _ := text.Marshal(0x9d6074459fa0602b, capnp.Struct(s))
+	return str
+}
+
+func (s Process_stdout_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_stdout_Results) DecodeFromPtr(p capnp.Ptr) Process_stdout_Results {
+	return Process_stdout_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_stdout_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_stdout_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_stdout_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_stdout_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_stdout_Results_List is a list of Process_stdout_Results.
+type Process_stdout_Results_List = capnp.StructList[Process_stdout_Results]
+
+// NewProcess_stdout_Results creates a new list of Process_stdout_Results.
+func NewProcess_stdout_Results_List(s *capnp.Segment, sz int32) (Process_stdout_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_stdout_Results](l), err
+}
+
+// Process_stdout_Results_Future is a wrapper for a Process_stdout_Results promised by a client call.
+type Process_stdout_Results_Future struct{ *capnp.Future }
+
+func (f Process_stdout_Results_Future) Struct() (Process_stdout_Results, error) {
+	p, err := f.Future.Ptr()
+	return Process_stdout_Results(p.Struct()), err
+}
+
+type Process_stderr_Params capnp.Struct
+
+// Process_stderr_Params_TypeID is the unique identifier for the type Process_stderr_Params.
+const Process_stderr_Params_TypeID = 0x86e3410d1abd406b
+
+func NewProcess_stderr_Params(s *capnp.Segment) (Process_stderr_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stderr_Params(st), err
+}
+
+func NewRootProcess_stderr_Params(s *capnp.Segment) (Process_stderr_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stderr_Params(st), err
+}
+
+func ReadRootProcess_stderr_Params(msg *capnp.Message) (Process_stderr_Params, error) {
+	root, err := msg.Root()
+	return Process_stderr_Params(root.Struct()), err
+}
+
+func (s Process_stderr_Params) String() string {
+	str, _ := text.Marshal(0x86e3410d1abd406b, capnp.Struct(s))
+	return str
+}
+
+func (s Process_stderr_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_stderr_Params) DecodeFromPtr(p capnp.Ptr) Process_stderr_Params {
+	return Process_stderr_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_stderr_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_stderr_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_stderr_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_stderr_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Process_stderr_Params) Writer() Process_Writer {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Process_Writer(p.Interface().Client())
+}
+
+func (s Process_stderr_Params) HasWriter() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Process_stderr_Params) SetWriter(v Process_Writer) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Process_stderr_Params_List is a list of Process_stderr_Params.
+type Process_stderr_Params_List = capnp.StructList[Process_stderr_Params]
+
+// NewProcess_stderr_Params creates a new list of Process_stderr_Params.
+func NewProcess_stderr_Params_List(s *capnp.Segment, sz int32) (Process_stderr_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Process_stderr_Params](l), err
+}
+
+// Process_stderr_Params_Future is a wrapper for a Process_stderr_Params promised by a client call.
+type Process_stderr_Params_Future struct{ *capnp.Future }
+
+func (f Process_stderr_Params_Future) Struct() (Process_stderr_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_stderr_Params(p.Struct()), err
+}
+func (p Process_stderr_Params_Future) Writer() Process_Writer {
+	return Process_Writer(p.Future.Field(0, nil).Client())
+}
+
+type Process_stderr_Results capnp.Struct
+
+// Process_stderr_Results_TypeID is the unique identifier for the type Process_stderr_Results.
+const Process_stderr_Results_TypeID = 0xd93c9aa0627bc93c
+
+func NewProcess_stderr_Results(s *capnp.Segment) (Process_stderr_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stderr_Results(st), err
+}
+
+func NewRootProcess_stderr_Results(s *capnp.Segment) (Process_stderr_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stderr_Results(st), err
+}
+
+func ReadRootProcess_stderr_Results(msg *capnp.Message) (Process_stderr_Results, error) {
+	root, err := msg.Root()
+	return Process_stderr_Results(root.Struct()), err
+}
+
+func (s Process_stderr_Results) String() string {
+	str, _ := text.Marshal(0xd93c9aa0627bc93c, capnp.Struct(s))
+	return str
+}
+
+func (s Process_stderr_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_stderr_Results) DecodeFromPtr(p capnp.Ptr) Process_stderr_Results {
+	return Process_stderr_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_stderr_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_stderr_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_stderr_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_stderr_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_stderr_Results_List is a list of Process_stderr_Results.
+type Process_stderr_Results_List = capnp.StructList[Process_stderr_Results]
+
+// NewProcess_stderr_Results creates a new list of Process_stderr_Results.
+func NewProcess_stderr_Results_List(s *capnp.Segment, sz int32) (Process_stderr_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_stderr_Results](l), err
+}
+
+// Process_stderr_Results_Future is a wrapper for a Process_stderr_Results promised by a client call.
+type Process_stderr_Results_Future struct{ *capnp.Future }
+
+func (f Process_stderr_Results_Future) Struct() (Process_stderr_Results, error) {
+	p, err := f.Future.Ptr()
+	return Process_stderr_Results(p.Struct()), err
+}
+
+type Process_stdin_Params capnp.Struct
+
+// Process_stdin_Params_TypeID is the unique identifier for the type Process_stdin_Params.
+const Process_stdin_Params_TypeID = 0xb72541d950858a60
+
+func NewProcess_stdin_Params(s *capnp.Segment) (Process_stdin_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdin_Params(st), err
+}
+
+func NewRootProcess_stdin_Params(s *capnp.Segment) (Process_stdin_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdin_Params(st), err
+}
+
+func ReadRootProcess_stdin_Params(msg *capnp.Message) (Process_stdin_Params, error) {
+	root, err := msg.Root()
+	return Process_stdin_Params(root.Struct()), err
+}
+
+func (s Process_stdin_Params) String() string {
+	str, _ := text.Marshal(0xb72541d950858a60, capnp.Struct(s))
+	return str
+}
+
+func (s Process_stdin_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_stdin_Params) DecodeFromPtr(p capnp.Ptr) Process_stdin_Params {
+	return Process_stdin_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_stdin_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_stdin_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_stdin_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_stdin_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Process_stdin_Params_List is a list of Process_stdin_Params.
+type Process_stdin_Params_List = capnp.StructList[Process_stdin_Params]
+
+// NewProcess_stdin_Params creates a new list of Process_stdin_Params.
+func NewProcess_stdin_Params_List(s *capnp.Segment, sz int32) (Process_stdin_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Process_stdin_Params](l), err
+}
+
+// Process_stdin_Params_Future is a wrapper for a Process_stdin_Params promised by a client call.
+type Process_stdin_Params_Future struct{ *capnp.Future }
+
+func (f Process_stdin_Params_Future) Struct() (Process_stdin_Params, error) {
+	p, err := f.Future.Ptr()
+	return Process_stdin_Params(p.Struct()), err
+}
+
+type Process_stdin_Results capnp.Struct
+
+// Process_stdin_Results_TypeID is the unique identifier for the type Process_stdin_Results.
+const Process_stdin_Results_TypeID = 0xf589dc1668ea3d8f
+
+func NewProcess_stdin_Results(s *capnp.Segment) (Process_stdin_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdin_Results(st), err
+}
+
+func NewRootProcess_stdin_Results(s *capnp.Segment) (Process_stdin_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdin_Results(st), err
+}
+
+func ReadRootProcess_stdin_Results(msg *capnp.Message) (Process_stdin_Results, error) {
+	root, err := msg.Root()
+	return Process_stdin_Results(root.Struct()), err
+}
+
+func (s Process_stdin_Results) String() string {
+	str, _ := text.Marshal(0xf589dc1668ea3d8f, capnp.Struct(s))
+	return str
+}
+
+func (s Process_stdin_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Process_stdin_Results) DecodeFromPtr(p capnp.Ptr) Process_stdin_Results {
+	return Process_stdin_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Process_stdin_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Process_stdin_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Process_stdin_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Process_stdin_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Process_stdin_Results) Writer() Process_Writer {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Process_Writer(p.Interface().Client())
+}
+
+func (s Process_stdin_Results) HasWriter() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Process_stdin_Results) SetWriter(v Process_Writer) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Process_stdin_Results_List is a list of Process_stdin_Results.
+type Process_stdin_Results_List = capnp.StructList[Process_stdin_Results]
+
+// NewProcess_stdin_Results creates a new list of Process_stdin_Results.
+func NewProcess_stdin_Results_List(s *capnp.Segment, sz int32) (Process_stdin_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Process_stdin_Results](l), err
+}
+
+// Process_stdin_Results_Future is a wrapper for a Process_stdin_Results promised by a client call.
+type Process_stdin_Results_Future struct{ *capnp.Future }
+
+func (f Process_stdin_Results_Future) Struct() (Process_stdin_Results, error) {
+	p, err := f.Future.Ptr()
+	return Process_stdin_Results(p.Struct()), err
+}
+func (p Process_stdin_Results_Future) Writer() Process_Writer {
+	return Process_Writer(p.Future.Field(0, nil).Client())
+}
+
+type ExitStatus capnp.Struct
+type ExitStatus_Which uint16
+
+const (
+	ExitStatus_Which_exit      ExitStatus_Which = 0
+	ExitStatus_Which_trap      ExitStatus_Which = 1
+	ExitStatus_Which_hostError ExitStatus_Which = 2
+	ExitStatus_Which_killed    ExitStatus_Which = 3
+	ExitStatus_Which_limit     ExitStatus_Which = 4
+)
+
+func (w ExitStatus_Which) String() string {
+	const s = "exittraphostErrorkilledlimit"
+	switch w {
+	case ExitStatus_Which_exit:
+		return s[0:4]
+	case ExitStatus_Which_trap:
+		return s[4:8]
+	case ExitStatus_Which_hostError:
+		return s[8:17]
+	case ExitStatus_Which_killed:
+		return s[17:23]
+	case ExitStatus_Which_limit:
+		return s[23:28]
+
+	}
+	return "ExitStatus_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// ExitStatus_TypeID is the unique identifier for the type ExitStatus.
+const ExitStatus_TypeID = 0xa85d5eebef9df7f3
+
+func NewExitStatus(s *capnp.Segment) (ExitStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ExitStatus(st), err
+}
+
+func NewRootExitStatus(s *capnp.Segment) (ExitStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ExitStatus(st), err
+}
+
+func ReadRootExitStatus(msg *capnp.Message) (ExitStatus, error) {
+	root, err := msg.Root()
+	return ExitStatus(root.Struct()), err
+}
+
+func (s ExitStatus) String() string {
+	str, _ := text.Marshal(0xa85d5eebef9df7f3, capnp.Struct(s))
+	return str
+}
+
+func (s ExitStatus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ExitStatus) DecodeFromPtr(p capnp.Ptr) ExitStatus {
+	return ExitStatus(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ExitStatus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s ExitStatus) Which() ExitStatus_Which {
+	return ExitStatus_Which(capnp.Struct(s).Uint16(4))
+}
+func (s ExitStatus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ExitStatus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ExitStatus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ExitStatus) Exit() uint32 {
+	if capnp.Struct(s).Uint16(4) != 0 {
+		panic("Which() != exit")
+	}
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s ExitStatus) SetExit(v uint32) {
+	capnp.Struct(s).SetUint16(4, 0)
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s ExitStatus) Trap() (ExitStatus_Trap, error) {
+	if capnp.Struct(s).Uint16(4) != 1 {
+		panic("Which() != trap")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return ExitStatus_Trap(p.Struct()), err
+}
+
+func (s ExitStatus) HasTrap() bool {
+	if capnp.Struct(s).Uint16(4) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ExitStatus) SetTrap(v ExitStatus_Trap) error {
+	capnp.Struct(s).SetUint16(4, 1)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewTrap sets the trap field to a newly
+// allocated ExitStatus_Trap struct, preferring placement in s's segment.
+func (s ExitStatus) NewTrap() (ExitStatus_Trap, error) {
+	capnp.Struct(s).SetUint16(4, 1)
+	ss, err := NewExitStatus_Trap(capnp.Struct(s).Segment())
+	if err != nil {
+		return ExitStatus_Trap{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s ExitStatus) HostError() (string, error) {
+	if capnp.Struct(s).Uint16(4) != 2 {
+		panic("Which() != hostError")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s ExitStatus) HasHostError() bool {
+	if capnp.Struct(s).Uint16(4) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ExitStatus) HostErrorBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s ExitStatus) SetHostError(v string) error {
+	capnp.Struct(s).SetUint16(4, 2)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ExitStatus) SetKilled() {
+	capnp.Struct(s).SetUint16(4, 3)
+
+}
+
+func (s ExitStatus) SetLimit() {
+	capnp.Struct(s).SetUint16(4, 4)
+
+}
+
+// ExitStatus_List is a list of ExitStatus.
+type ExitStatus_List = capnp.StructList[ExitStatus]
+
+// NewExitStatus creates a new list of ExitStatus.
+func NewExitStatus_List(s *capnp.Segment, sz int32) (ExitStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[ExitStatus](l), err
+}
+
+// ExitStatus_Future is a wrapper for a ExitStatus promised by a client call.
+type ExitStatus_Future struct{ *capnp.Future }
+
+func (f ExitStatus_Future) Struct() (ExitStatus, error) {
+	p, err := f.Future.Ptr()
+	return ExitStatus(p.Struct()), err
+}
+func (p ExitStatus_Future) Trap() ExitStatus_Trap_Future {
+	return ExitStatus_Trap_Future{Future: p.Future.Field(0, nil)}
+}
+
+type ExitStatus_Trap capnp.Struct
+
+// ExitStatus_Trap_TypeID is the unique identifier for the type ExitStatus_Trap.
+const ExitStatus_Trap_TypeID = 0xbcab3a53a92a2224
+
+func NewExitStatus_Trap(s *capnp.Segment) (ExitStatus_Trap, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return ExitStatus_Trap(st), err
+}
+
+func NewRootExitStatus_Trap(s *capnp.Segment) (ExitStatus_Trap, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return ExitStatus_Trap(st), err
+}
+
+func ReadRootExitStatus_Trap(msg *capnp.Message) (ExitStatus_Trap, error) {
+	root, err := msg.Root()
+	return ExitStatus_Trap(root.Struct()), err
+}
+
+func (s ExitStatus_Trap) String() string {
+	str, _ := text.Marshal(0xbcab3a53a92a2224, capnp.Struct(s))
+	return str
+}
+
+func (s ExitStatus_Trap) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ExitStatus_Trap) DecodeFromPtr(p capnp.Ptr) ExitStatus_Trap {
+	return ExitStatus_Trap(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ExitStatus_Trap) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ExitStatus_Trap) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ExitStatus_Trap) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ExitStatus_Trap) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ExitStatus_Trap) Reason() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s ExitStatus_Trap) HasReason() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ExitStatus_Trap) ReasonBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s ExitStatus_Trap) SetReason(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ExitStatus_Trap) Stack() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return capnp.TextList(p.List()), err
+}
+
+func (s ExitStatus_Trap) HasStack() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s ExitStatus_Trap) SetStack(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewStack sets the stack field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s ExitStatus_Trap) NewStack(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+
+// ExitStatus_Trap_List is a list of ExitStatus_Trap.
+type ExitStatus_Trap_List = capnp.StructList[ExitStatus_Trap]
+
+// NewExitStatus_Trap creates a new list of ExitStatus_Trap.
+func NewExitStatus_Trap_List(s *capnp.Segment, sz int32) (ExitStatus_Trap_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[ExitStatus_Trap](l), err
+}
+
+// ExitStatus_Trap_Future is a wrapper for a ExitStatus_Trap promised by a client call.
+type ExitStatus_Trap_Future struct{ *capnp.Future }
+
+func (f ExitStatus_Trap_Future) Struct() (ExitStatus_Trap, error) {
+	p, err := f.Future.Ptr()
+	return ExitStatus_Trap(p.Struct()), err
+}
+
+type Info capnp.Struct
+
+// Info_TypeID is the unique identifier for the type Info.
+const Info_TypeID = 0xc3153fa5a13d8a26
+
+func NewInfo(s *capnp.Segment) (Info, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
+	return Info(st), err
+}
+
+func NewRootInfo(s *capnp.Segment) (Info, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
+	return Info(st), err
+}
+
+func ReadRootInfo(msg *capnp.Message) (Info, error) {
+	root, err := msg.Root()
+	return Info(root.Struct()), err
+}
+
+func (s Info) String() string {
+	str, _ := text.Marshal(0xc3153fa5a13d8a26, capnp.Struct(s))
+	return str
+}
+
+func (s Info) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Info) DecodeFromPtr(p capnp.Ptr) Info {
+	return Info(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Info) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Info) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Info) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Info) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Info) Pid() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Info) SetPid(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Info) Ppid() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s Info) SetPpid(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+func (s Info) Cid() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Info) HasCid() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Info) SetCid(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+func (s Info) Args() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return capnp.TextList(p.List()), err
+}
+
+func (s Info) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Info) SetArgs(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Info) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+func (s Info) StartTime() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s Info) SetStartTime(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s Info) State() Info_State {
+	return Info_State(capnp.Struct(s).Uint16(16))
+}
+
+func (s Info) SetState(v Info_State) {
+	capnp.Struct(s).SetUint16(16, uint16(v))
+}
+
+func (s Info) Memory() uint64 {
+	return capnp.Struct(s).Uint64(24)
+}
+
+func (s Info) SetMemory(v uint64) {
+	capnp.Struct(s).SetUint64(24, v)
+}
+
+func (s Info) Calls() uint64 {
+	return capnp.Struct(s).Uint64(32)
+}
+
+func (s Info) SetCalls(v uint64) {
+	capnp.Struct(s).SetUint64(32, v)
+}
+
+// Info_List is a list of Info.
+type Info_List = capnp.StructList[Info]
+
+// NewInfo creates a new list of Info.
+func NewInfo_List(s *capnp.Segment, sz int32) (Info_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2}, sz)
+	return capnp.StructList[Info](l), err
+}
+
+// Info_Future is a wrapper for a Info promised by a client call.
+type Info_Future struct{ *capnp.Future }
+
+func (f Info_Future) Struct() (Info, error) {
+	p, err := f.Future.Ptr()
+	return Info(p.Struct()), err
+}
+
+type Info_State uint16
+
+// Info_State_TypeID is the unique identifier for the type Info_State.
+const Info_State_TypeID = 0xce2c5679a7828874
+
+// Values of Info_State.
+const (
+	Info_State_running Info_State = 0
+	Info_State_exited  Info_State = 1
+)
+
+// String returns the enum's constant name.
+func (c Info_State) String() string {
+	switch c {
+	case Info_State_running:
+		return "running"
+	case Info_State_exited:
+		return "exited"
+
+	default:
+		return ""
+	}
+}
+
+// Info_StateFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Info_StateFromString(c string) Info_State {
+	switch c {
+	case "running":
+		return Info_State_running
+	case "exited":
+		return Info_State_exited
+
+	default:
+		return 0
+	}
+}
+
+type Info_State_List = capnp.EnumList[Info_State]
+
+func NewInfo_State_List(s *capnp.Segment, sz int32) (Info_State_List, error) {
+	return capnp.NewEnumList[Info_State](s, sz)
+}
+
+type Event capnp.Struct
+type Event_Which uint16
+
+const (
+	Event_Which_spawn Event_Which = 0
+	Event_Which_exit  Event_Which = 1
+)
+
+func (w Event_Which) String() string {
+	const s = "spawnexit"
+	switch w {
+	case Event_Which_spawn:
+		return s[0:5]
+	case Event_Which_exit:
+		return s[5:9]
+
+	}
+	return "Event_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Event_TypeID is the unique identifier for the type Event.
+const Event_TypeID = 0xfc2e1e2b3df2697d
+
+func NewEvent(s *capnp.Segment) (Event, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Event(st), err
+}
+
+func NewRootEvent(s *capnp.Segment) (Event, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Event(st), err
+}
+
+func ReadRootEvent(msg *capnp.Message) (Event, error) {
+	root, err := msg.Root()
+	return Event(root.Struct()), err
+}
+
+func (s Event) String() string {
+	str, _ := text.Marshal(0xfc2e1e2b3df2697d, capnp.Struct(s))
+	return str
+}
+
+func (s Event) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Event) DecodeFromPtr(p capnp.Ptr) Event {
+	return Event(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Event) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s Event) Which() Event_Which {
+	return Event_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Event) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Event) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Event) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Event) Info() (Info, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Info(p.Struct()), err
+}
+
+func (s Event) HasInfo() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Event) SetInfo(v Info) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewInfo sets the info field to a newly
+// allocated Info struct, preferring placement in s's segment.
+func (s Event) NewInfo() (Info, error) {
+	ss, err := NewInfo(capnp.Struct(s).Segment())
+	if err != nil {
+		return Info{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Event) SetSpawn() {
+	capnp.Struct(s).SetUint16(0, 0)
+
+}
+
+func (s Event) Exit() uint32 {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != exit")
+	}
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s Event) SetExit(v uint32) {
+	capnp.Struct(s).SetUint16(0, 1)
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+// Event_List is a list of Event.
+type Event_List = capnp.StructList[Event]
+
+// NewEvent creates a new list of Event.
+func NewEvent_List(s *capnp.Segment, sz int32) (Event_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Event](l), err
+}
+
+// Event_Future is a wrapper for a Event promised by a client call.
+type Event_Future struct{ *capnp.Future }
+
+func (f Event_Future) Struct() (Event, error) {
+	p, err := f.Future.Ptr()
+	return Event(p.Struct()), err
+}
+func (p Event_Future) Info() Info_Future {
+	return Info_Future{Future: p.Future.Field(0, nil)}
+}
+
+type EventHandler capnp.Client
+
+// EventHandler_TypeID is the unique identifier for the type EventHandler.
+const EventHandler_TypeID = 0xea4441dc72c8feb6
+
+func (c EventHandler) Recv(ctx context.Context, params func(EventHandler_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xea4441dc72c8feb6,
+			MethodID:      0,
+			InterfaceName: "process.capnp:EventHandler",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(EventHandler_recv_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
+
+}
+
+func (c EventHandler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c EventHandler) String() string {
+	return "EventHandler(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c EventHandler) AddRef() EventHandler {
+	return EventHandler(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c EventHandler) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c EventHandler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c EventHandler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (EventHandler) DecodeFromPtr(p capnp.Ptr) EventHandler {
+	return EventHandler(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c EventHandler) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c EventHandler) IsSame(other EventHandler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c EventHandler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c EventHandler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A EventHandler_Server is a EventHandler with a local implementation.
+type EventHandler_Server interface {
+	Recv(context.Context, EventHandler_recv) error
+}
+
+// EventHandler_NewServer creates a new Server from an implementation of EventHandler_Server.
+func EventHandler_NewServer(s EventHandler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(EventHandler_Methods(nil, s), s, c)
+}
+
+// EventHandler_ServerToClient creates a new Client from an implementation of EventHandler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func EventHandler_ServerToClient(s EventHandler_Server) EventHandler {
+	return EventHandler(capnp.NewClient(EventHandler_NewServer(s)))
+}
+
+// EventHandler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func EventHandler_Methods(methods []server.Method, s EventHandler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xea4441dc72c8feb6,
+			MethodID:      0,
+			InterfaceName: "process.capnp:EventHandler",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, EventHandler_recv{call})
+		},
+	})
+
+	return methods
+}
+
+// EventHandler_recv holds the state for a server call to EventHandler.recv.
+// See server.Call for documentation.
+type EventHandler_recv struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c EventHandler_recv) Args() EventHandler_recv_Params {
+	return EventHandler_recv_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c EventHandler_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// EventHandler_List is a list of EventHandler.
+type EventHandler_List = capnp.CapList[EventHandler]
+
+// NewEventHandler creates a new list of EventHandler.
+func NewEventHandler_List(s *capnp.Segment, sz int32) (EventHandler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[EventHandler](l), err
+}
+
+type EventHandler_recv_Params capnp.Struct
+
+// EventHandler_recv_Params_TypeID is the unique identifier for the type EventHandler_recv_Params.
+const EventHandler_recv_Params_TypeID = 0x823b5c54a47330c8
+
+func NewEventHandler_recv_Params(s *capnp.Segment) (EventHandler_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EventHandler_recv_Params(st), err
+}
+
+func NewRootEventHandler_recv_Params(s *capnp.Segment) (EventHandler_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EventHandler_recv_Params(st), err
+}
+
+func ReadRootEventHandler_recv_Params(msg *capnp.Message) (EventHandler_recv_Params, error) {
+	root, err := msg.Root()
+	return EventHandler_recv_Params(root.Struct()), err
+}
+
+func (s EventHandler_recv_Params) String() string {
+	str, _ := text.Marshal(0x823b5c54a47330c8, capnp.Struct(s))
+	return str
+}
+
+func (s EventHandler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (EventHandler_recv_Params) DecodeFromPtr(p capnp.Ptr) EventHandler_recv_Params {
+	return EventHandler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s EventHandler_recv_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s EventHandler_recv_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s EventHandler_recv_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s EventHandler_recv_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s EventHandler_recv_Params) Event() (Event, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Event(p.Struct()), err
+}
+
+func (s EventHandler_recv_Params) HasEvent() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s EventHandler_recv_Params) SetEvent(v Event) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated Event struct, preferring placement in s's segment.
+func (s EventHandler_recv_Params) NewEvent() (Event, error) {
+	ss, err := NewEvent(capnp.Struct(s).Segment())
+	if err != nil {
+		return Event{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// EventHandler_recv_Params_List is a list of EventHandler_recv_Params.
+type EventHandler_recv_Params_List = capnp.StructList[EventHandler_recv_Params]
+
+// NewEventHandler_recv_Params creates a new list of EventHandler_recv_Params.
+func NewEventHandler_recv_Params_List(s *capnp.Segment, sz int32) (EventHandler_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[EventHandler_recv_Params](l), err
+}
+
+// EventHandler_recv_Params_Future is a wrapper for a EventHandler_recv_Params promised by a client call.
+type EventHandler_recv_Params_Future struct{ *capnp.Future }
+
+func (f EventHandler_recv_Params_Future) Struct() (EventHandler_recv_Params, error) {
+	p, err := f.Future.Ptr()
+	return EventHandler_recv_Params(p.Struct()), err
+}
+func (p EventHandler_recv_Params_Future) Event() Event_Future {
+	return Event_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Cap capnp.Struct
+
+// Cap_TypeID is the unique identifier for the type Cap.
+const Cap_TypeID = 0xdcc65655a4acaab8
+
+func NewCap(s *capnp.Segment) (Cap, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Cap(st), err
+}
+
+func NewRootCap(s *capnp.Segment) (Cap, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Cap(st), err
+}
+
+func ReadRootCap(msg *capnp.Message) (Cap, error) {
+	root, err := msg.Root()
+	return Cap(root.Struct()), err
+}
+
+func (s Cap) String() string {
+	str, _ := text.Marshal(0xdcc65655a4acaab8, capnp.Struct(s))
+	return str
+}
+
+func (s Cap) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Cap) DecodeFromPtr(p capnp.Ptr) Cap {
+	return Cap(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Cap) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Cap) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Cap) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Cap) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Cap) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Cap) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Cap) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Cap) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Cap) Client() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(1)
+	return p.Interface().Client()
+}
+
+func (s Cap) HasClient() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Cap) SetClient(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(1, in.ToPtr())
+}
+
+// Cap_List is a list of Cap.
+type Cap_List = capnp.StructList[Cap]
+
+// NewCap creates a new list of Cap.
+func NewCap_List(s *capnp.Segment, sz int32) (Cap_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Cap](l), err
+}
+
+// Cap_Future is a wrapper for a Cap promised by a client call.
+type Cap_Future struct{ *capnp.Future }
+
+func (f Cap_Future) Struct() (Cap, error) {
+	p, err := f.Future.Ptr()
+	return Cap(p.Struct()), err
+}
+func (p Cap_Future) Client() capnp.Client {
+	return p.Future.Field(1, nil).Client()
+}
+
+type BootContext capnp.Client
+
+// BootContext_TypeID is the unique identifier for the type BootContext.
+const BootContext_TypeID = 0xef622b23fee0980e
+
+func (c BootContext) Pid(ctx context.Context, params func(BootContext_pid_Params) error) (BootContext_pid_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      0,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "pid",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_pid_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_pid_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) Cid(ctx context.Context, params func(BootContext_cid_Params) error) (BootContext_cid_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      1,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "cid",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_cid_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_cid_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) Args(ctx context.Context, params func(BootContext_args_Params) error) (BootContext_args_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      2,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "args",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_args_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_args_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) Caps(ctx context.Context, params func(BootContext_caps_Params) error) (BootContext_caps_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      3,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "caps",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_caps_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_caps_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) SetPid(ctx context.Context, params func(BootContext_setPid_Params) error) (BootContext_setPid_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      4,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "setPid",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_setPid_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_setPid_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) SetCid(ctx context.Context, params func(BootContext_setCid_Params) error) (BootContext_setCid_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      5,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "setCid",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_setCid_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_setCid_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) Cap(ctx context.Context, params func(BootContext_cap_Params) error) (BootContext_cap_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      6,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "cap",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_cap_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_cap_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) Term(ctx context.Context, params func(BootContext_term_Params) error) (BootContext_term_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      7,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "term",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_term_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return BootContext_term_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c BootContext) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c BootContext) String() string {
+	return "BootContext(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c BootContext) AddRef() BootContext {
+	return BootContext(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c BootContext) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c BootContext) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c BootContext) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (BootContext) DecodeFromPtr(p capnp.Ptr) BootContext {
+	return BootContext(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c BootContext) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c BootContext) IsSame(other BootContext) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c BootContext) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c BootContext) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A BootContext_Server is a BootContext with a local implementation.
+type BootContext_Server interface {
+	Pid(context.Context, BootContext_pid) error
+
+	Cid(context.Context, BootContext_cid) error
+
+	Args(context.Context, BootContext_args) error
+
+	Caps(context.Context, BootContext_caps) error
+
+	SetPid(context.Context, BootContext_setPid) error
+
+	SetCid(context.Context, BootContext_setCid) error
+
+	Cap(context.Context, BootContext_cap) error
+
+	Term(context.Context, BootContext_term) error
+}
+
+// BootContext_NewServer creates a new Server from an implementation of BootContext_Server.
+func BootContext_NewServer(s BootContext_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(BootContext_Methods(nil, s), s, c)
+}
+
+// BootContext_ServerToClient creates a new Client from an implementation of BootContext_Server.
+// The caller is responsible for calling Release on the returned Client.
+func BootContext_ServerToClient(s BootContext_Server) BootContext {
+	return BootContext(capnp.NewClient(BootContext_NewServer(s)))
+}
+
+// BootContext_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func BootContext_Methods(methods []server.Method, s BootContext_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 8)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      0,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "pid",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Pid(ctx, BootContext_pid{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      1,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "cid",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Cid(ctx, BootContext_cid{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      2,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "args",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Args(ctx, BootContext_args{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      3,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "caps",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Caps(ctx, BootContext_caps{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      4,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "setPid",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SetPid(ctx, BootContext_setPid{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      5,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "setCid",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SetCid(ctx, BootContext_setCid{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      6,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "cap",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Cap(ctx, BootContext_cap{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef622b23fee0980e,
+			MethodID:      7,
+			InterfaceName: "process.capnp:BootContext",
+			MethodName:    "term",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Term(ctx, BootContext_term{call})
+		},
+	})
+
+	return methods
+}
+
+// BootContext_pid holds the state for a server call to BootContext.pid.
+// See server.Call for documentation.
+type BootContext_pid struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_pid) Args() BootContext_pid_Params {
+	return BootContext_pid_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_pid) AllocResults() (BootContext_pid_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return BootContext_pid_Results(r), err
+}
+
+// BootContext_cid holds the state for a server call to BootContext.cid.
+// See server.Call for documentation.
+type BootContext_cid struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_cid) Args() BootContext_cid_Params {
+	return BootContext_cid_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_cid) AllocResults() (BootContext_cid_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cid_Results(r), err
+}
+
+// BootContext_args holds the state for a server call to BootContext.args.
+// See server.Call for documentation.
+type BootContext_args struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_args) Args() BootContext_args_Params {
+	return BootContext_args_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_args) AllocResults() (BootContext_args_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_args_Results(r), err
+}
+
+// BootContext_caps holds the state for a server call to BootContext.caps.
+// See server.Call for documentation.
+type BootContext_caps struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_caps) Args() BootContext_caps_Params {
+	return BootContext_caps_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_caps) AllocResults() (BootContext_caps_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_caps_Results(r), err
+}
+
+// BootContext_setPid holds the state for a server call to BootContext.setPid.
+// See server.Call for documentation.
+type BootContext_setPid struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_setPid) Args() BootContext_setPid_Params {
+	return BootContext_setPid_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_setPid) AllocResults() (BootContext_setPid_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_setPid_Results(r), err
+}
+
+// BootContext_setCid holds the state for a server call to BootContext.setCid.
+// See server.Call for documentation.
+type BootContext_setCid struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_setCid) Args() BootContext_setCid_Params {
+	return BootContext_setCid_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_setCid) AllocResults() (BootContext_setCid_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_setCid_Results(r), err
+}
+
+// BootContext_cap holds the state for a server call to BootContext.cap.
+// See server.Call for documentation.
+type BootContext_cap struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_cap) Args() BootContext_cap_Params {
+	return BootContext_cap_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_cap) AllocResults() (BootContext_cap_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cap_Results(r), err
+}
+
+// BootContext_term holds the state for a server call to BootContext.term.
+// See server.Call for documentation.
+type BootContext_term struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c BootContext_term) Args() BootContext_term_Params {
+	return BootContext_term_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c BootContext_term) AllocResults() (BootContext_term_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_term_Results(r), err
+}
+
+// BootContext_List is a list of BootContext.
+type BootContext_List = capnp.CapList[BootContext]
+
+// NewBootContext creates a new list of BootContext.
+func NewBootContext_List(s *capnp.Segment, sz int32) (BootContext_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[BootContext](l), err
+}
+
+type BootContext_pid_Params capnp.Struct
+
+// BootContext_pid_Params_TypeID is the unique identifier for the type BootContext_pid_Params.
+const BootContext_pid_Params_TypeID = 0xa7600db255bca0c7
+
+func NewBootContext_pid_Params(s *capnp.Segment) (BootContext_pid_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_pid_Params(st), err
+}
+
+func NewRootBootContext_pid_Params(s *capnp.Segment) (BootContext_pid_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_pid_Params(st), err
+}
+
+func ReadRootBootContext_pid_Params(msg *capnp.Message) (BootContext_pid_Params, error) {
+	root, err := msg.Root()
+	return BootContext_pid_Params(root.Struct()), err
+}
+
+func (s BootContext_pid_Params) String() string {
+	str, _ := text.Marshal(0xa7600db255bca0c7, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_pid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_pid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_pid_Params {
+	return BootContext_pid_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_pid_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_pid_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_pid_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_pid_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// BootContext_pid_Params_List is a list of BootContext_pid_Params.
+type BootContext_pid_Params_List = capnp.StructList[BootContext_pid_Params]
+
+// NewBootContext_pid_Params creates a new list of BootContext_pid_Params.
+func NewBootContext_pid_Params_List(s *capnp.Segment, sz int32) (BootContext_pid_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_pid_Params](l), err
+}
+
+// BootContext_pid_Params_Future is a wrapper for a BootContext_pid_Params promised by a client call.
+type BootContext_pid_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_pid_Params_Future) Struct() (BootContext_pid_Params, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_pid_Params(p.Struct()), err
+}
+
+type BootContext_pid_Results capnp.Struct
+
+// BootContext_pid_Results_TypeID is the unique identifier for the type BootContext_pid_Results.
+const BootContext_pid_Results_TypeID = 0xe84ba4855da630b6
+
+func NewBootContext_pid_Results(s *capnp.Segment) (BootContext_pid_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return BootContext_pid_Results(st), err
+}
+
+func NewRootBootContext_pid_Results(s *capnp.Segment) (BootContext_pid_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return BootContext_pid_Results(st), err
+}
+
+func ReadRootBootContext_pid_Results(msg *capnp.Message) (BootContext_pid_Results, error) {
+	root, err := msg.Root()
+	return BootContext_pid_Results(root.Struct()), err
+}
+
+func (s BootContext_pid_Results) String() string {
+	str, _ := text.Marshal(0xe84ba4855da630b6, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_pid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_pid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_pid_Results {
+	return BootContext_pid_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_pid_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_pid_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_pid_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_pid_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s BootContext_pid_Results) Pid() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s BootContext_pid_Results) SetPid(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// BootContext_pid_Results_List is a list of BootContext_pid_Results.
+type BootContext_pid_Results_List = capnp.StructList[BootContext_pid_Results]
+
+// NewBootContext_pid_Results creates a new list of BootContext_pid_Results.
+func NewBootContext_pid_Results_List(s *capnp.Segment, sz int32) (BootContext_pid_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_pid_Results](l), err
+}
+
+// BootContext_pid_Results_Future is a wrapper for a BootContext_pid_Results promised by a client call.
+type BootContext_pid_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_pid_Results_Future) Struct() (BootContext_pid_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_pid_Results(p.Struct()), err
+}
+
+type BootContext_cid_Params capnp.Struct
+
+// BootContext_cid_Params_TypeID is the unique identifier for the type BootContext_cid_Params.
+const BootContext_cid_Params_TypeID = 0x8dbc523bcfdca829
+
+func NewBootContext_cid_Params(s *capnp.Segment) (BootContext_cid_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_cid_Params(st), err
+}
+
+func NewRootBootContext_cid_Params(s *capnp.Segment) (BootContext_cid_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_cid_Params(st), err
+}
+
+func ReadRootBootContext_cid_Params(msg *capnp.Message) (BootContext_cid_Params, error) {
+	root, err := msg.Root()
+	return BootContext_cid_Params(root.Struct()), err
+}
+
+func (s BootContext_cid_Params) String() string {
+	str, _ := text.Marshal(0x8dbc523bcfdca829, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_cid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_cid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_cid_Params {
+	return BootContext_cid_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_cid_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_cid_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_cid_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_cid_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// BootContext_cid_Params_List is a list of BootContext_cid_Params.
+type BootContext_cid_Params_List = capnp.StructList[BootContext_cid_Params]
+
+// NewBootContext_cid_Params creates a new list of BootContext_cid_Params.
+func NewBootContext_cid_Params_List(s *capnp.Segment, sz int32) (BootContext_cid_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_cid_Params](l), err
+}
+
+// BootContext_cid_Params_Future is a wrapper for a BootContext_cid_Params promised by a client call.
+type BootContext_cid_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_cid_Params_Future) Struct() (BootContext_cid_Params, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_cid_Params(p.Struct()), err
+}
+
+type BootContext_cid_Results capnp.Struct
+
+// BootContext_cid_Results_TypeID is the unique identifier for the type BootContext_cid_Results.
+const BootContext_cid_Results_TypeID = 0xda227d43770b4d13
+
+func NewBootContext_cid_Results(s *capnp.Segment) (BootContext_cid_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cid_Results(st), err
+}
+
+func NewRootBootContext_cid_Results(s *capnp.Segment) (BootContext_cid_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cid_Results(st), err
+}
+
+func ReadRootBootContext_cid_Results(msg *capnp.Message) (BootContext_cid_Results, error) {
+	root, err := msg.Root()
+	return BootContext_cid_Results(root.Struct()), err
+}
+
+func (s BootContext_cid_Results) String() string {
+	str, _ := text.Marshal(0xda227d43770b4d13, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_cid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_cid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_cid_Results {
+	return BootContext_cid_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_cid_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_cid_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_cid_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_cid_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s BootContext_cid_Results) Cid() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s BootContext_cid_Results) HasCid() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s BootContext_cid_Results) SetCid(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// BootContext_cid_Results_List is a list of BootContext_cid_Results.
+type BootContext_cid_Results_List = capnp.StructList[BootContext_cid_Results]
+
+// NewBootContext_cid_Results creates a new list of BootContext_cid_Results.
+func NewBootContext_cid_Results_List(s *capnp.Segment, sz int32) (BootContext_cid_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[BootContext_cid_Results](l), err
+}
+
+// BootContext_cid_Results_Future is a wrapper for a BootContext_cid_Results promised by a client call.
+type BootContext_cid_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_cid_Results_Future) Struct() (BootContext_cid_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_cid_Results(p.Struct()), err
+}
+
+type BootContext_args_Params capnp.Struct
+
+// BootContext_args_Params_TypeID is the unique identifier for the type BootContext_args_Params.
+const BootContext_args_Params_TypeID = 0xdd266b5e92d80bb6
+
+func NewBootContext_args_Params(s *capnp.Segment) (BootContext_args_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_args_Params(st), err
+}
+
+func NewRootBootContext_args_Params(s *capnp.Segment) (BootContext_args_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_args_Params(st), err
+}
+
+func ReadRootBootContext_args_Params(msg *capnp.Message) (BootContext_args_Params, error) {
+	root, err := msg.Root()
+	return BootContext_args_Params(root.Struct()), err
+}
+
+func (s BootContext_args_Params) String() string {
+	str, _ := text.Marshal(0xdd266b5e92d80bb6, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_args_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_args_Params) DecodeFromPtr(p capnp.Ptr) BootContext_args_Params {
+	return BootContext_args_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_args_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_args_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_args_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_args_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// BootContext_args_Params_List is a list of BootContext_args_Params.
+type BootContext_args_Params_List = capnp.StructList[BootContext_args_Params]
+
+// NewBootContext_args_Params creates a new list of BootContext_args_Params.
+func NewBootContext_args_Params_List(s *capnp.Segment, sz int32) (BootContext_args_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_args_Params](l), err
+}
+
+// BootContext_args_Params_Future is a wrapper for a BootContext_args_Params promised by a client call.
+type BootContext_args_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_args_Params_Future) Struct() (BootContext_args_Params, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_args_Params(p.Struct()), err
+}
+
+type BootContext_args_Results capnp.Struct
+
+// BootContext_args_Results_TypeID is the unique identifier for the type BootContext_args_Results.
+const BootContext_args_Results_TypeID = 0xda9aeb6068ce2f08
+
+func NewBootContext_args_Results(s *capnp.Segment) (BootContext_args_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_args_Results(st), err
+}
+
+func NewRootBootContext_args_Results(s *capnp.Segment) (BootContext_args_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_args_Results(st), err
+}
+
+func ReadRootBootContext_args_Results(msg *capnp.Message) (BootContext_args_Results, error) {
+	root, err := msg.Root()
+	return BootContext_args_Results(root.Struct()), err
+}
+
+func (s BootContext_args_Results) String() string {
+	str, _ := text.Marshal(0xda9aeb6068ce2f08, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_args_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_args_Results) DecodeFromPtr(p capnp.Ptr) BootContext_args_Results {
+	return BootContext_args_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_args_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_args_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_args_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_args_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s BootContext_args_Results) Args() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s BootContext_args_Results) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s BootContext_args_Results) SetArgs(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s BootContext_args_Results) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// BootContext_args_Results_List is a list of BootContext_args_Results.
+type BootContext_args_Results_List = capnp.StructList[BootContext_args_Results]
+
+// NewBootContext_args_Results creates a new list of BootContext_args_Results.
+func NewBootContext_args_Results_List(s *capnp.Segment, sz int32) (BootContext_args_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[BootContext_args_Results](l), err
+}
+
+// BootContext_args_Results_Future is a wrapper for a BootContext_args_Results promised by a client call.
+type BootContext_args_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_args_Results_Future) Struct() (BootContext_args_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_args_Results(p.Struct()), err
+}
+
+type BootContext_caps_Params capnp.Struct
+
+// BootContext_caps_Params_TypeID is the unique identifier for the type BootContext_caps_Params.
+const BootContext_caps_Params_TypeID = 0xb4c6412facf739e9
+
+func NewBootContext_caps_Params(s *capnp.Segment) (BootContext_caps_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_caps_Params(st), err
+}
+
+func NewRootBootContext_caps_Params(s *capnp.Segment) (BootContext_caps_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_caps_Params(st), err
+}
+
+func ReadRootBootContext_caps_Params(msg *capnp.Message) (BootContext_caps_Params, error) {
+	root, err := msg.Root()
+	return BootContext_caps_Params(root.Struct()), err
+}
+
+func (s BootContext_caps_Params) String() string {
+	str, _ := text.Marshal(0xb4c6412facf739e9, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_caps_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_caps_Params) DecodeFromPtr(p capnp.Ptr) BootContext_caps_Params {
+	return BootContext_caps_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_caps_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_caps_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_caps_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_caps_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// BootContext_caps_Params_List is a list of BootContext_caps_Params.
+type BootContext_caps_Params_List = capnp.StructList[BootContext_caps_Params]
+
+// NewBootContext_caps_Params creates a new list of BootContext_caps_Params.
+func NewBootContext_caps_Params_List(s *capnp.Segment, sz int32) (BootContext_caps_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_caps_Params](l), err
+}
+
+// BootContext_caps_Params_Future is a wrapper for a BootContext_caps_Params promised by a client call.
+type BootContext_caps_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_caps_Params_Future) Struct() (BootContext_caps_Params, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_caps_Params(p.Struct()), err
+}
+
+type BootContext_caps_Results capnp.Struct
+
+// BootContext_caps_Results_TypeID is the unique identifier for the type BootContext_caps_Results.
+const BootContext_caps_Results_TypeID = 0xccc01fd29eb6c672
+
+func NewBootContext_caps_Results(s *capnp.Segment) (BootContext_caps_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_caps_Results(st), err
+}
+
+func NewRootBootContext_caps_Results(s *capnp.Segment) (BootContext_caps_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_caps_Results(st), err
+}
+
+func ReadRootBootContext_caps_Results(msg *capnp.Message) (BootContext_caps_Results, error) {
+	root, err := msg.Root()
+	return BootContext_caps_Results(root.Struct()), err
+}
+
+func (s BootContext_caps_Results) String() string {
+	str, _ := text.Marshal(0xccc01fd29eb6c672, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_caps_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_caps_Results) DecodeFromPtr(p capnp.Ptr) BootContext_caps_Results {
+	return BootContext_caps_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_caps_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_caps_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_caps_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_caps_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s BootContext_caps_Results) Caps() (capnp.PointerList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.PointerList(p.List()), err
+}
+
+func (s BootContext_caps_Results) HasCaps() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s BootContext_caps_Results) SetCaps(v capnp.PointerList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated capnp.PointerList, preferring placement in s's segment.
+func (s BootContext_caps_Results) NewCaps(n int32) (capnp.PointerList, error) {
+	l, err := capnp.NewPointerList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.PointerList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// BootContext_caps_Results_List is a list of BootContext_caps_Results.
+type BootContext_caps_Results_List = capnp.StructList[BootContext_caps_Results]
+
+// NewBootContext_caps_Results creates a new list of BootContext_caps_Results.
+func NewBootContext_caps_Results_List(s *capnp.Segment, sz int32) (BootContext_caps_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[BootContext_caps_Results](l), err
+}
+
+// BootContext_caps_Results_Future is a wrapper for a BootContext_caps_Results promised by a client call.
+type BootContext_caps_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_caps_Results_Future) Struct() (BootContext_caps_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_caps_Results(p.Struct()), err
+}
+
+type BootContext_setPid_Params capnp.Struct
+
+// BootContext_setPid_Params_TypeID is the unique identifier for the type BootContext_setPid_Params.
+const BootContext_setPid_Params_TypeID = 0x9a476b9f1a755580
+
+func NewBootContext_setPid_Params(s *capnp.Segment) (BootContext_setPid_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return BootContext_setPid_Params(st), err
+}
+
+func NewRootBootContext_setPid_Params(s *capnp.Segment) (BootContext_setPid_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return BootContext_setPid_Params(st), err
+}
+
+func ReadRootBootContext_setPid_Params(msg *capnp.Message) (BootContext_setPid_Params, error) {
+	root, err := msg.Root()
+	return BootContext_setPid_Params(root.Struct()), err
+}
+
+func (s BootContext_setPid_Params) String() string {
+	str, _ := text.Marshal(0x9a476b9f1a755580, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_setPid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_setPid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_setPid_Params {
+	return BootContext_setPid_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_setPid_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_setPid_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_setPid_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_setPid_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s BootContext_setPid_Params) Pid() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s BootContext_setPid_Params) SetPid(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// BootContext_setPid_Params_List is a list of BootContext_setPid_Params.
+type BootContext_setPid_Params_List = capnp.StructList[BootContext_setPid_Params]
+
+// NewBootContext_setPid_Params creates a new list of BootContext_setPid_Params.
+func NewBootContext_setPid_Params_List(s *capnp.Segment, sz int32) (BootContext_setPid_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_setPid_Params](l), err
+}
+
+// BootContext_setPid_Params_Future is a wrapper for a BootContext_setPid_Params promised by a client call.
+type BootContext_setPid_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_setPid_Params_Future) Struct() (BootContext_setPid_Params, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_setPid_Params(p.Struct()), err
+}
+
+type BootContext_setPid_Results capnp.Struct
+
+// BootContext_setPid_Results_TypeID is the unique identifier for the type BootContext_setPid_Results.
+const BootContext_setPid_Results_TypeID = 0xf96299218f4522e8
+
+func NewBootContext_setPid_Results(s *capnp.Segment) (BootContext_setPid_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_setPid_Results(st), err
+}
+
+func NewRootBootContext_setPid_Results(s *capnp.Segment) (BootContext_setPid_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_setPid_Results(st), err
+}
+
+func ReadRootBootContext_setPid_Results(msg *capnp.Message) (BootContext_setPid_Results, error) {
+	root, err := msg.Root()
+	return BootContext_setPid_Results(root.Struct()), err
+}
+
+func (s BootContext_setPid_Results) String() string {
+	str, _ := text.Marshal(0xf96299218f4522e8, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_setPid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_setPid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_setPid_Results {
+	return BootContext_setPid_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_setPid_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_setPid_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_setPid_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_setPid_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// BootContext_setPid_Results_List is a list of BootContext_setPid_Results.
+type BootContext_setPid_Results_List = capnp.StructList[BootContext_setPid_Results]
+
+// NewBootContext_setPid_Results creates a new list of BootContext_setPid_Results.
+func NewBootContext_setPid_Results_List(s *capnp.Segment, sz int32) (BootContext_setPid_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_setPid_Results](l), err
+}
+
+// BootContext_setPid_Results_Future is a wrapper for a BootContext_setPid_Results promised by a client call.
+type BootContext_setPid_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_setPid_Results_Future) Struct() (BootContext_setPid_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_setPid_Results(p.Struct()), err
+}
+
+type BootContext_setCid_Params capnp.Struct
+
+// BootContext_setCid_Params_TypeID is the unique identifier for the type BootContext_setCid_Params.
+const BootContext_setCid_Params_TypeID = 0x97a28cda532de0ff
+
+func NewBootContext_setCid_Params(s *capnp.Segment) (BootContext_setCid_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_setCid_Params(st), err
+}
+
+func NewRootBootContext_setCid_Params(s *capnp.Segment) (BootContext_setCid_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_setCid_Params(st), err
+}
+
+func ReadRootBootContext_setCid_Params(msg *capnp.Message) (BootContext_setCid_Params, error) {
+	root, err := msg.Root()
+	return BootContext_setCid_Params(root.Struct()), err
+}
+
+func (s BootContext_setCid_Params) String() string {
+	str, _ := text.Marshal(0x97a28cda532de0ff, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_setCid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_setCid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_setCid_Params {
+	return BootContext_setCid_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_setCid_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_setCid_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_setCid_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_setCid_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s BootContext_setCid_Params) Cid() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s BootContext_setCid_Params) HasCid() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s BootContext_setCid_Params) SetCid(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// BootContext_setCid_Params_List is a list of BootContext_setCid_Params.
+type BootContext_setCid_Params_List = capnp.StructList[BootContext_setCid_Params]
+
+// NewBootContext_setCid_Params creates a new list of BootContext_setCid_Params.
+func NewBootContext_setCid_Params_List(s *capnp.Segment, sz int32) (BootContext_setCid_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[BootContext_setCid_Params](l), err
+}
+
+// BootContext_setCid_Params_Future is a wrapper for a BootContext_setCid_Params promised by a client call.
+type BootContext_setCid_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_setCid_Params_Future) Struct() (BootContext_setCid_Params, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_setCid_Params(p.Struct()), err
+}
+
+type BootContext_setCid_Results capnp.Struct
+
+// BootContext_setCid_Results_TypeID is the unique identifier for the type BootContext_setCid_Results.
+const BootContext_setCid_Results_TypeID = 0xaab0eb92d588b81e
+
+func NewBootContext_setCid_Results(s *capnp.Segment) (BootContext_setCid_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_setCid_Results(st), err
+}
+
+func NewRootBootContext_setCid_Results(s *capnp.Segment) (BootContext_setCid_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_setCid_Results(st), err
+}
+
+func ReadRootBootContext_setCid_Results(msg *capnp.Message) (BootContext_setCid_Results, error) {
+	root, err := msg.Root()
+	return BootContext_setCid_Results(root.Struct()), err
+}
+
+func (s BootContext_setCid_Results) String() string {
+	str, _ := text.Marshal(0xaab0eb92d588b81e, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_setCid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_setCid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_setCid_Results {
+	return BootContext_setCid_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_setCid_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_setCid_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s BootContext_setCid_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s BootContext_setCid_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// BootContext_setCid_Results_List is a list of BootContext_setCid_Results.
+type BootContext_setCid_Results_List = capnp.StructList[BootContext_setCid_Results]
+
+// NewBootContext_setCid_Results creates a new list of BootContext_setCid_Results.
+func NewBootContext_setCid_Results_List(s *capnp.Segment, sz int32) (BootContext_setCid_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_setCid_Results](l), err
+}
+
+// BootContext_setCid_Results_Future is a wrapper for a BootContext_setCid_Results promised by a client call.
+type BootContext_setCid_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_setCid_Results_Future) Struct() (BootContext_setCid_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_setCid_Results(p.Struct()), err
+}
+
+type BootContext_cap_Params capnp.Struct
+
+// BootContext_cap_Params_TypeID is the unique identifier for the type BootContext_cap_Params.
+const BootContext_cap_Params_TypeID = 0xea404e36f022ea2e
+
+func NewBootContext_cap_Params(s *capnp.Segment) (BootContext_cap_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cap_Params(st), err
+}
+
+func NewRootBootContext_cap_Params(s *capnp.Segment) (BootContext_cap_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cap_Params(st), err
+}
+
+func ReadRootBootContext_cap_Params(msg *capnp.Message) (BootContext_cap_Params, error) {
+	root, err := msg.Root()
+	return BootContext_cap_Params(root.Struct()), err
+}
+
+func (s BootContext_cap_Params) String() string {
+	str, _ := text.Marshal(0xea404e36f022ea2e, capnp.Struct(s))
+	return str
+}
+
+func (s BootContext_cap_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (BootContext_cap_Params) DecodeFromPtr(p capnp.Ptr) BootContext_cap_Params {
+	return BootContext_cap_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s BootContext_cap_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_cap_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Process_wait_Results) Message() *capnp.Message {
+func (s BootContext_cap_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Process_wait_Results) Segment() *capnp.Segment {
+func (s BootContext_cap_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Process_wait_Results) ExitCode() uint32 {
-	return capnp.Struct(s).Uint32(0)
+func (s BootContext_cap_Params) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
 }
 
-func (s Process_wait_Results) SetExitCode(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+func (s BootContext_cap_Params) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Process_wait_Results_List is a list of Process_wait_Results.
-type Process_wait_Results_List = capnp.StructList[Process_wait_Results]
+func (s BootContext_cap_Params) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
 
-// NewProcess_wait_Results creates a new list of Process_wait_Results.
-func NewProcess_wait_Results_List(s *capnp.Segment, sz int32) (Process_wait_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[Process_wait_Results](l), err
+func (s BootContext_cap_Params) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// Process_wait_Results_Future is a wrapper for a Process_wait_Results promised by a client call.
-type Process_wait_Results_Future struct{ *capnp.Future }
+// BootContext_cap_Params_List is a list of BootContext_cap_Params.
+type BootContext_cap_Params_List = capnp.StructList[BootContext_cap_Params]
 
-func (f Process_wait_Results_Future) Struct() (Process_wait_Results, error) {
+// NewBootContext_cap_Params creates a new list of BootContext_cap_Params.
+func NewBootContext_cap_Params_List(s *capnp.Segment, sz int32) (BootContext_cap_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[BootContext_cap_Params](l), err
+}
+
+// BootContext_cap_Params_Future is a wrapper for a BootContext_cap_Params promised by a client call.
+type BootContext_cap_Params_Future struct{ *capnp.Future }
+
+func (f BootContext_cap_Params_Future) Struct() (BootContext_cap_Params, error) {
 	p, err := f.Future.Ptr()
-	return Process_wait_Results(p.Struct()), err
+	return BootContext_cap_Params(p.Struct()), err
 }
 
-type Process_kill_Params capnp.Struct
+type BootContext_cap_Results capnp.Struct
 
-// Process_kill_Params_TypeID is the unique identifier for the type Process_kill_Params.
-const Process_kill_Params_TypeID = 0xeea7ae19b02f5d47
+// BootContext_cap_Results_TypeID is the unique identifier for the type BootContext_cap_Results.
+const BootContext_cap_Results_TypeID = 0x87cbe00b93cb9c46
 
-func NewProcess_kill_Params(s *capnp.Segment) (Process_kill_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Process_kill_Params(st), err
+func NewBootContext_cap_Results(s *capnp.Segment) (BootContext_cap_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cap_Results(st), err
 }
 
-func NewRootProcess_kill_Params(s *capnp.Segment) (Process_kill_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Process_kill_Params(st), err
+func NewRootBootContext_cap_Results(s *capnp.Segment) (BootContext_cap_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return BootContext_cap_Results(st), err
 }
 
-func ReadRootProcess_kill_Params(msg *capnp.Message) (Process_kill_Params, error) {
+func ReadRootBootContext_cap_Results(msg *capnp.Message) (BootContext_cap_Results, error) {
 	root, err := msg.Root()
-	return Process_kill_Params(root.Struct()), err
+	return BootContext_cap_Results(root.Struct()), err
 }
 
-func (s Process_kill_Params) String() string {
-	str, _ := text.Marshal(0xeea7ae19b02f5d47, capnp.Struct(s))
+func (s BootContext_cap_Results) String() string {
+	str, _ := text.Marshal(0x87cbe00b93cb9c46, capnp.Struct(s))
 	return str
 }
 
-func (s Process_kill_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s BootContext_cap_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Process_kill_Params) DecodeFromPtr(p capnp.Ptr) Process_kill_Params {
-	return Process_kill_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (BootContext_cap_Results) DecodeFromPtr(p capnp.Ptr) BootContext_cap_Results {
+	return BootContext_cap_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Process_kill_Params) ToPtr() capnp.Ptr {
+func (s BootContext_cap_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Process_kill_Params) IsValid() bool {
+func (s BootContext_cap_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Process_kill_Params) Message() *capnp.Message {
+func (s BootContext_cap_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Process_kill_Params) Segment() *capnp.Segment {
+func (s BootContext_cap_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s BootContext_cap_Results) Cap() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return p.Interface().Client()
+}
 
-// Process_kill_Params_List is a list of Process_kill_Params.
-type Process_kill_Params_List = capnp.StructList[Process_kill_Params]
+func (s BootContext_cap_Results) HasCap() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewProcess_kill_Params creates a new list of Process_kill_Params.
-func NewProcess_kill_Params_List(s *capnp.Segment, sz int32) (Process_kill_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Process_kill_Params](l), err
+func (s BootContext_cap_Results) SetCap(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
 }
 
-// Process_kill_Params_Future is a wrapper for a Process_kill_Params promised by a client call.
-type Process_kill_Params_Future struct{ *capnp.Future }
+// BootContext_cap_Results_List is a list of BootContext_cap_Results.
+type BootContext_cap_Results_List = capnp.StructList[BootContext_cap_Results]
 
-func (f Process_kill_Params_Future) Struct() (Process_kill_Params, error) {
+// NewBootContext_cap_Results creates a new list of BootContext_cap_Results.
+func NewBootContext_cap_Results_List(s *capnp.Segment, sz int32) (BootContext_cap_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[BootContext_cap_Results](l), err
+}
+
+// BootContext_cap_Results_Future is a wrapper for a BootContext_cap_Results promised by a client call.
+type BootContext_cap_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_cap_Results_Future) Struct() (BootContext_cap_Results, error) {
 	p, err := f.Future.Ptr()
-	return Process_kill_Params(p.Struct()), err
+	return BootContext_cap_Results(p.Struct()), err
+}
+func (p BootContext_cap_Results_Future) Cap() capnp.Client {
+	return p.Future.Field(0, nil).Client()
 }
 
-type Process_kill_Results capnp.Struct
+type BootContext_term_Params capnp.Struct
 
-// Process_kill_Results_TypeID is the unique identifier for the type Process_kill_Results.
-const Process_kill_Results_TypeID = 0xc53168b273d497ee
+// BootContext_term_Params_TypeID is the unique identifier for the type BootContext_term_Params.
+const BootContext_term_Params_TypeID = 0xfca94c1fc0347f9a
 
-func NewProcess_kill_Results(s *capnp.Segment) (Process_kill_Results, error) {
+func NewBootContext_term_Params(s *capnp.Segment) (BootContext_term_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Process_kill_Results(st), err
+	return BootContext_term_Params(st), err
 }
 
-func NewRootProcess_kill_Results(s *capnp.Segment) (Process_kill_Results, error) {
+func NewRootBootContext_term_Params(s *capnp.Segment) (BootContext_term_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Process_kill_Results(st), err
+	return BootContext_term_Params(st), err
 }
 
-func ReadRootProcess_kill_Results(msg *capnp.Message) (Process_kill_Results, error) {
+func ReadRootBootContext_term_Params(msg *capnp.Message) (BootContext_term_Params, error) {
 	root, err := msg.Root()
-	return Process_kill_Results(root.Struct()), err
+	return BootContext_term_Params(root.Struct()), err
 }
 
-func (s Process_kill_Results) String() string {
-	str, _ := text.Marshal(0xc53168b273d497ee, capnp.Struct(s))
+func (s BootContext_term_Params) String() string {
+	str, _ := text.Marshal(0xfca94c1fc0347f9a, capnp.Struct(s))
 	return str
 }
 
-func (s Process_kill_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s BootContext_term_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Process_kill_Results) DecodeFromPtr(p capnp.Ptr) Process_kill_Results {
-	return Process_kill_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (BootContext_term_Params) DecodeFromPtr(p capnp.Ptr) BootContext_term_Params {
+	return BootContext_term_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Process_kill_Results) ToPtr() capnp.Ptr {
+func (s BootContext_term_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Process_kill_Results) IsValid() bool {
+func (s BootContext_term_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Process_kill_Results) Message() *capnp.Message {
+func (s BootContext_term_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Process_kill_Results) Segment() *capnp.Segment {
+func (s BootContext_term_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 
-// Process_kill_Results_List is a list of Process_kill_Results.
-type Process_kill_Results_List = capnp.StructList[Process_kill_Results]
+// BootContext_term_Params_List is a list of BootContext_term_Params.
+type BootContext_term_Params_List = capnp.StructList[BootContext_term_Params]
 
-// NewProcess_kill_Results creates a new list of Process_kill_Results.
-func NewProcess_kill_Results_List(s *capnp.Segment, sz int32) (Process_kill_Results_List, error) {
+// NewBootContext_term_Params creates a new list of BootContext_term_Params.
+func NewBootContext_term_Params_List(s *capnp.Segment, sz int32) (BootContext_term_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Process_kill_Results](l), err
+	return capnp.StructList[BootContext_term_Params](l), err
 }
 
-// Process_kill_Results_Future is a wrapper for a Process_kill_Results promised by a client call.
-type Process_kill_Results_Future struct{ *capnp.Future }
+// BootContext_term_Params_Future is a wrapper for a BootContext_term_Params promised by a client call.
+type BootContext_term_Params_Future struct{ *capnp.Future }
 
-func (f Process_kill_Results_Future) Struct() (Process_kill_Results, error) {
+func (f BootContext_term_Params_Future) Struct() (BootContext_term_Params, error) {
 	p, err := f.Future.Ptr()
-	return Process_kill_Results(p.Struct()), err
+	return BootContext_term_Params(p.Struct()), err
 }
 
-type BootContext capnp.Client
+type BootContext_term_Results capnp.Struct
 
-// BootContext_TypeID is the unique identifier for the type BootContext.
-const BootContext_TypeID = 0xef622b23fee0980e
+// BootContext_term_Results_TypeID is the unique identifier for the type BootContext_term_Results.
+const BootContext_term_Results_TypeID = 0xeb752d2ac39bb2c0
 
-func (c BootContext) Pid(ctx context.Context, params func(BootContext_pid_Params) error) (BootContext_pid_Results_Future, capnp.ReleaseFunc) {
+func NewBootContext_term_Results(s *capnp.Segment) (BootContext_term_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_term_Results(st), err
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      0,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "pid",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_pid_Params(s)) }
-	}
+func NewRootBootContext_term_Results(s *capnp.Segment) (BootContext_term_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return BootContext_term_Results(st), err
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return BootContext_pid_Results_Future{Future: ans.Future()}, release
+func ReadRootBootContext_term_Results(msg *capnp.Message) (BootContext_term_Results, error) {
+	root, err := msg.Root()
+	return BootContext_term_Results(root.Struct()), err
+}
 
+func (s BootContext_term_Results) String() string {
+	str, _ := text.Marshal(0xeb752d2ac39bb2c0, capnp.Struct(s))
+	return str
 }
 
-func (c BootContext) Cid(ctx context.Context, params func(BootContext_cid_Params) error) (BootContext_cid_Results_Future, capnp.ReleaseFunc) {
+func (s BootContext_term_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      1,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "cid",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_cid_Params(s)) }
-	}
+func (BootContext_term_Results) DecodeFromPtr(p capnp.Ptr) BootContext_term_Results {
+	return BootContext_term_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return BootContext_cid_Results_Future{Future: ans.Future()}, release
+func (s BootContext_term_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s BootContext_term_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
 
+func (s BootContext_term_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (c BootContext) Args(ctx context.Context, params func(BootContext_args_Params) error) (BootContext_args_Results_Future, capnp.ReleaseFunc) {
+func (s BootContext_term_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      2,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "args",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_args_Params(s)) }
-	}
+// BootContext_term_Results_List is a list of BootContext_term_Results.
+type BootContext_term_Results_List = capnp.StructList[BootContext_term_Results]
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return BootContext_args_Results_Future{Future: ans.Future()}, release
+// NewBootContext_term_Results creates a new list of BootContext_term_Results.
+func NewBootContext_term_Results_List(s *capnp.Segment, sz int32) (BootContext_term_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[BootContext_term_Results](l), err
+}
 
+// BootContext_term_Results_Future is a wrapper for a BootContext_term_Results promised by a client call.
+type BootContext_term_Results_Future struct{ *capnp.Future }
+
+func (f BootContext_term_Results_Future) Struct() (BootContext_term_Results, error) {
+	p, err := f.Future.Ptr()
+	return BootContext_term_Results(p.Struct()), err
 }
 
-func (c BootContext) Caps(ctx context.Context, params func(BootContext_caps_Params) error) (BootContext_caps_Results_Future, capnp.ReleaseFunc) {
+type Supervisor capnp.Client
+
+// Supervisor_TypeID is the unique identifier for the type Supervisor.
+const Supervisor_TypeID = 0xcfdb9668711b98df
+
+func (c Supervisor) Add(ctx context.Context, params func(Supervisor_add_Params) error) (Supervisor_add_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      3,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "caps",
+			InterfaceID:   0xcfdb9668711b98df,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Supervisor",
+			MethodName:    "add",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_caps_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Supervisor_add_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return BootContext_caps_Results_Future{Future: ans.Future()}, release
+	return Supervisor_add_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c BootContext) SetPid(ctx context.Context, params func(BootContext_setPid_Params) error) (BootContext_setPid_Results_Future, capnp.ReleaseFunc) {
+func (c Supervisor) Watch(ctx context.Context, params func(Supervisor_watch_Params) error) (Supervisor_watch_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      4,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "setPid",
+			InterfaceID:   0xcfdb9668711b98df,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Supervisor",
+			MethodName:    "watch",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_setPid_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Supervisor_watch_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return BootContext_setPid_Results_Future{Future: ans.Future()}, release
+	return Supervisor_watch_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c BootContext) SetCid(ctx context.Context, params func(BootContext_setCid_Params) error) (BootContext_setCid_Results_Future, capnp.ReleaseFunc) {
+func (c Supervisor) Stop(ctx context.Context, params func(Supervisor_stop_Params) error) (Supervisor_stop_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      5,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "setCid",
+			InterfaceID:   0xcfdb9668711b98df,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Supervisor",
+			MethodName:    "stop",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(BootContext_setCid_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Supervisor_stop_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return BootContext_setCid_Results_Future{Future: ans.Future()}, release
+	return Supervisor_stop_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c BootContext) WaitStreaming() error {
+func (c Supervisor) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -1343,14 +5072,14 @@ func (c BootContext) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c BootContext) String() string {
-	return "BootContext(" + capnp.Client(c).String() + ")"
+func (c Supervisor) String() string {
+	return "Supervisor(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c BootContext) AddRef() BootContext {
-	return BootContext(capnp.Client(c).AddRef())
+func (c Supervisor) AddRef() Supervisor {
+	return Supervisor(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -1359,28 +5088,28 @@ func (c BootContext) AddRef() BootContext {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c BootContext) Release() {
+func (c Supervisor) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c BootContext) Resolve(ctx context.Context) error {
+func (c Supervisor) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c BootContext) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c Supervisor) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (BootContext) DecodeFromPtr(p capnp.Ptr) BootContext {
-	return BootContext(capnp.Client{}.DecodeFromPtr(p))
+func (Supervisor) DecodeFromPtr(p capnp.Ptr) Supervisor {
+	return Supervisor(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c BootContext) IsValid() bool {
+func (c Supervisor) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -1388,7 +5117,7 @@ func (c BootContext) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c BootContext) IsSame(other BootContext) bool {
+func (c Supervisor) IsSame(other Supervisor) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -1396,1199 +5125,1621 @@ func (c BootContext) IsSame(other BootContext) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c BootContext) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c Supervisor) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c BootContext) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
-}
-
-// A BootContext_Server is a BootContext with a local implementation.
-type BootContext_Server interface {
-	Pid(context.Context, BootContext_pid) error
-
-	Cid(context.Context, BootContext_cid) error
-
-	Args(context.Context, BootContext_args) error
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Supervisor) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
 
-	Caps(context.Context, BootContext_caps) error
+// A Supervisor_Server is a Supervisor with a local implementation.
+type Supervisor_Server interface {
+	Add(context.Context, Supervisor_add) error
 
-	SetPid(context.Context, BootContext_setPid) error
+	Watch(context.Context, Supervisor_watch) error
 
-	SetCid(context.Context, BootContext_setCid) error
+	Stop(context.Context, Supervisor_stop) error
 }
 
-// BootContext_NewServer creates a new Server from an implementation of BootContext_Server.
-func BootContext_NewServer(s BootContext_Server) *server.Server {
+// Supervisor_NewServer creates a new Server from an implementation of Supervisor_Server.
+func Supervisor_NewServer(s Supervisor_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(BootContext_Methods(nil, s), s, c)
+	return server.New(Supervisor_Methods(nil, s), s, c)
 }
 
-// BootContext_ServerToClient creates a new Client from an implementation of BootContext_Server.
+// Supervisor_ServerToClient creates a new Client from an implementation of Supervisor_Server.
 // The caller is responsible for calling Release on the returned Client.
-func BootContext_ServerToClient(s BootContext_Server) BootContext {
-	return BootContext(capnp.NewClient(BootContext_NewServer(s)))
+func Supervisor_ServerToClient(s Supervisor_Server) Supervisor {
+	return Supervisor(capnp.NewClient(Supervisor_NewServer(s)))
 }
 
-// BootContext_Methods appends Methods to a slice that invoke the methods on s.
+// Supervisor_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func BootContext_Methods(methods []server.Method, s BootContext_Server) []server.Method {
+func Supervisor_Methods(methods []server.Method, s Supervisor_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 6)
+		methods = make([]server.Method, 0, 3)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
+			InterfaceID:   0xcfdb9668711b98df,
 			MethodID:      0,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "pid",
+			InterfaceName: "process.capnp:Supervisor",
+			MethodName:    "add",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Pid(ctx, BootContext_pid{call})
+			return s.Add(ctx, Supervisor_add{call})
 		},
 	})
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
+			InterfaceID:   0xcfdb9668711b98df,
 			MethodID:      1,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "cid",
+			InterfaceName: "process.capnp:Supervisor",
+			MethodName:    "watch",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Cid(ctx, BootContext_cid{call})
+			return s.Watch(ctx, Supervisor_watch{call})
 		},
 	})
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
+			InterfaceID:   0xcfdb9668711b98df,
 			MethodID:      2,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "args",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Args(ctx, BootContext_args{call})
-		},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      3,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "caps",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Caps(ctx, BootContext_caps{call})
-		},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      4,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "setPid",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.SetPid(ctx, BootContext_setPid{call})
-		},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xef622b23fee0980e,
-			MethodID:      5,
-			InterfaceName: "process.capnp:BootContext",
-			MethodName:    "setCid",
+			InterfaceName: "process.capnp:Supervisor",
+			MethodName:    "stop",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.SetCid(ctx, BootContext_setCid{call})
+			return s.Stop(ctx, Supervisor_stop{call})
 		},
 	})
 
 	return methods
 }
 
-// BootContext_pid holds the state for a server call to BootContext.pid.
+// Supervisor_add holds the state for a server call to Supervisor.add.
 // See server.Call for documentation.
-type BootContext_pid struct {
+type Supervisor_add struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c BootContext_pid) Args() BootContext_pid_Params {
-	return BootContext_pid_Params(c.Call.Args())
+func (c Supervisor_add) Args() Supervisor_add_Params {
+	return Supervisor_add_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c BootContext_pid) AllocResults() (BootContext_pid_Results, error) {
+func (c Supervisor_add) AllocResults() (Supervisor_add_Results, error) {
 	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return BootContext_pid_Results(r), err
+	return Supervisor_add_Results(r), err
 }
 
-// BootContext_cid holds the state for a server call to BootContext.cid.
+// Supervisor_watch holds the state for a server call to Supervisor.watch.
 // See server.Call for documentation.
-type BootContext_cid struct {
+type Supervisor_watch struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c BootContext_cid) Args() BootContext_cid_Params {
-	return BootContext_cid_Params(c.Call.Args())
+func (c Supervisor_watch) Args() Supervisor_watch_Params {
+	return Supervisor_watch_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c BootContext_cid) AllocResults() (BootContext_cid_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_cid_Results(r), err
+func (c Supervisor_watch) AllocResults() (Supervisor_watch_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Supervisor_watch_Results(r), err
 }
 
-// BootContext_args holds the state for a server call to BootContext.args.
+// Supervisor_stop holds the state for a server call to Supervisor.stop.
 // See server.Call for documentation.
-type BootContext_args struct {
+type Supervisor_stop struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c BootContext_args) Args() BootContext_args_Params {
-	return BootContext_args_Params(c.Call.Args())
+func (c Supervisor_stop) Args() Supervisor_stop_Params {
+	return Supervisor_stop_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c BootContext_args) AllocResults() (BootContext_args_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_args_Results(r), err
+func (c Supervisor_stop) AllocResults() (Supervisor_stop_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Supervisor_stop_Results(r), err
 }
 
-// BootContext_caps holds the state for a server call to BootContext.caps.
-// See server.Call for documentation.
-type BootContext_caps struct {
-	*server.Call
-}
+// Supervisor_List is a list of Supervisor.
+type Supervisor_List = capnp.CapList[Supervisor]
 
-// Args returns the call's arguments.
-func (c BootContext_caps) Args() BootContext_caps_Params {
-	return BootContext_caps_Params(c.Call.Args())
+// NewSupervisor creates a new list of Supervisor.
+func NewSupervisor_List(s *capnp.Segment, sz int32) (Supervisor_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Supervisor](l), err
 }
 
-// AllocResults allocates the results struct.
-func (c BootContext_caps) AllocResults() (BootContext_caps_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_caps_Results(r), err
-}
+type Supervisor_Strategy uint16
 
-// BootContext_setPid holds the state for a server call to BootContext.setPid.
-// See server.Call for documentation.
-type BootContext_setPid struct {
-	*server.Call
-}
+// Supervisor_Strategy_TypeID is the unique identifier for the type Supervisor_Strategy.
+const Supervisor_Strategy_TypeID = 0xa1bf4878bc46fffc
 
-// Args returns the call's arguments.
-func (c BootContext_setPid) Args() BootContext_setPid_Params {
-	return BootContext_setPid_Params(c.Call.Args())
+// Values of Supervisor_Strategy.
+const (
+	Supervisor_Strategy_oneForOne Supervisor_Strategy = 0
+	Supervisor_Strategy_oneForAll Supervisor_Strategy = 1
+)
+
+// String returns the enum's constant name.
+func (c Supervisor_Strategy) String() string {
+	switch c {
+	case Supervisor_Strategy_oneForOne:
+		return "oneForOne"
+	case Supervisor_Strategy_oneForAll:
+		return "oneForAll"
+
+	default:
+		return ""
+	}
 }
 
-// AllocResults allocates the results struct.
-func (c BootContext_setPid) AllocResults() (BootContext_setPid_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_setPid_Results(r), err
+// Supervisor_StrategyFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Supervisor_StrategyFromString(c string) Supervisor_Strategy {
+	switch c {
+	case "oneForOne":
+		return Supervisor_Strategy_oneForOne
+	case "oneForAll":
+		return Supervisor_Strategy_oneForAll
+
+	default:
+		return 0
+	}
 }
 
-// BootContext_setCid holds the state for a server call to BootContext.setCid.
-// See server.Call for documentation.
-type BootContext_setCid struct {
-	*server.Call
+type Supervisor_Strategy_List = capnp.EnumList[Supervisor_Strategy]
+
+func NewSupervisor_Strategy_List(s *capnp.Segment, sz int32) (Supervisor_Strategy_List, error) {
+	return capnp.NewEnumList[Supervisor_Strategy](s, sz)
 }
 
-// Args returns the call's arguments.
-func (c BootContext_setCid) Args() BootContext_setCid_Params {
-	return BootContext_setCid_Params(c.Call.Args())
+type Supervisor_Restart uint16
+
+// Supervisor_Restart_TypeID is the unique identifier for the type Supervisor_Restart.
+const Supervisor_Restart_TypeID = 0xded7c51957d47222
+
+// Values of Supervisor_Restart.
+const (
+	Supervisor_Restart_never     Supervisor_Restart = 0
+	Supervisor_Restart_onFailure Supervisor_Restart = 1
+	Supervisor_Restart_always    Supervisor_Restart = 2
+)
+
+// String returns the enum's constant name.
+func (c Supervisor_Restart) String() string {
+	switch c {
+	case Supervisor_Restart_never:
+		return "never"
+	case Supervisor_Restart_onFailure:
+		return "onFailure"
+	case Supervisor_Restart_always:
+		return "always"
+
+	default:
+		return ""
+	}
 }
 
-// AllocResults allocates the results struct.
-func (c BootContext_setCid) AllocResults() (BootContext_setCid_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_setCid_Results(r), err
+// Supervisor_RestartFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Supervisor_RestartFromString(c string) Supervisor_Restart {
+	switch c {
+	case "never":
+		return Supervisor_Restart_never
+	case "onFailure":
+		return Supervisor_Restart_onFailure
+	case "always":
+		return Supervisor_Restart_always
+
+	default:
+		return 0
+	}
 }
 
-// BootContext_List is a list of BootContext.
-type BootContext_List = capnp.CapList[BootContext]
+type Supervisor_Restart_List = capnp.EnumList[Supervisor_Restart]
 
-// NewBootContext creates a new list of BootContext.
-func NewBootContext_List(s *capnp.Segment, sz int32) (BootContext_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[BootContext](l), err
+func NewSupervisor_Restart_List(s *capnp.Segment, sz int32) (Supervisor_Restart_List, error) {
+	return capnp.NewEnumList[Supervisor_Restart](s, sz)
 }
 
-type BootContext_pid_Params capnp.Struct
+type Supervisor_Config capnp.Struct
 
-// BootContext_pid_Params_TypeID is the unique identifier for the type BootContext_pid_Params.
-const BootContext_pid_Params_TypeID = 0xa7600db255bca0c7
+// Supervisor_Config_TypeID is the unique identifier for the type Supervisor_Config.
+const Supervisor_Config_TypeID = 0xdc277c6ed056c611
 
-func NewBootContext_pid_Params(s *capnp.Segment) (BootContext_pid_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_pid_Params(st), err
+func NewSupervisor_Config(s *capnp.Segment) (Supervisor_Config, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	return Supervisor_Config(st), err
 }
 
-func NewRootBootContext_pid_Params(s *capnp.Segment) (BootContext_pid_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_pid_Params(st), err
+func NewRootSupervisor_Config(s *capnp.Segment) (Supervisor_Config, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	return Supervisor_Config(st), err
 }
 
-func ReadRootBootContext_pid_Params(msg *capnp.Message) (BootContext_pid_Params, error) {
+func ReadRootSupervisor_Config(msg *capnp.Message) (Supervisor_Config, error) {
 	root, err := msg.Root()
-	return BootContext_pid_Params(root.Struct()), err
+	return Supervisor_Config(root.Struct()), err
 }
 
-func (s BootContext_pid_Params) String() string {
-	str, _ := text.Marshal(0xa7600db255bca0c7, capnp.Struct(s))
+func (s Supervisor_Config) String() string {
+	str, _ := text.Marshal(0xdc277c6ed056c611, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_pid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_Config) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_pid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_pid_Params {
-	return BootContext_pid_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_Config) DecodeFromPtr(p capnp.Ptr) Supervisor_Config {
+	return Supervisor_Config(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_pid_Params) ToPtr() capnp.Ptr {
+func (s Supervisor_Config) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_pid_Params) IsValid() bool {
+func (s Supervisor_Config) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_pid_Params) Message() *capnp.Message {
+func (s Supervisor_Config) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_pid_Params) Segment() *capnp.Segment {
+func (s Supervisor_Config) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s Supervisor_Config) Strategy() Supervisor_Strategy {
+	return Supervisor_Strategy(capnp.Struct(s).Uint16(0))
+}
 
-// BootContext_pid_Params_List is a list of BootContext_pid_Params.
-type BootContext_pid_Params_List = capnp.StructList[BootContext_pid_Params]
+func (s Supervisor_Config) SetStrategy(v Supervisor_Strategy) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
+}
 
-// NewBootContext_pid_Params creates a new list of BootContext_pid_Params.
-func NewBootContext_pid_Params_List(s *capnp.Segment, sz int32) (BootContext_pid_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_pid_Params](l), err
+func (s Supervisor_Config) MaxRestarts() uint32 {
+	return capnp.Struct(s).Uint32(4)
 }
 
-// BootContext_pid_Params_Future is a wrapper for a BootContext_pid_Params promised by a client call.
-type BootContext_pid_Params_Future struct{ *capnp.Future }
+func (s Supervisor_Config) SetMaxRestarts(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
 
-func (f BootContext_pid_Params_Future) Struct() (BootContext_pid_Params, error) {
+func (s Supervisor_Config) Window() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Supervisor_Config) SetWindow(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s Supervisor_Config) MinBackoff() uint64 {
+	return capnp.Struct(s).Uint64(16)
+}
+
+func (s Supervisor_Config) SetMinBackoff(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
+}
+
+func (s Supervisor_Config) MaxBackoff() uint64 {
+	return capnp.Struct(s).Uint64(24)
+}
+
+func (s Supervisor_Config) SetMaxBackoff(v uint64) {
+	capnp.Struct(s).SetUint64(24, v)
+}
+
+// Supervisor_Config_List is a list of Supervisor_Config.
+type Supervisor_Config_List = capnp.StructList[Supervisor_Config]
+
+// NewSupervisor_Config creates a new list of Supervisor_Config.
+func NewSupervisor_Config_List(s *capnp.Segment, sz int32) (Supervisor_Config_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0}, sz)
+	return capnp.StructList[Supervisor_Config](l), err
+}
+
+// Supervisor_Config_Future is a wrapper for a Supervisor_Config promised by a client call.
+type Supervisor_Config_Future struct{ *capnp.Future }
+
+func (f Supervisor_Config_Future) Struct() (Supervisor_Config, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_pid_Params(p.Struct()), err
+	return Supervisor_Config(p.Struct()), err
 }
 
-type BootContext_pid_Results capnp.Struct
+type Supervisor_Spec capnp.Struct
 
-// BootContext_pid_Results_TypeID is the unique identifier for the type BootContext_pid_Results.
-const BootContext_pid_Results_TypeID = 0xe84ba4855da630b6
+// Supervisor_Spec_TypeID is the unique identifier for the type Supervisor_Spec.
+const Supervisor_Spec_TypeID = 0x8dcc0b14234facc7
 
-func NewBootContext_pid_Results(s *capnp.Segment) (BootContext_pid_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return BootContext_pid_Results(st), err
+func NewSupervisor_Spec(s *capnp.Segment) (Supervisor_Spec, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Supervisor_Spec(st), err
 }
 
-func NewRootBootContext_pid_Results(s *capnp.Segment) (BootContext_pid_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return BootContext_pid_Results(st), err
+func NewRootSupervisor_Spec(s *capnp.Segment) (Supervisor_Spec, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Supervisor_Spec(st), err
 }
 
-func ReadRootBootContext_pid_Results(msg *capnp.Message) (BootContext_pid_Results, error) {
+func ReadRootSupervisor_Spec(msg *capnp.Message) (Supervisor_Spec, error) {
 	root, err := msg.Root()
-	return BootContext_pid_Results(root.Struct()), err
+	return Supervisor_Spec(root.Struct()), err
 }
 
-func (s BootContext_pid_Results) String() string {
-	str, _ := text.Marshal(0xe84ba4855da630b6, capnp.Struct(s))
+func (s Supervisor_Spec) String() string {
+	str, _ := text.Marshal(0x8dcc0b14234facc7, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_pid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_Spec) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_pid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_pid_Results {
-	return BootContext_pid_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_Spec) DecodeFromPtr(p capnp.Ptr) Supervisor_Spec {
+	return Supervisor_Spec(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_pid_Results) ToPtr() capnp.Ptr {
+func (s Supervisor_Spec) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_pid_Results) IsValid() bool {
+func (s Supervisor_Spec) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_pid_Results) Message() *capnp.Message {
+func (s Supervisor_Spec) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_pid_Results) Segment() *capnp.Segment {
+func (s Supervisor_Spec) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s BootContext_pid_Results) Pid() uint32 {
-	return capnp.Struct(s).Uint32(0)
+func (s Supervisor_Spec) Bytecode() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
 }
 
-func (s BootContext_pid_Results) SetPid(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+func (s Supervisor_Spec) HasBytecode() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// BootContext_pid_Results_List is a list of BootContext_pid_Results.
-type BootContext_pid_Results_List = capnp.StructList[BootContext_pid_Results]
-
-// NewBootContext_pid_Results creates a new list of BootContext_pid_Results.
-func NewBootContext_pid_Results_List(s *capnp.Segment, sz int32) (BootContext_pid_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_pid_Results](l), err
+func (s Supervisor_Spec) SetBytecode(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
 }
 
-// BootContext_pid_Results_Future is a wrapper for a BootContext_pid_Results promised by a client call.
-type BootContext_pid_Results_Future struct{ *capnp.Future }
-
-func (f BootContext_pid_Results_Future) Struct() (BootContext_pid_Results, error) {
-	p, err := f.Future.Ptr()
-	return BootContext_pid_Results(p.Struct()), err
+func (s Supervisor_Spec) Args() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return capnp.TextList(p.List()), err
 }
 
-type BootContext_cid_Params capnp.Struct
-
-// BootContext_cid_Params_TypeID is the unique identifier for the type BootContext_cid_Params.
-const BootContext_cid_Params_TypeID = 0x8dbc523bcfdca829
+func (s Supervisor_Spec) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
 
-func NewBootContext_cid_Params(s *capnp.Segment) (BootContext_cid_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_cid_Params(st), err
+func (s Supervisor_Spec) SetArgs(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
 }
 
-func NewRootBootContext_cid_Params(s *capnp.Segment) (BootContext_cid_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_cid_Params(st), err
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Supervisor_Spec) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+func (s Supervisor_Spec) Limits() (Limits, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return Limits(p.Struct()), err
 }
 
-func ReadRootBootContext_cid_Params(msg *capnp.Message) (BootContext_cid_Params, error) {
-	root, err := msg.Root()
-	return BootContext_cid_Params(root.Struct()), err
+func (s Supervisor_Spec) HasLimits() bool {
+	return capnp.Struct(s).HasPtr(2)
 }
 
-func (s BootContext_cid_Params) String() string {
-	str, _ := text.Marshal(0x8dbc523bcfdca829, capnp.Struct(s))
-	return str
+func (s Supervisor_Spec) SetLimits(v Limits) error {
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
 }
 
-func (s BootContext_cid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// NewLimits sets the limits field to a newly
+// allocated Limits struct, preferring placement in s's segment.
+func (s Supervisor_Spec) NewLimits() (Limits, error) {
+	ss, err := NewLimits(capnp.Struct(s).Segment())
+	if err != nil {
+		return Limits{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
 }
 
-func (BootContext_cid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_cid_Params {
-	return BootContext_cid_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (s Supervisor_Spec) Caps() (Cap_List, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return Cap_List(p.List()), err
 }
 
-func (s BootContext_cid_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (s Supervisor_Spec) HasCaps() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
-func (s BootContext_cid_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+func (s Supervisor_Spec) SetCaps(v Cap_List) error {
+	return capnp.Struct(s).SetPtr(3, v.ToPtr())
 }
 
-func (s BootContext_cid_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// NewCaps sets the caps field to a newly
+// allocated Cap_List, preferring placement in s's segment.
+func (s Supervisor_Spec) NewCaps(n int32) (Cap_List, error) {
+	l, err := NewCap_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return Cap_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
+	return l, err
+}
+func (s Supervisor_Spec) Restart() Supervisor_Restart {
+	return Supervisor_Restart(capnp.Struct(s).Uint16(0))
 }
 
-func (s BootContext_cid_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Supervisor_Spec) SetRestart(v Supervisor_Restart) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-// BootContext_cid_Params_List is a list of BootContext_cid_Params.
-type BootContext_cid_Params_List = capnp.StructList[BootContext_cid_Params]
+// Supervisor_Spec_List is a list of Supervisor_Spec.
+type Supervisor_Spec_List = capnp.StructList[Supervisor_Spec]
 
-// NewBootContext_cid_Params creates a new list of BootContext_cid_Params.
-func NewBootContext_cid_Params_List(s *capnp.Segment, sz int32) (BootContext_cid_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_cid_Params](l), err
+// NewSupervisor_Spec creates a new list of Supervisor_Spec.
+func NewSupervisor_Spec_List(s *capnp.Segment, sz int32) (Supervisor_Spec_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[Supervisor_Spec](l), err
 }
 
-// BootContext_cid_Params_Future is a wrapper for a BootContext_cid_Params promised by a client call.
-type BootContext_cid_Params_Future struct{ *capnp.Future }
+// Supervisor_Spec_Future is a wrapper for a Supervisor_Spec promised by a client call.
+type Supervisor_Spec_Future struct{ *capnp.Future }
 
-func (f BootContext_cid_Params_Future) Struct() (BootContext_cid_Params, error) {
+func (f Supervisor_Spec_Future) Struct() (Supervisor_Spec, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_cid_Params(p.Struct()), err
+	return Supervisor_Spec(p.Struct()), err
+}
+func (p Supervisor_Spec_Future) Limits() Limits_Future {
+	return Limits_Future{Future: p.Future.Field(2, nil)}
 }
 
-type BootContext_cid_Results capnp.Struct
+type Supervisor_Event capnp.Struct
+type Supervisor_Event_Which uint16
 
-// BootContext_cid_Results_TypeID is the unique identifier for the type BootContext_cid_Results.
-const BootContext_cid_Results_TypeID = 0xda227d43770b4d13
+const (
+	Supervisor_Event_Which_start   Supervisor_Event_Which = 0
+	Supervisor_Event_Which_exit    Supervisor_Event_Which = 1
+	Supervisor_Event_Which_restart Supervisor_Event_Which = 2
+	Supervisor_Event_Which_giveUp  Supervisor_Event_Which = 3
+)
 
-func NewBootContext_cid_Results(s *capnp.Segment) (BootContext_cid_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_cid_Results(st), err
+func (w Supervisor_Event_Which) String() string {
+	const s = "startexitrestartgiveUp"
+	switch w {
+	case Supervisor_Event_Which_start:
+		return s[0:5]
+	case Supervisor_Event_Which_exit:
+		return s[5:9]
+	case Supervisor_Event_Which_restart:
+		return s[9:16]
+	case Supervisor_Event_Which_giveUp:
+		return s[16:22]
+
+	}
+	return "Supervisor_Event_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func NewRootBootContext_cid_Results(s *capnp.Segment) (BootContext_cid_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_cid_Results(st), err
+// Supervisor_Event_TypeID is the unique identifier for the type Supervisor_Event.
+const Supervisor_Event_TypeID = 0xdcf37da4dc4b2fda
+
+func NewSupervisor_Event(s *capnp.Segment) (Supervisor_Event, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Supervisor_Event(st), err
 }
 
-func ReadRootBootContext_cid_Results(msg *capnp.Message) (BootContext_cid_Results, error) {
+func NewRootSupervisor_Event(s *capnp.Segment) (Supervisor_Event, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Supervisor_Event(st), err
+}
+
+func ReadRootSupervisor_Event(msg *capnp.Message) (Supervisor_Event, error) {
 	root, err := msg.Root()
-	return BootContext_cid_Results(root.Struct()), err
+	return Supervisor_Event(root.Struct()), err
 }
 
-func (s BootContext_cid_Results) String() string {
-	str, _ := text.Marshal(0xda227d43770b4d13, capnp.Struct(s))
+func (s Supervisor_Event) String() string {
+	str, _ := text.Marshal(0xdcf37da4dc4b2fda, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_cid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_Event) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_cid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_cid_Results {
-	return BootContext_cid_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_Event) DecodeFromPtr(p capnp.Ptr) Supervisor_Event {
+	return Supervisor_Event(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_cid_Results) ToPtr() capnp.Ptr {
+func (s Supervisor_Event) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_cid_Results) IsValid() bool {
+
+func (s Supervisor_Event) Which() Supervisor_Event_Which {
+	return Supervisor_Event_Which(capnp.Struct(s).Uint16(8))
+}
+func (s Supervisor_Event) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_cid_Results) Message() *capnp.Message {
+func (s Supervisor_Event) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_cid_Results) Segment() *capnp.Segment {
+func (s Supervisor_Event) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s BootContext_cid_Results) Cid() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+func (s Supervisor_Event) Child() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s BootContext_cid_Results) HasCid() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Supervisor_Event) SetChild(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s BootContext_cid_Results) SetCid(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+func (s Supervisor_Event) Pid() uint32 {
+	return capnp.Struct(s).Uint32(4)
 }
 
-// BootContext_cid_Results_List is a list of BootContext_cid_Results.
-type BootContext_cid_Results_List = capnp.StructList[BootContext_cid_Results]
-
-// NewBootContext_cid_Results creates a new list of BootContext_cid_Results.
-func NewBootContext_cid_Results_List(s *capnp.Segment, sz int32) (BootContext_cid_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[BootContext_cid_Results](l), err
+func (s Supervisor_Event) SetPid(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
 }
 
-// BootContext_cid_Results_Future is a wrapper for a BootContext_cid_Results promised by a client call.
-type BootContext_cid_Results_Future struct{ *capnp.Future }
+func (s Supervisor_Event) SetStart() {
+	capnp.Struct(s).SetUint16(8, 0)
 
-func (f BootContext_cid_Results_Future) Struct() (BootContext_cid_Results, error) {
-	p, err := f.Future.Ptr()
-	return BootContext_cid_Results(p.Struct()), err
 }
 
-type BootContext_args_Params capnp.Struct
-
-// BootContext_args_Params_TypeID is the unique identifier for the type BootContext_args_Params.
-const BootContext_args_Params_TypeID = 0xdd266b5e92d80bb6
-
-func NewBootContext_args_Params(s *capnp.Segment) (BootContext_args_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_args_Params(st), err
+func (s Supervisor_Event) Exit() uint32 {
+	if capnp.Struct(s).Uint16(8) != 1 {
+		panic("Which() != exit")
+	}
+	return capnp.Struct(s).Uint32(12)
 }
 
-func NewRootBootContext_args_Params(s *capnp.Segment) (BootContext_args_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_args_Params(st), err
+func (s Supervisor_Event) SetExit(v uint32) {
+	capnp.Struct(s).SetUint16(8, 1)
+	capnp.Struct(s).SetUint32(12, v)
 }
 
-func ReadRootBootContext_args_Params(msg *capnp.Message) (BootContext_args_Params, error) {
-	root, err := msg.Root()
-	return BootContext_args_Params(root.Struct()), err
+func (s Supervisor_Event) Restart() uint64 {
+	if capnp.Struct(s).Uint16(8) != 2 {
+		panic("Which() != restart")
+	}
+	return capnp.Struct(s).Uint64(16)
 }
 
-func (s BootContext_args_Params) String() string {
-	str, _ := text.Marshal(0xdd266b5e92d80bb6, capnp.Struct(s))
-	return str
+func (s Supervisor_Event) SetRestart(v uint64) {
+	capnp.Struct(s).SetUint16(8, 2)
+	capnp.Struct(s).SetUint64(16, v)
 }
 
-func (s BootContext_args_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
+func (s Supervisor_Event) SetGiveUp() {
+	capnp.Struct(s).SetUint16(8, 3)
 
-func (BootContext_args_Params) DecodeFromPtr(p capnp.Ptr) BootContext_args_Params {
-	return BootContext_args_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_args_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s BootContext_args_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
+// Supervisor_Event_List is a list of Supervisor_Event.
+type Supervisor_Event_List = capnp.StructList[Supervisor_Event]
 
-func (s BootContext_args_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// NewSupervisor_Event creates a new list of Supervisor_Event.
+func NewSupervisor_Event_List(s *capnp.Segment, sz int32) (Supervisor_Event_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Supervisor_Event](l), err
 }
 
-func (s BootContext_args_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Supervisor_Event_Future is a wrapper for a Supervisor_Event promised by a client call.
+type Supervisor_Event_Future struct{ *capnp.Future }
+
+func (f Supervisor_Event_Future) Struct() (Supervisor_Event, error) {
+	p, err := f.Future.Ptr()
+	return Supervisor_Event(p.Struct()), err
 }
 
-// BootContext_args_Params_List is a list of BootContext_args_Params.
-type BootContext_args_Params_List = capnp.StructList[BootContext_args_Params]
+type Supervisor_Handler capnp.Client
 
-// NewBootContext_args_Params creates a new list of BootContext_args_Params.
-func NewBootContext_args_Params_List(s *capnp.Segment, sz int32) (BootContext_args_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_args_Params](l), err
-}
+// Supervisor_Handler_TypeID is the unique identifier for the type Supervisor_Handler.
+const Supervisor_Handler_TypeID = 0xe6acf016150ad33d
 
-// BootContext_args_Params_Future is a wrapper for a BootContext_args_Params promised by a client call.
-type BootContext_args_Params_Future struct{ *capnp.Future }
+func (c Supervisor_Handler) Recv(ctx context.Context, params func(Supervisor_Handler_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe6acf016150ad33d,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Supervisor.Handler",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Supervisor_Handler_recv_Params(s)) }
+	}
 
-func (f BootContext_args_Params_Future) Struct() (BootContext_args_Params, error) {
-	p, err := f.Future.Ptr()
-	return BootContext_args_Params(p.Struct()), err
-}
+	return capnp.Client(c).SendStreamCall(ctx, s)
 
-type BootContext_args_Results capnp.Struct
+}
 
-// BootContext_args_Results_TypeID is the unique identifier for the type BootContext_args_Results.
-const BootContext_args_Results_TypeID = 0xda9aeb6068ce2f08
+func (c Supervisor_Handler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
 
-func NewBootContext_args_Results(s *capnp.Segment) (BootContext_args_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_args_Results(st), err
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Supervisor_Handler) String() string {
+	return "Supervisor_Handler(" + capnp.Client(c).String() + ")"
 }
 
-func NewRootBootContext_args_Results(s *capnp.Segment) (BootContext_args_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_args_Results(st), err
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Supervisor_Handler) AddRef() Supervisor_Handler {
+	return Supervisor_Handler(capnp.Client(c).AddRef())
 }
 
-func ReadRootBootContext_args_Results(msg *capnp.Message) (BootContext_args_Results, error) {
-	root, err := msg.Root()
-	return BootContext_args_Results(root.Struct()), err
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Supervisor_Handler) Release() {
+	capnp.Client(c).Release()
 }
 
-func (s BootContext_args_Results) String() string {
-	str, _ := text.Marshal(0xda9aeb6068ce2f08, capnp.Struct(s))
-	return str
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Supervisor_Handler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-func (s BootContext_args_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (c Supervisor_Handler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (BootContext_args_Results) DecodeFromPtr(p capnp.Ptr) BootContext_args_Results {
-	return BootContext_args_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_Handler) DecodeFromPtr(p capnp.Ptr) Supervisor_Handler {
+	return Supervisor_Handler(capnp.Client{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_args_Results) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Supervisor_Handler) IsValid() bool {
+	return capnp.Client(c).IsValid()
 }
-func (s BootContext_args_Results) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Supervisor_Handler) IsSame(other Supervisor_Handler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
-func (s BootContext_args_Results) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Supervisor_Handler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-func (s BootContext_args_Results) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Supervisor_Handler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
-func (s BootContext_args_Results) Args() (capnp.TextList, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.TextList(p.List()), err
+
+// A Supervisor_Handler_Server is a Supervisor_Handler with a local implementation.
+type Supervisor_Handler_Server interface {
+	Recv(context.Context, Supervisor_Handler_recv) error
 }
 
-func (s BootContext_args_Results) HasArgs() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Supervisor_Handler_NewServer creates a new Server from an implementation of Supervisor_Handler_Server.
+func Supervisor_Handler_NewServer(s Supervisor_Handler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Supervisor_Handler_Methods(nil, s), s, c)
 }
 
-func (s BootContext_args_Results) SetArgs(v capnp.TextList) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// Supervisor_Handler_ServerToClient creates a new Client from an implementation of Supervisor_Handler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Supervisor_Handler_ServerToClient(s Supervisor_Handler_Server) Supervisor_Handler {
+	return Supervisor_Handler(capnp.NewClient(Supervisor_Handler_NewServer(s)))
 }
 
-// NewArgs sets the args field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s BootContext_args_Results) NewArgs(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
+// Supervisor_Handler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Supervisor_Handler_Methods(methods []server.Method, s Supervisor_Handler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe6acf016150ad33d,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Supervisor.Handler",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, Supervisor_Handler_recv{call})
+		},
+	})
+
+	return methods
 }
 
-// BootContext_args_Results_List is a list of BootContext_args_Results.
-type BootContext_args_Results_List = capnp.StructList[BootContext_args_Results]
+// Supervisor_Handler_recv holds the state for a server call to Supervisor_Handler.recv.
+// See server.Call for documentation.
+type Supervisor_Handler_recv struct {
+	*server.Call
+}
 
-// NewBootContext_args_Results creates a new list of BootContext_args_Results.
-func NewBootContext_args_Results_List(s *capnp.Segment, sz int32) (BootContext_args_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[BootContext_args_Results](l), err
+// Args returns the call's arguments.
+func (c Supervisor_Handler_recv) Args() Supervisor_Handler_recv_Params {
+	return Supervisor_Handler_recv_Params(c.Call.Args())
 }
 
-// BootContext_args_Results_Future is a wrapper for a BootContext_args_Results promised by a client call.
-type BootContext_args_Results_Future struct{ *capnp.Future }
+// AllocResults allocates the results struct.
+func (c Supervisor_Handler_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
 
-func (f BootContext_args_Results_Future) Struct() (BootContext_args_Results, error) {
-	p, err := f.Future.Ptr()
-	return BootContext_args_Results(p.Struct()), err
+// Supervisor_Handler_List is a list of Supervisor_Handler.
+type Supervisor_Handler_List = capnp.CapList[Supervisor_Handler]
+
+// NewSupervisor_Handler creates a new list of Supervisor_Handler.
+func NewSupervisor_Handler_List(s *capnp.Segment, sz int32) (Supervisor_Handler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Supervisor_Handler](l), err
 }
 
-type BootContext_caps_Params capnp.Struct
+type Supervisor_Handler_recv_Params capnp.Struct
 
-// BootContext_caps_Params_TypeID is the unique identifier for the type BootContext_caps_Params.
-const BootContext_caps_Params_TypeID = 0xb4c6412facf739e9
+// Supervisor_Handler_recv_Params_TypeID is the unique identifier for the type Supervisor_Handler_recv_Params.
+const Supervisor_Handler_recv_Params_TypeID = 0xebacd20159020390
 
-func NewBootContext_caps_Params(s *capnp.Segment) (BootContext_caps_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_caps_Params(st), err
+func NewSupervisor_Handler_recv_Params(s *capnp.Segment) (Supervisor_Handler_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Supervisor_Handler_recv_Params(st), err
 }
 
-func NewRootBootContext_caps_Params(s *capnp.Segment) (BootContext_caps_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_caps_Params(st), err
+func NewRootSupervisor_Handler_recv_Params(s *capnp.Segment) (Supervisor_Handler_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Supervisor_Handler_recv_Params(st), err
 }
 
-func ReadRootBootContext_caps_Params(msg *capnp.Message) (BootContext_caps_Params, error) {
+func ReadRootSupervisor_Handler_recv_Params(msg *capnp.Message) (Supervisor_Handler_recv_Params, error) {
 	root, err := msg.Root()
-	return BootContext_caps_Params(root.Struct()), err
+	return Supervisor_Handler_recv_Params(root.Struct()), err
 }
 
-func (s BootContext_caps_Params) String() string {
-	str, _ := text.Marshal(0xb4c6412facf739e9, capnp.Struct(s))
+func (s Supervisor_Handler_recv_Params) String() string {
+	str, _ := text.Marshal(0xebacd20159020390, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_caps_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_Handler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_caps_Params) DecodeFromPtr(p capnp.Ptr) BootContext_caps_Params {
-	return BootContext_caps_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_Handler_recv_Params) DecodeFromPtr(p capnp.Ptr) Supervisor_Handler_recv_Params {
+	return Supervisor_Handler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_caps_Params) ToPtr() capnp.Ptr {
+func (s Supervisor_Handler_recv_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_caps_Params) IsValid() bool {
+func (s Supervisor_Handler_recv_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_caps_Params) Message() *capnp.Message {
+func (s Supervisor_Handler_recv_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_caps_Params) Segment() *capnp.Segment {
+func (s Supervisor_Handler_recv_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s Supervisor_Handler_recv_Params) Event() (Supervisor_Event, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Supervisor_Event(p.Struct()), err
+}
 
-// BootContext_caps_Params_List is a list of BootContext_caps_Params.
-type BootContext_caps_Params_List = capnp.StructList[BootContext_caps_Params]
+func (s Supervisor_Handler_recv_Params) HasEvent() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewBootContext_caps_Params creates a new list of BootContext_caps_Params.
-func NewBootContext_caps_Params_List(s *capnp.Segment, sz int32) (BootContext_caps_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_caps_Params](l), err
+func (s Supervisor_Handler_recv_Params) SetEvent(v Supervisor_Event) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-// BootContext_caps_Params_Future is a wrapper for a BootContext_caps_Params promised by a client call.
-type BootContext_caps_Params_Future struct{ *capnp.Future }
+// NewEvent sets the event field to a newly
+// allocated Supervisor_Event struct, preferring placement in s's segment.
+func (s Supervisor_Handler_recv_Params) NewEvent() (Supervisor_Event, error) {
+	ss, err := NewSupervisor_Event(capnp.Struct(s).Segment())
+	if err != nil {
+		return Supervisor_Event{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
 
-func (f BootContext_caps_Params_Future) Struct() (BootContext_caps_Params, error) {
+// Supervisor_Handler_recv_Params_List is a list of Supervisor_Handler_recv_Params.
+type Supervisor_Handler_recv_Params_List = capnp.StructList[Supervisor_Handler_recv_Params]
+
+// NewSupervisor_Handler_recv_Params creates a new list of Supervisor_Handler_recv_Params.
+func NewSupervisor_Handler_recv_Params_List(s *capnp.Segment, sz int32) (Supervisor_Handler_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Supervisor_Handler_recv_Params](l), err
+}
+
+// Supervisor_Handler_recv_Params_Future is a wrapper for a Supervisor_Handler_recv_Params promised by a client call.
+type Supervisor_Handler_recv_Params_Future struct{ *capnp.Future }
+
+func (f Supervisor_Handler_recv_Params_Future) Struct() (Supervisor_Handler_recv_Params, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_caps_Params(p.Struct()), err
+	return Supervisor_Handler_recv_Params(p.Struct()), err
+}
+func (p Supervisor_Handler_recv_Params_Future) Event() Supervisor_Event_Future {
+	return Supervisor_Event_Future{Future: p.Future.Field(0, nil)}
 }
 
-type BootContext_caps_Results capnp.Struct
+type Supervisor_add_Params capnp.Struct
 
-// BootContext_caps_Results_TypeID is the unique identifier for the type BootContext_caps_Results.
-const BootContext_caps_Results_TypeID = 0xccc01fd29eb6c672
+// Supervisor_add_Params_TypeID is the unique identifier for the type Supervisor_add_Params.
+const Supervisor_add_Params_TypeID = 0xf7de136b7f7bf9b6
 
-func NewBootContext_caps_Results(s *capnp.Segment) (BootContext_caps_Results, error) {
+func NewSupervisor_add_Params(s *capnp.Segment) (Supervisor_add_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_caps_Results(st), err
+	return Supervisor_add_Params(st), err
 }
 
-func NewRootBootContext_caps_Results(s *capnp.Segment) (BootContext_caps_Results, error) {
+func NewRootSupervisor_add_Params(s *capnp.Segment) (Supervisor_add_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_caps_Results(st), err
+	return Supervisor_add_Params(st), err
 }
 
-func ReadRootBootContext_caps_Results(msg *capnp.Message) (BootContext_caps_Results, error) {
+func ReadRootSupervisor_add_Params(msg *capnp.Message) (Supervisor_add_Params, error) {
 	root, err := msg.Root()
-	return BootContext_caps_Results(root.Struct()), err
+	return Supervisor_add_Params(root.Struct()), err
 }
 
-func (s BootContext_caps_Results) String() string {
-	str, _ := text.Marshal(0xccc01fd29eb6c672, capnp.Struct(s))
+func (s Supervisor_add_Params) String() string {
+	str, _ := text.Marshal(0xf7de136b7f7bf9b6, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_caps_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_add_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_caps_Results) DecodeFromPtr(p capnp.Ptr) BootContext_caps_Results {
-	return BootContext_caps_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_add_Params) DecodeFromPtr(p capnp.Ptr) Supervisor_add_Params {
+	return Supervisor_add_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_caps_Results) ToPtr() capnp.Ptr {
+func (s Supervisor_add_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_caps_Results) IsValid() bool {
+func (s Supervisor_add_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_caps_Results) Message() *capnp.Message {
+func (s Supervisor_add_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_caps_Results) Segment() *capnp.Segment {
+func (s Supervisor_add_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s BootContext_caps_Results) Caps() (capnp.PointerList, error) {
+func (s Supervisor_add_Params) Spec() (Supervisor_Spec, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.PointerList(p.List()), err
+	return Supervisor_Spec(p.Struct()), err
 }
 
-func (s BootContext_caps_Results) HasCaps() bool {
+func (s Supervisor_add_Params) HasSpec() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s BootContext_caps_Results) SetCaps(v capnp.PointerList) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Supervisor_add_Params) SetSpec(v Supervisor_Spec) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-// NewCaps sets the caps field to a newly
-// allocated capnp.PointerList, preferring placement in s's segment.
-func (s BootContext_caps_Results) NewCaps(n int32) (capnp.PointerList, error) {
-	l, err := capnp.NewPointerList(capnp.Struct(s).Segment(), n)
+// NewSpec sets the spec field to a newly
+// allocated Supervisor_Spec struct, preferring placement in s's segment.
+func (s Supervisor_add_Params) NewSpec() (Supervisor_Spec, error) {
+	ss, err := NewSupervisor_Spec(capnp.Struct(s).Segment())
 	if err != nil {
-		return capnp.PointerList{}, err
+		return Supervisor_Spec{}, err
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
 }
 
-// BootContext_caps_Results_List is a list of BootContext_caps_Results.
-type BootContext_caps_Results_List = capnp.StructList[BootContext_caps_Results]
+// Supervisor_add_Params_List is a list of Supervisor_add_Params.
+type Supervisor_add_Params_List = capnp.StructList[Supervisor_add_Params]
 
-// NewBootContext_caps_Results creates a new list of BootContext_caps_Results.
-func NewBootContext_caps_Results_List(s *capnp.Segment, sz int32) (BootContext_caps_Results_List, error) {
+// NewSupervisor_add_Params creates a new list of Supervisor_add_Params.
+func NewSupervisor_add_Params_List(s *capnp.Segment, sz int32) (Supervisor_add_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[BootContext_caps_Results](l), err
+	return capnp.StructList[Supervisor_add_Params](l), err
 }
 
-// BootContext_caps_Results_Future is a wrapper for a BootContext_caps_Results promised by a client call.
-type BootContext_caps_Results_Future struct{ *capnp.Future }
+// Supervisor_add_Params_Future is a wrapper for a Supervisor_add_Params promised by a client call.
+type Supervisor_add_Params_Future struct{ *capnp.Future }
 
-func (f BootContext_caps_Results_Future) Struct() (BootContext_caps_Results, error) {
+func (f Supervisor_add_Params_Future) Struct() (Supervisor_add_Params, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_caps_Results(p.Struct()), err
+	return Supervisor_add_Params(p.Struct()), err
+}
+func (p Supervisor_add_Params_Future) Spec() Supervisor_Spec_Future {
+	return Supervisor_Spec_Future{Future: p.Future.Field(0, nil)}
 }
 
-type BootContext_setPid_Params capnp.Struct
+type Supervisor_add_Results capnp.Struct
 
-// BootContext_setPid_Params_TypeID is the unique identifier for the type BootContext_setPid_Params.
-const BootContext_setPid_Params_TypeID = 0x9a476b9f1a755580
+// Supervisor_add_Results_TypeID is the unique identifier for the type Supervisor_add_Results.
+const Supervisor_add_Results_TypeID = 0xdac4ad2c402994f8
 
-func NewBootContext_setPid_Params(s *capnp.Segment) (BootContext_setPid_Params, error) {
+func NewSupervisor_add_Results(s *capnp.Segment) (Supervisor_add_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return BootContext_setPid_Params(st), err
+	return Supervisor_add_Results(st), err
 }
 
-func NewRootBootContext_setPid_Params(s *capnp.Segment) (BootContext_setPid_Params, error) {
+func NewRootSupervisor_add_Results(s *capnp.Segment) (Supervisor_add_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return BootContext_setPid_Params(st), err
+	return Supervisor_add_Results(st), err
 }
 
-func ReadRootBootContext_setPid_Params(msg *capnp.Message) (BootContext_setPid_Params, error) {
+func ReadRootSupervisor_add_Results(msg *capnp.Message) (Supervisor_add_Results, error) {
 	root, err := msg.Root()
-	return BootContext_setPid_Params(root.Struct()), err
+	return Supervisor_add_Results(root.Struct()), err
 }
 
-func (s BootContext_setPid_Params) String() string {
-	str, _ := text.Marshal(0x9a476b9f1a755580, capnp.Struct(s))
+func (s Supervisor_add_Results) String() string {
+	str, _ := text.Marshal(0xdac4ad2c402994f8, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_setPid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_add_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_setPid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_setPid_Params {
-	return BootContext_setPid_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_add_Results) DecodeFromPtr(p capnp.Ptr) Supervisor_add_Results {
+	return Supervisor_add_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_setPid_Params) ToPtr() capnp.Ptr {
+func (s Supervisor_add_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_setPid_Params) IsValid() bool {
+func (s Supervisor_add_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_setPid_Params) Message() *capnp.Message {
+func (s Supervisor_add_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_setPid_Params) Segment() *capnp.Segment {
+func (s Supervisor_add_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s BootContext_setPid_Params) Pid() uint32 {
+func (s Supervisor_add_Results) Id() uint32 {
 	return capnp.Struct(s).Uint32(0)
 }
 
-func (s BootContext_setPid_Params) SetPid(v uint32) {
+func (s Supervisor_add_Results) SetId(v uint32) {
 	capnp.Struct(s).SetUint32(0, v)
 }
 
-// BootContext_setPid_Params_List is a list of BootContext_setPid_Params.
-type BootContext_setPid_Params_List = capnp.StructList[BootContext_setPid_Params]
+// Supervisor_add_Results_List is a list of Supervisor_add_Results.
+type Supervisor_add_Results_List = capnp.StructList[Supervisor_add_Results]
 
-// NewBootContext_setPid_Params creates a new list of BootContext_setPid_Params.
-func NewBootContext_setPid_Params_List(s *capnp.Segment, sz int32) (BootContext_setPid_Params_List, error) {
+// NewSupervisor_add_Results creates a new list of Supervisor_add_Results.
+func NewSupervisor_add_Results_List(s *capnp.Segment, sz int32) (Supervisor_add_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_setPid_Params](l), err
+	return capnp.StructList[Supervisor_add_Results](l), err
 }
 
-// BootContext_setPid_Params_Future is a wrapper for a BootContext_setPid_Params promised by a client call.
-type BootContext_setPid_Params_Future struct{ *capnp.Future }
+// Supervisor_add_Results_Future is a wrapper for a Supervisor_add_Results promised by a client call.
+type Supervisor_add_Results_Future struct{ *capnp.Future }
 
-func (f BootContext_setPid_Params_Future) Struct() (BootContext_setPid_Params, error) {
+func (f Supervisor_add_Results_Future) Struct() (Supervisor_add_Results, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_setPid_Params(p.Struct()), err
+	return Supervisor_add_Results(p.Struct()), err
 }
 
-type BootContext_setPid_Results capnp.Struct
+type Supervisor_watch_Params capnp.Struct
 
-// BootContext_setPid_Results_TypeID is the unique identifier for the type BootContext_setPid_Results.
-const BootContext_setPid_Results_TypeID = 0xf96299218f4522e8
+// Supervisor_watch_Params_TypeID is the unique identifier for the type Supervisor_watch_Params.
+const Supervisor_watch_Params_TypeID = 0xd8a16fa7c41c6d1b
 
-func NewBootContext_setPid_Results(s *capnp.Segment) (BootContext_setPid_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_setPid_Results(st), err
+func NewSupervisor_watch_Params(s *capnp.Segment) (Supervisor_watch_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Supervisor_watch_Params(st), err
 }
 
-func NewRootBootContext_setPid_Results(s *capnp.Segment) (BootContext_setPid_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_setPid_Results(st), err
+func NewRootSupervisor_watch_Params(s *capnp.Segment) (Supervisor_watch_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Supervisor_watch_Params(st), err
 }
 
-func ReadRootBootContext_setPid_Results(msg *capnp.Message) (BootContext_setPid_Results, error) {
+func ReadRootSupervisor_watch_Params(msg *capnp.Message) (Supervisor_watch_Params, error) {
 	root, err := msg.Root()
-	return BootContext_setPid_Results(root.Struct()), err
+	return Supervisor_watch_Params(root.Struct()), err
 }
 
-func (s BootContext_setPid_Results) String() string {
-	str, _ := text.Marshal(0xf96299218f4522e8, capnp.Struct(s))
+func (s Supervisor_watch_Params) String() string {
+	str, _ := text.Marshal(0xd8a16fa7c41c6d1b, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_setPid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_watch_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_setPid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_setPid_Results {
-	return BootContext_setPid_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_watch_Params) DecodeFromPtr(p capnp.Ptr) Supervisor_watch_Params {
+	return Supervisor_watch_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_setPid_Results) ToPtr() capnp.Ptr {
+func (s Supervisor_watch_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_setPid_Results) IsValid() bool {
+func (s Supervisor_watch_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_setPid_Results) Message() *capnp.Message {
+func (s Supervisor_watch_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_setPid_Results) Segment() *capnp.Segment {
+func (s Supervisor_watch_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s Supervisor_watch_Params) Handler() Supervisor_Handler {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Supervisor_Handler(p.Interface().Client())
+}
 
-// BootContext_setPid_Results_List is a list of BootContext_setPid_Results.
-type BootContext_setPid_Results_List = capnp.StructList[BootContext_setPid_Results]
+func (s Supervisor_watch_Params) HasHandler() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewBootContext_setPid_Results creates a new list of BootContext_setPid_Results.
-func NewBootContext_setPid_Results_List(s *capnp.Segment, sz int32) (BootContext_setPid_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_setPid_Results](l), err
+func (s Supervisor_watch_Params) SetHandler(v Supervisor_Handler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
 }
 
-// BootContext_setPid_Results_Future is a wrapper for a BootContext_setPid_Results promised by a client call.
-type BootContext_setPid_Results_Future struct{ *capnp.Future }
+// Supervisor_watch_Params_List is a list of Supervisor_watch_Params.
+type Supervisor_watch_Params_List = capnp.StructList[Supervisor_watch_Params]
 
-func (f BootContext_setPid_Results_Future) Struct() (BootContext_setPid_Results, error) {
+// NewSupervisor_watch_Params creates a new list of Supervisor_watch_Params.
+func NewSupervisor_watch_Params_List(s *capnp.Segment, sz int32) (Supervisor_watch_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Supervisor_watch_Params](l), err
+}
+
+// Supervisor_watch_Params_Future is a wrapper for a Supervisor_watch_Params promised by a client call.
+type Supervisor_watch_Params_Future struct{ *capnp.Future }
+
+func (f Supervisor_watch_Params_Future) Struct() (Supervisor_watch_Params, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_setPid_Results(p.Struct()), err
+	return Supervisor_watch_Params(p.Struct()), err
+}
+func (p Supervisor_watch_Params_Future) Handler() Supervisor_Handler {
+	return Supervisor_Handler(p.Future.Field(0, nil).Client())
 }
 
-type BootContext_setCid_Params capnp.Struct
+type Supervisor_watch_Results capnp.Struct
 
-// BootContext_setCid_Params_TypeID is the unique identifier for the type BootContext_setCid_Params.
-const BootContext_setCid_Params_TypeID = 0x97a28cda532de0ff
+// Supervisor_watch_Results_TypeID is the unique identifier for the type Supervisor_watch_Results.
+const Supervisor_watch_Results_TypeID = 0x90f58dae1cf0cca9
 
-func NewBootContext_setCid_Params(s *capnp.Segment) (BootContext_setCid_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_setCid_Params(st), err
+func NewSupervisor_watch_Results(s *capnp.Segment) (Supervisor_watch_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Supervisor_watch_Results(st), err
 }
 
-func NewRootBootContext_setCid_Params(s *capnp.Segment) (BootContext_setCid_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return BootContext_setCid_Params(st), err
+func NewRootSupervisor_watch_Results(s *capnp.Segment) (Supervisor_watch_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Supervisor_watch_Results(st), err
 }
 
-func ReadRootBootContext_setCid_Params(msg *capnp.Message) (BootContext_setCid_Params, error) {
+func ReadRootSupervisor_watch_Results(msg *capnp.Message) (Supervisor_watch_Results, error) {
 	root, err := msg.Root()
-	return BootContext_setCid_Params(root.Struct()), err
+	return Supervisor_watch_Results(root.Struct()), err
 }
 
-func (s BootContext_setCid_Params) String() string {
-	str, _ := text.Marshal(0x97a28cda532de0ff, capnp.Struct(s))
+func (s Supervisor_watch_Results) String() string {
+	str, _ := text.Marshal(0x90f58dae1cf0cca9, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_setCid_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_watch_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_setCid_Params) DecodeFromPtr(p capnp.Ptr) BootContext_setCid_Params {
-	return BootContext_setCid_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_watch_Results) DecodeFromPtr(p capnp.Ptr) Supervisor_watch_Results {
+	return Supervisor_watch_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_setCid_Params) ToPtr() capnp.Ptr {
+func (s Supervisor_watch_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_setCid_Params) IsValid() bool {
+func (s Supervisor_watch_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_setCid_Params) Message() *capnp.Message {
+func (s Supervisor_watch_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_setCid_Params) Segment() *capnp.Segment {
+func (s Supervisor_watch_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s BootContext_setCid_Params) Cid() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+
+// Supervisor_watch_Results_List is a list of Supervisor_watch_Results.
+type Supervisor_watch_Results_List = capnp.StructList[Supervisor_watch_Results]
+
+// NewSupervisor_watch_Results creates a new list of Supervisor_watch_Results.
+func NewSupervisor_watch_Results_List(s *capnp.Segment, sz int32) (Supervisor_watch_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Supervisor_watch_Results](l), err
 }
 
-func (s BootContext_setCid_Params) HasCid() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Supervisor_watch_Results_Future is a wrapper for a Supervisor_watch_Results promised by a client call.
+type Supervisor_watch_Results_Future struct{ *capnp.Future }
+
+func (f Supervisor_watch_Results_Future) Struct() (Supervisor_watch_Results, error) {
+	p, err := f.Future.Ptr()
+	return Supervisor_watch_Results(p.Struct()), err
 }
 
-func (s BootContext_setCid_Params) SetCid(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+type Supervisor_stop_Params capnp.Struct
+
+// Supervisor_stop_Params_TypeID is the unique identifier for the type Supervisor_stop_Params.
+const Supervisor_stop_Params_TypeID = 0xa4603b136c67e9b4
+
+func NewSupervisor_stop_Params(s *capnp.Segment) (Supervisor_stop_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Supervisor_stop_Params(st), err
 }
 
-// BootContext_setCid_Params_List is a list of BootContext_setCid_Params.
-type BootContext_setCid_Params_List = capnp.StructList[BootContext_setCid_Params]
+func NewRootSupervisor_stop_Params(s *capnp.Segment) (Supervisor_stop_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Supervisor_stop_Params(st), err
+}
 
-// NewBootContext_setCid_Params creates a new list of BootContext_setCid_Params.
-func NewBootContext_setCid_Params_List(s *capnp.Segment, sz int32) (BootContext_setCid_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[BootContext_setCid_Params](l), err
+func ReadRootSupervisor_stop_Params(msg *capnp.Message) (Supervisor_stop_Params, error) {
+	root, err := msg.Root()
+	return Supervisor_stop_Params(root.Struct()), err
 }
 
-// BootContext_setCid_Params_Future is a wrapper for a BootContext_setCid_Params promised by a client call.
-type BootContext_setCid_Params_Future struct{ *capnp.Future }
+func (s Supervisor_stop_Params) String() string {
+	str, _ := text.Marshal(0xa4603b136c67e9b4, capnp.Struct(s))
+	return str
+}
 
-func (f BootContext_setCid_Params_Future) Struct() (BootContext_setCid_Params, error) {
+func (s Supervisor_stop_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Supervisor_stop_Params) DecodeFromPtr(p capnp.Ptr) Supervisor_stop_Params {
+	return Supervisor_stop_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Supervisor_stop_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Supervisor_stop_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Supervisor_stop_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Supervisor_stop_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Supervisor_stop_Params_List is a list of Supervisor_stop_Params.
+type Supervisor_stop_Params_List = capnp.StructList[Supervisor_stop_Params]
+
+// NewSupervisor_stop_Params creates a new list of Supervisor_stop_Params.
+func NewSupervisor_stop_Params_List(s *capnp.Segment, sz int32) (Supervisor_stop_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Supervisor_stop_Params](l), err
+}
+
+// Supervisor_stop_Params_Future is a wrapper for a Supervisor_stop_Params promised by a client call.
+type Supervisor_stop_Params_Future struct{ *capnp.Future }
+
+func (f Supervisor_stop_Params_Future) Struct() (Supervisor_stop_Params, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_setCid_Params(p.Struct()), err
+	return Supervisor_stop_Params(p.Struct()), err
 }
 
-type BootContext_setCid_Results capnp.Struct
+type Supervisor_stop_Results capnp.Struct
 
-// BootContext_setCid_Results_TypeID is the unique identifier for the type BootContext_setCid_Results.
-const BootContext_setCid_Results_TypeID = 0xaab0eb92d588b81e
+// Supervisor_stop_Results_TypeID is the unique identifier for the type Supervisor_stop_Results.
+const Supervisor_stop_Results_TypeID = 0xffdd9f94c7c599cb
 
-func NewBootContext_setCid_Results(s *capnp.Segment) (BootContext_setCid_Results, error) {
+func NewSupervisor_stop_Results(s *capnp.Segment) (Supervisor_stop_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_setCid_Results(st), err
+	return Supervisor_stop_Results(st), err
 }
 
-func NewRootBootContext_setCid_Results(s *capnp.Segment) (BootContext_setCid_Results, error) {
+func NewRootSupervisor_stop_Results(s *capnp.Segment) (Supervisor_stop_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return BootContext_setCid_Results(st), err
+	return Supervisor_stop_Results(st), err
 }
 
-func ReadRootBootContext_setCid_Results(msg *capnp.Message) (BootContext_setCid_Results, error) {
+func ReadRootSupervisor_stop_Results(msg *capnp.Message) (Supervisor_stop_Results, error) {
 	root, err := msg.Root()
-	return BootContext_setCid_Results(root.Struct()), err
+	return Supervisor_stop_Results(root.Struct()), err
 }
 
-func (s BootContext_setCid_Results) String() string {
-	str, _ := text.Marshal(0xaab0eb92d588b81e, capnp.Struct(s))
+func (s Supervisor_stop_Results) String() string {
+	str, _ := text.Marshal(0xffdd9f94c7c599cb, capnp.Struct(s))
 	return str
 }
 
-func (s BootContext_setCid_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Supervisor_stop_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (BootContext_setCid_Results) DecodeFromPtr(p capnp.Ptr) BootContext_setCid_Results {
-	return BootContext_setCid_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Supervisor_stop_Results) DecodeFromPtr(p capnp.Ptr) Supervisor_stop_Results {
+	return Supervisor_stop_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BootContext_setCid_Results) ToPtr() capnp.Ptr {
+func (s Supervisor_stop_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s BootContext_setCid_Results) IsValid() bool {
+func (s Supervisor_stop_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s BootContext_setCid_Results) Message() *capnp.Message {
+func (s Supervisor_stop_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s BootContext_setCid_Results) Segment() *capnp.Segment {
+func (s Supervisor_stop_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 
-// BootContext_setCid_Results_List is a list of BootContext_setCid_Results.
-type BootContext_setCid_Results_List = capnp.StructList[BootContext_setCid_Results]
+// Supervisor_stop_Results_List is a list of Supervisor_stop_Results.
+type Supervisor_stop_Results_List = capnp.StructList[Supervisor_stop_Results]
 
-// NewBootContext_setCid_Results creates a new list of BootContext_setCid_Results.
-func NewBootContext_setCid_Results_List(s *capnp.Segment, sz int32) (BootContext_setCid_Results_List, error) {
+// NewSupervisor_stop_Results creates a new list of Supervisor_stop_Results.
+func NewSupervisor_stop_Results_List(s *capnp.Segment, sz int32) (Supervisor_stop_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[BootContext_setCid_Results](l), err
+	return capnp.StructList[Supervisor_stop_Results](l), err
 }
 
-// BootContext_setCid_Results_Future is a wrapper for a BootContext_setCid_Results promised by a client call.
-type BootContext_setCid_Results_Future struct{ *capnp.Future }
+// Supervisor_stop_Results_Future is a wrapper for a Supervisor_stop_Results promised by a client call.
+type Supervisor_stop_Results_Future struct{ *capnp.Future }
 
-func (f BootContext_setCid_Results_Future) Struct() (BootContext_setCid_Results, error) {
+func (f Supervisor_stop_Results_Future) Struct() (Supervisor_stop_Results, error) {
 	p, err := f.Future.Ptr()
-	return BootContext_setCid_Results(p.Struct()), err
-}
-
-const schema_9a51e53177277763 = "x\xda\x9cV]h\x1cU\x14>g~74\x9b\xe5" +
-	"\xeehmCmL\x9a(\x896\xc9\x1a|H\x04\xb3" +
-	"\xe9RB\xa2\xc2N$\x0f*)\x99l\x86\xec\x92\xd8" +
-	",\x99Y\xd2\x82\x05\xf3 U\xb0H\x8bP\x13\x8aV" +
-	"\xb16\x91V\xdaX\x82\x85\"\xa24}\xb1JS\x83" +
-	"\x1a\x15J5R\x09T\x05\x8d,HW\xee\x9d\xbd\xd3" +
-	"Ig\xb7\xbb\xf4e\xd9\xe1\x9e\xf3\x9d\xf3}\xe7\xcew" +
-	"\xa65,F\xa5H0W\x01\x82n\xcbJ\xaeq\xf6" +
-	"\xa7o\x9e\xec\xbbp\x08H5\x02H*@\xdb\xaa\xd4" +
-	"\x84 \xe5>{\xaa\xb9)\x14^8\x0cd\x1b\x02\xc8" +
-	"H\x8f.K\x1d\x08\xa8-K\x9d\x80\xb9\xdc\xb5\x9d\xcf" +
-	"\xad\xbc\xf1\xfeQ'\xd5\x09\xc8J\xbbh\x00\xca4\xe0" +
-	"\x95\xfeL\xf5\xbb\xa3\xdd3\xa0W#\x07\xaf\x95Y@" +
-	"#\x0b\xb8t\xfcB\xff|p\xf0\xa4\xa7x\x8f\xcc\x8a" +
-	"o\xff\xf4\xb5\xe5#kg>\xf2\x9cD\xe4^z\xf2" +
-	"\xb7h\xbfx\xf1\xaf\xc5yo[\x0f:\xa0\x0d\x0c\xf4" +
-	"\xf7\xf6\x7fO\xb5t-\x9e\xdb\x00\xfa8M\xed\xbfu" +
-	"\xacg\xf6\x81\x17\xbe\xf0\xa6Fd\xc6\xa8\x9d\xa5\xde<" +
-	"\xfa\xad5\x9f\x8c\\\x04\xb2\x99\xa7>/W\xd3\xd4\x89" +
-	"\xc5\x85w\x96j>\xff\xca\xcb\xb5\xcbI\xeda\xa9\xa7" +
-	"\xba[\xeb\x8f\x9fk\xfa\x0e\xf4\xcd.\xd7\xfd4\x17\xb5" +
-	")\x16\xa0=\xbbi2v\xa0n\xc5\x8bp\x82\xf6\x85" +
-	"\xdai\x16\xd0\xa64\xcc^\xfds\xc7\x0a\x90*1\x97" +
-	"\x98|d2\xb2\xaa\xcf\x00\xa0vY\x9e\xd7\x96e\x15" +
-	"@\xbb\"\x1f\xd4j\x15\x15 \x17h\xf9:9\xb86" +
-	"\xb3\x01\xadBa\xfd\x10\x85\xa2-l\xfa\xfe\xc8\x9e\xd1" +
-	"\x87\x7f\xf6\xa8\xd0\xa50\x15l\xacX\x17\x7f}\xe67" +
-	"_\x9dF\xe5\xba\xf6\x04E\xd7\"\xca%m\x8e\xd5Y" +
-	"h\xfdp\xe0\xd5\x0f\x9e\xbe\xe1\x1d\xe1a\x85u=\xcd" +
-	"\xeat\x0f\xb4\x9c\xd9\xfa\xf1\xc9\x9b\x1e\xc9\xce+aZ" +
-	"\xa7\xea\xedk\xb7v<:\xf4\x87\xaf\xce{\xca\x92v" +
-	"\x9a\xa2\xb7\xcd)\x07Q\x9bVi\xa1\xe4\xf9\x87\xfe\xbb" +
-	"z`\xfb?\xde\xd9L\xa9l\xac\xaf\xab\xb4\xd0\xcb\xb3" +
-	"k\x99c\xe1\xb7\xd6\xbd\x01s*c|\x96\x05\x84\xf6" +
-	"\xac\x7f\xb9\xf4\xd8`\x16\xf4mn\xabW\x1c\x84\x1fX" +
-	"\xc0\x8d\xba\xddo\xd6N\x0fe=\x92dUv\xa7~" +
-	"\xf9\xe4\xc7\xc0\xf5\xdeT\xd6CbU\x0d#\xb4\xe6\xd2" +
-	"\x13\xe3\x09\xd3\xb2\x9a\xc5\x84\x91\xde\x9b\xee\xd85>n" +
-	"\xc7\xc6\xf7\xda\xe6>\xbb9\x91\x1a\xae\x8f\x1b\xa1\x09\xe3" +
-	"%\xcb\x17\xb6\xdf6\x13\xe3\xc3f\xccH$\xcd\xe6t" +
-	"\xc6\xae\xef\x8c\x1b4P\x97D\x09@B\x00\x12\xec\x05" +
-	"\xd0+E\xd4\xb7\x08\x98\x1b\xca'\x00\x00\x06A\xc0 " +
-	"\xa0\x8b)\xf9K[\xa6\x1dc\xd5)&xA\xeb\x00" +
-	"\xf4\x80\x88\xfa}\x02\xaa\x89\xd4p\x99`\xf1\xd2`\xe9" +
-	"\xd40\x06@\xc0\x80\x07\xac\x80(\xe9\x02\xa2\x14'\xd0" +
-	"gZ\x991\xdb\x02\xf0\xc5n\x10p\xc4\xb4\xdd\xd0{" +
-	"Q\xb0\xd0\xf0\x8c\xb4U\x1f\xaf1JO/iX\x85" +
-	"\xa6WJ\xe8<V<\xff8\x9a\x1a\x1bs8\x88\xb6" +
-	"U\xb2\xb1\xbeN\x87\xad\xb7`S\xbe`\xbd\x80!\x1a" +
-	"\x84U\x80q\x111,\x89\x80\xf4\xa1X\xe9I#e" +
-	"\xbb\xa5\x8b\xc9g\xeeK\xd9\xb1\xbc|e\x8c9\xc1\x86" +
-	"W\xe3k\xb2\x94*\xc8[\xaba\xcfqD= \xca" +
-	"\x00\xee\x1b\x88\xdcGI\xa4\x09\x04\xd2\xa0\xe2m\x8bA" +
-	"n\xcfd+=\x0b\xaa!J-\x8a!*n\x14\xe3" +
-	"x\xd7\x96\x8d\x89\x91\xd2\xc2\xd2 .l%\x08\x85d" +
-	"\xf5a\xdey\x8b\x84;o\x91\x9aH\x9a\x94i%c" +
-	"\xca\xd7*r\xc7#z\x1d\x08d7e\xca=\x0e\xf9" +
-	"\x92#\xed\xf4l\xa7\x8a\x82\xbb\xbc\x90\xfb\x1c\xa9\xa5g" +
-	"\xf7\xabj:cGQ\x1d1\xe9o\xd2\xb0J*\x91" +
-	".cxwy\xdd7\\i\xf6b\xa0\x9f\xbc[\x0e" +
-	"mJ}\x0b\xa3\xce\xd7=\xf2\xadB\xceR\x0a'(" +
-	"u\xfe\x1d\x82|O\x92izv\x88R\xe7\xbb\x0c\xf9" +
-	"\xd6#S\xf4\x02dT\x14\xddm\x8f|C\x93\x14=" +
-	"\x1bPQr\xbf?\x90;?\xd1;\x1c\xa9e\xf7\xe3" +
-	"\x05\xf9\x97\x06i\xef`RS\xeaQv{\xa3\xce}" +
-	"\x88:\xef[\x14;\x1d\xa7t\xfe\xc4\xe8\x1f\xaf\xd0R" +
-	"1\xeb/\xe4\\e\xba\x87\xdf\x06\xef\xc1\x89\xa4b\xae" +
-	"V\xaa\xb1\xa4a!\x82\x80X\xd6\xfe\xf0{y!\x1f" +
-	"\xca\xdf\x97\xff\x03\x00\x00\xff\xff1\xca\xf2\xf5"
+	return Supervisor_stop_Results(p.Struct()), err
+}
+
+const schema_9a51e53177277763 = "x\xda\xacY}p\x1c\xe5y\x7f\x9e\xdd;\x9d\x8cu" +
+	"Z\xbd\xda\xd3\xc7I\x98CBNj\x81e[N\xdb" +
+	"p\xc4\xbd\x93\x840r\xec\xa2\x950\x14\x1a\xa8\xd7\xa7" +
+	"\xb5t\xb1tw\xec\xad,k\x82G)\x13\x17H\xe3" +
+	"\x09\xa6\x9e\x04\xa9v\xb12\xa6XT$\xe0\x10\x17f" +
+	" 5ul\x87\xcf$\x06O\xc0\xb1\x13\x1aJ\x06\xdb" +
+	"C\xb0\x01C\xd4\xca\xde\xce\xf3\xee\xed\xed\xea\xeedy" +
+	"\x98\xfe\xa3Y\xdd>\xfb\xbc\xcf\xe7\xef\xf9x\x97\xee\x98" +
+	"\x17\xf5,\xf3WU\x83\xd0]\x8d\xde\"\xf3\xe7K\xd3" +
+	"{n\xfd\xda\x0d\xf7\x01\xabE\x00/\xfa\x00\x96\x9f\x9b" +
+	"\x1fF@yj~\x04\xd0\xdc\x18}\xa1\xc6\xdf\xf2\xee" +
+	"?\x00\xab\xcc\x12\x04K\xea\x89\xa0\xae\x84\x08n\xda\xf9" +
+	"\xca?\xcd\x7f\xe7\x95\xfb\x81\xd5d\x09ZJ\x9a\x89\xa0" +
+	"\x83\x13,\xda{\xe2\x177t=\xbf\xcd\"\xf0\xd0\xfb" +
+	"xI#\x82\xc7<2y\xcb5\x81\xf9\xafn\x03%" +
+	"\x88h\xfe\xee\x91\xda{\xfa\xbe\xf7\x9b_\x80\x97hd" +
+	"\xa5\xe4#\xf9\xae\x12\xa2\xbe\xa3$\x84\x80\xe6\xc4\xabg" +
+	"\xaf\xfc\xe1\xb6\xf3\x0f\x01\x0b\xda|\xb6\xf9\xc3\xc4\xe7\xa7" +
+	"\x7f\xd5\xd4(\x95\xef\xdf\x0e\xec\xca\xac\x08\x83~\xae\xc4" +
+	"\x16?\x89`\xbe\xb3\xb8\xfb\xf8w~\xf0}\xb7\x8c\xe3" +
+	"\xfeV\"\x98\xe0\x04\xf7\xbfq\xf0\xe0\x0b_>?\x0a" +
+	"\xacR4\x97\x17-\xdc\xfb\xc6\xd9k\x8e\x03\xa0\xfc\x92" +
+	"\xff\xb4|\xccO\xf2\xfc\xca\x7f\xbf\\W\xea\x030\xbf" +
+	"\xb9v\xb0\xe6\xd1\x8d+\xc7@\xa9A[\x92y\xa5\x9c" +
+	"\x1b+%n\xd7\xae\xdb\xfdh\xbb\xb1n\x97e3\xfe" +
+	"~Y)\xd7x\xda\xbc\xe9\xf9\xcd7\xff\xc78\xb0\xa0" +
+	"\xe0(\x0c\xb8<XZ\xceMZ\xfa\x97\x80\xe63\xa7" +
+	"z\xfb\xe5\x1b\xd6\xedqi\xba\xd8\xfa\xfe\xc8\xee\xe7\xd7" +
+	"\xee\xf3\xaf{\xdce\xcb\x0a\xeb\xcd\xc7\x9f\xed\xfa\xf0\xcc" +
+	"\xddw\xed\x05\xa5\x14\xd1\x8c\x0d}qh\xd9{\xca\x18" +
+	"\xb4\xa3\xcf\x8b\x82|\xc1\xff\xb2<\xaf\xb4\x0a`9+" +
+	"\xe5\xc6\xbc\xea\xd9\x07\x8e=|\xe6\xa9'\\\x8c\x16J" +
+	"\xab\x88\xd1\xd7\xbf\xf7fMx\xfc\x8e\x1f\x11#\xd1a" +
+	"\xc4}\xc2\xa4'\xe4\xa0DO\x15\xd2\x8f\x00\xcdOD" +
+	"\xe3o\x0f\x9d;\xbc\xcfm\xf8\xa7%n\x89\xe7$\xb2" +
+	"\xc4\xa9\xeb?\x9b\\\xd2r\xf8\x19\xd71'\xa5f:" +
+	"f\xdd\xb7\xb7v\xbe\xdd\xb2\xf0\xdf]6:$\xd5\xd0" +
+	"\x9b\x86\xfa\xc6\x89\xee\xf0\xbf=O\xda;jy\x05:" +
+	"\xf6I\xe9#\xf99.\xc0O\xa4!@\xf3\xcbc\xfb" +
+	"oH\xfe\xf8\xc3\x9f\x02[`s\xa9+\xbb\x93\xb8\xac" +
+	"\xbd\xb8\xb3co\xd5\x9d\xff\xe9\x16\xcd_\xc6c\xa2\xa2" +
+	"\x8cD\xfb\xc2\xb7W\x8c?\x16\xa98Hzz\x1d=" +
+	"\xads\xfe\xbclL^QF\xf6j/;\x82\x80\xe6" +
+	"\x1f\xbf\xfffz_\xdf\xb2C.q\x17\x94sq\xf5" +
+	"\xc3\xfb\xff\xe5h\xe8\xc0\xab\xee\xd8\xf2\x96\xf3\x83\xfc\xe5" +
+	"t\x90\xf1\xc0}\x8f\x0f\xdfv\xdd\xeb\xc0d\xc19\x15" +
+	"P^Q\xfe2\xa0\xdcRN.\xcf\xc6\x02+u\x19" +
+	"\x1dPn/\x7fYV\xca{\x01\xe4\xd1\xf2#2\x93" +
+	")\x00\xdf\xbf\xff\xc5\xa2\xdf\x0d.9\xea\xce\xc9\xa9r" +
+	"\x9e\x93(\xd3\x91\x93+\x976\xec~\xa6\xf1\xd7\xa0T" +
+	"b\x96\xa2N\xae!\x8aE2y\xaev\xe0\xca\x9f=" +
+	"\x9e\x1c\x7f\xcb\x0a1\x8b\xe0\x90\xcc\xb3\xf65\xce\xe2+" +
+	"/}c\xfd\xee\xb1\xaf\xbc\xedR\xf8\x03\x99G\x9a\xbc" +
+	"f\xfeP\xdb\x96\xfa\xe3n\x85\x8fY\x9f\x9e\xe4\x9ff" +
+	"\xf3'W\x97\x0b\xf2>\xd9\x1b \xab\xfa\x03+Q>" +
+	"\x16 e\x8a\x97\xbc\xde\xb7\xee\xcc\xd8\x0cv/\x04\xb8" +
+	"\xfd\x0e\x05\x88\xdd\x9fv,\x8a^\xf7\xe4\xcf\x8es\x94" +
+	"\xc8\x88r>\xd0H\x04\x178\x01;|\xdb/\x13\xf7" +
+	"~\xf1\x04\x11x\x1cKZN\xaa\x10P^T\xc1\xe3" +
+	"\xbb\x82G\xfe\xb3OL\xeeY{\xdb\xe1\x13\xc0J1" +
+	"\xd7\xed\xed\x95\x0f\xcbk*\xe9\xa9\xa3\x92\xc2\xeb\xf8\x92" +
+	"\xaf\x9e\xd8\xb3\xe5c\xceYt8\xdf\xe8\xf3\xa0G~" +
+	"\xac\xf2\x7f\xe4\xa7\x89x\xf9\x93\x95\xb7\x13\xe7\xfd\xf3\xdf" +
+	"z\xf8\xee\x8d_8\xe9\x0a\xf6\xa9*\x1e\xec\xf5\xfa\x9b" +
+	"\xb7\x07\x0f\xfd\xfa\xb7yi\xff_UW\x90\x1e\xa7\xaa" +
+	"n\x014\x0f\x1c\x98H\xfe\xe3U\xda\xbb\xae`\xc6\xea" +
+	".\xfa\xde\xc0y\x9f\x8a\xff\xbd\xfa\x0fy\x16\xfd\xa0\xea" +
+	"\xf7\xf2T\x15\x09|\xbe\xea\x88<PM\x06]\xf1\xc6" +
+	"\x15\x15\x95g'\xff\x00,(\xce8lm\xf5\x15(" +
+	"kD#\xab\xd5+\xe5\x079\xf5\xfe\xa5\xffz\xd7\xd6" +
+	"=_}\xdf\x0df\xf7Tso\x0eW\x93u\x9bN" +
+	"\xd7\x9f\xfd\x8b\xbf\x8e\x9ev\xfbg\xb4\x9a\x9b\x7f\x9c\x13" +
+	"\xec\xbf\xf8s\xfdD\xcb\x8d\xa7\xf3\x84{\xb1\xfa\xb8\xfc" +
+	"\x1a?\xee\xa5\xea\x95\xf29~\xdcX\xe0\xf5\x8f\x9bR" +
+	"\xf7\x9d\xb6t\xb4\xb8\xbdMJ\xa2\xfc\x1e\xe7v`\xdf" +
+	"?\x1fl\\<x\xc6eDo\x90\xa3\xfcC\xa2p" +
+	"\x07\x1e\x9d<\x03\xac.\xfb\xe9\x07\xd5_\xe7\xa5\x8a\x7f" +
+	"\xba\xf2\xae%O\x05\x7f\xf8\xf8\x1f3Q\xcf\xbf\x0d\x06" +
+	"-\\\x0d\x12A\xe9#\xef\\\xbc\xe6\xda\xf5\x1f\xe6I" +
+	"\xda\x12<*\xaf\x09\x12}G\xd0'\xc8\x0f\xd6\x90\xa8" +
+	"}\xcf]=\xfd\xc6\x96\xab\xce\xbb\x11\xe4\x9e\x1a\x0en" +
+	"\xc35\xc4\xee\xbb+N\xf7U\x9ex\xf0\xbc;\x0dG" +
+	"kx\x1a\x8es\x82{\xf7\x9e\x19\xdcY\xbe\xe3S7" +
+	"\x87\x17kxh\xbf\xc4\x09\xf6O}cd\xa3\xfc\xdb" +
+	"\xcf\xdcYx\xca\xe2p\x8e\x13Hw\x7fz\xf0\xe8u" +
+	"\xeb\xa6@\xb92\xab\x12\xab\xe52\x04k\x89\xe0\xfd\xfa" +
+	"\xf6\xef\xd6\x8d\xae\x9fr\x99\xeb\xfaZ\x8e\xe3\xef\xfe\xf8" +
+	"7\xc5\xbf_\x15\x9fr%\xf0\xc2\xdarz\xb3%\xfe" +
+	"\xd1\x8ak\xafj\x9a\xce/\x15\x02\x80\xec\xaf\xfd\x81\\" +
+	"Q\xcb\xd1\xbe\x96\x90bl\xe4K\x07B\xab'\xa6]" +
+	"'L\xd4\xf2\xa8~e\xf4\xd0\x91\x1d\x8f\x9e4]e" +
+	"j;\xbd\xf9\x9a\x99\xd2\x931-\x9dn\x12cj*" +
+	"\x91\x0a\xb7o\xd2\x12\xc6\xcdj\xa2\xa7_\xd3\x9bt-" +
+	"\xb6\xa9!\xd2\xa9\xea\xea@Z\xf1\x88\x1e\x00\x0f\x020" +
+	"\x7f3\x80R,\xa2\x12\x100\xa4\xd1\x07X\xe6H\x0a" +
+	"\x88e\x80\xb9|;3\xff\xa6\x8d\x1eM\xd7\x1b:U" +
+	"\xdd\x97\xc34\xec0\x8d\x0c\xe9qC\xd3\x919\xc5\x1e" +
+	"\x10Y>\xd7\xd6d\xd2hK&\x0cm\xb3\xd1\x14S" +
+	"S\x0d]Z(=\xd8o\xcc\xe0[\xef\xf0\xf5\xc5\xd4" +
+	"\x14\x96{D@,\x9f\x83Y\xbc\xa7\xa1S\x95H\xf1" +
+	",\x99`\x91u\x0f\xa64}S<\x9d\xd4CM\xdd" +
+	")-\xd6\x89\xa8\x04\xb2\xc7mY\x05\xa0\xdc+\xa2\xf2" +
+	"\x80\x80\x0c1\x80\xf4\xe3\xd6F\x00\xe5\x9b\"*;\x05" +
+	"d\x82\x10@\x01\x80\x8d\x92\xc2;DTv\x0b\xc8D" +
+	"1\x80\"\x00\xdbE\x94\x8f\x88\xa8<# z\x02\xe8" +
+	"\x01`O\xb7\x02(\x93\"*\xcf\x0ah\xae\x1f6\xb4" +
+	"X\xb2G\x03\x00\xf4\x83\x80~@I\xd5{\xd3X\x0a" +
+	"\xd8)\"\x96\x80@\x8f\x91\xfe\xf8@\xdcHc\x99\xd3" +
+	"#X~\x91bj*K\\\xe6 , \xfd8\xa2" +
+	"kiC\xd5\x0d\x94\x1c\x1c\x04D)\xdfX\x8e\x15\x9a" +
+	"\x86T#\xd6\xd7\xd0\x15\xd1\xb8\xe9\xf3\xac\x9a\x11\xb8M" +
+	"\x8d\xf5iM\xa9A\xa3P@\x91\xd1JDT\xaa\x0b" +
+	"k\x98\xe5\xe9\xc9\xf7TZ3\xda\xb8\xb3\x88'\xcc\xea" +
+	"\xf8xO\x1e3afd\xde\xaeK\x14t\xe4\xceb" +
+	"\xd1\xeb\xc2?L<}`h\xf9\xd8\xdf\x8d\xb2e\xcd" +
+	" \xb0\x85>t\xf0\x1f\xed\xae\x86\x05\xe9\x9d\xdf\x17\xe2" +
+	"\xa1\x1b\xc5P\xac?\x99\xd6\xa2\xd8\x89sI\xdf9\xb7" +
+	"\xf4\xa9x\x0f\x16\x83\x80\xc5\x97\xcc\xab\xe4\xa0\xd1\xd0\xa5" +
+	"\xa5\xa5B^p\xb9\xab\xdb\xd0UC\xeb\xc5a\xa5\x98" +
+	"\xc7!\xeb\xe2\xc9\xe5\xef\x020\x93\x09\xed\xa6\xa4~K" +
+	"\x02P\xcb<\xb7\xf4\x03\xf6_\x82[\xdaH\xa6\xf22" +
+	"\xa5@B\xa5fO\xa8\xf6\xcdq\xa3\xdbP\x8d\xc14" +
+	"\x80\xe2Atu\x95\xd8(\xdd\xaa\xab)\xca\xaf\x12\xd3" +
+	"\xb4\x12\x8c2d\xb3\x88\xca\xb7\x04\xf4\xe3E\xd3\xca\xb0" +
+	"\xbfot\xd2\xce/\\0\xad\x14\xdb\xda\x05\xa0|K" +
+	"D\xe5!\x01\xfd\xe2\xb4i\xe5\xd86J\xbc\x07DT" +
+	"v\x08\xe8\xf7\xfc\xafie\xd9v\x02\xb5\xef\x88\xa8<" +
+	"\"\xa0\xa4m\x8e\x1b\xb6\xc5%CWSX\xe6H\x95" +
+	"\x01\xb8\xbed\xdah\xd7\xf5$\xa0\xce\xb3\xae\x040\xb2" +
+	"1\xde\xdf\xaf\xf5@Q\x88\xa7\x1f\x14]F\xe0vY" +
+	"i\x03\x90\xa5E\x8bvu\\\xa2\x14\xa6x,\xc9\x86" +
+	"E\xfbz\x00\xe5F\x11\x95N\x17\xbc\xac!\x80\xb8Y" +
+	"D\xe5V\x17\xbc(\xa4\xcfj\x11\x95\xbf\x11\xd0\x1c\xd0" +
+	"\x06\x92\xfap\xa7\x0a\xbe^-m+6b\xc4\x07\xb4" +
+	"\xe4\xa0\x81\xf3@\xc0y\x80\xa1\x98\xda\xdf\x9f\xb6\xff\xcb" +
+	"\x13}F\x1e\xf7jFV\xf2\xcf\x93\xc8\x85\xf1;\xdd" +
+	"\xd0\x19R\x0bE\x92+\xca\xe3\x09\x9e-\xe2\xa5\xe2(" +
+	"\xd4DQce\xb2-\xda\"\xf2z\x83\x88\xcaR\x97" +
+	"\xe5\x16\x93\x91\xfeLD\xe5F\x01#\xba\xa6\xa6\x93\x09" +
+	"\xdb\x97\xa1\xb4\xa1\xc66\xe6\xc0j\xaeQ\x1c\xec \xe8" +
+	"h\xe29o\xd9E\x9c\x0b\x09\xfb\xd4t!$\x9c\x0b" +
+	"\xb42\xc1\xd1\x91\x107$y\xb28#\x0b6\x87H" +
+	"}M\xb9:\xcb\xeeW\xc4\xeeU\x11\x95\xb7\\J\x1f" +
+	"\xa3\\\xf9\xa5\x88\xca\x09\x011\x13-o\x13\xe1\x9b\"" +
+	"*\xefP1B+QN\x12\xe1[\"*\x9f\x08\xc8" +
+	"<h\xe5\xc99\xca\xa9\xb3\"*\xd3\x022oq\x00" +
+	"\xbd\x00l\x8a\xec\xf8\x89\x88]( +\x12\x03X\x04" +
+	"\xc0.\x90\xc5\xff$b\xb7\x87~\xf5y\x02H\x9d\x0a" +
+	"\"\xd1N\x8b\xd8]\x8c3\xa1MJ\xb9\xfeq+_" +
+	"\xa8\xc0\x99\xbcN\xdd\x1a\x1f\x00\xd4\xd0\x0b\x02z-\x97" +
+	"\x19\x1aJ\x8eM\xac\xda\x15\xb1\xc2\x7f\x8e8\xcf\x095" +
+	"J\xe5\xd9]\x99\x1b\xb7v\xf5s\xbb\xb21\xe3\xca\x06" +
+	"af\xd5\xb5\x1a\x90\xd2\xfcJ\xd4\x91\xd8\x90l\xe2." +
+	"\xa4/-pn\xe5\xe0</\x0c0\xa2\x0f&\x12\xf1" +
+	"Do\x84\xc0I\xeb\x99\xbd-\x01P\xaa\x11]\xfb\x8b" +
+	"E\xab\\S\xcd\xa2Vg\x08c\x0b\xc3\xceb\x87\xd5" +
+	"5:C\x14[\xd0\xec\x1eNZ\xcdL\xd5\x18\x06\x80" +
+	"\x91.\xabI\x88\xb4%\x13\x1b\xe2\xbd\x125A!\xde" +
+	"7\x8ed\x1aG\xa5\x84\x17P\xbbeF{,dJ" +
+	"=\x08\xac\xdd\x87\xceP\x8b\xf6\xaa\x88]O\x05t\xb1" +
+	"\x0f\x85\xecN\x05\xed\xae\x95\xd55\x82\xc0*|>\xb5" +
+	"\xa7'\x8a!\xdepDQ\xa2\xda3\xb3\xc2\x16.\x8a" +
+	"\xffO\xcd\xa6\xcduH\x8d\x1b\xd9\xc8pc\xf3*7" +
+	"6_\x9d\xc1\xe6\xb0\x83\xcdv\xb2)a\x174\x937" +
+	"\xdb2(\x99\x89\xfdL\x19A\x04\x01\x110\x92\xe6\xa0" +
+	"\x86e\xce\x86\xa5p\x8f\x9d\xd7\x93e\xd0\xd4\xadx\xab" +
+	"\xa3\xf8H\x9f\xe5-d\x8e\xab/\xady\xa6y\x9f\xad" +
+	"\xc9\xc8m\xa0\xe7\xec\xc6g\xc7\xb7N=\xc4\xff\xe7\x10" +
+	"\xe7l\xfc0\x1c\xb1\xa0V\x09\xf0\x08\xb3\x07'\xb4\xb7" +
+	"(l;E\xcaV\x1f:#&\xda\xfb 6L\xef" +
+	"\x06(\xc2\xec\xad\x0c\xda\xeb?\xa6\x86A`k}(" +
+	"f\xb7\xa8h\xafUX\x07\xbd[\xe1Cg\x13\x86\xf6" +
+	"<i\xb7\x84\x12\x05E\x14%\xf2\\\x14#V\xe0Y" +
+	"\x0f\x9a\xaeG\x09\x9az\xe2\x89\x82\xc1\xea\xb6\x1a\x01\xdd" +
+	"\x9cXR\x08\x0dg\x8f\x04\xb5\xa7'\xeb07\xc7\x1a" +
+	"\xc7\x11\xe2\xec\xed\xa5\x8b\x91\x95\xedp9\xe3\xcez\xa7" +
+	"\xedb\x02ZA\xef\xee\xba\x98(X\x15f\xfb\x9d\x00" +
+	"\xcaC\x99\xc1\xc8#Z\x15f\xf4\xce\xcc\x0c\xb4G " +
+	"\x98\xcf\xe2\x0eJ\x0e\xa6e\x86\x92\x01u3\x07#\xf0" +
+	"\xe9F\xb6\xaf\x89\x0c\xc5\x13=\xc9\xa1,\xc0\x0f\xc4\x13" +
+	"\xadjlc\x12\xc4\x0d\x1b\x9c\x1f\xd5\xcd\xf9?\xe6\x04" +
+	"a\x9b\x8a\xb9MDc\xa1&\"\x9ci\"\xbe$\xa0" +
+	"\x94P\x07\xb4l;\x18\xeb\x8f\xd3p\x9c?q\xe6a" +
+	"v\xa4\x89\x03(\x9dV\x9d=m\xb4\xd95!\xda\xa7" +
+	"\xed\xaaw\xac\xe3\x17\xccL\xa7;N\xa4;ET\xf6" +
+	"\x0a\xb8@\xbchf\xc6\xc9\xc7H\xde\xdd\"*\x93\x02" +
+	".\xf0\\0\x05\xcb\xc2\x13\x84\x02{DT\x9e\x12\xd0" +
+	"\xef\x9d6\xad\"\xfe$\xe9\xb1\xd7\x9a=C\xb1\xbex" +
+	"\xbfS\x8c]\x859\xc4\xb1\x1f\x8af\xf4\xc8\xd9\xb91" +
+	"c\xc9Ho|\x93\xb66\x05E\xb9\xf1\x94\x17\xec\xb3" +
+	"4|\xae\xb8\xcbT\x1bj,\xb9\xaa\x0b\x9a9JU" +
+	"t\x01\xa0\xc0X\x18 \x94\xd06i\xba\x99L\xdc\xa4" +
+	"\xc6\xfb\x07u@-\xa2\xf6\x0f\xa9\xc3\xe9\xcbj\xd7x" +
+	"\x0f\x86y\xdd\xa4\xd3\xad\xf9b}\x9a\xd5\x85\x13\xe4\xd8" +
+	"\x17\x18h\xef\x9c\\E\xcd^\"\xa1\xbdlg\xd7\xd7" +
+	"\xdbE\xcd^r\xa3\xbd'bu\xf5VQK\x11R" +
+	"\xf8z5\xfa\xdb\xa7\xa6\x0b\x82\x84\xcb\x1e\x99\x1a\x0b<" +
+	"\x13=\\&{\xdd\xe6L\xaa\x8c\xa0\xce\xeb\x93h\x83" +
+	"3'\xea\xa4.\x03\xab/1\x82\xe6.a2c^" +
+	"!\xf8\x0a\xe4dH^\x0b\x9f\xdd>\x89\x9a\xee\xe8g" +
+	"\xdf|]\x8e~\x85]\xcdk\xbc\xed\xea\xd9$\xebQ" +
+	"\x0d\xf5r&\x15C\xd3\x07\xf2\xf7\x1d\x9e\xd9<e\xad" +
+	"\xd1\x0aX\xa5\xd0\x1a-\xdb\x81]z\x8d\xc6\xbb\xd3\x02" +
+	"\xea\xb8Y\xf6\xeajL\xcb\xc36!W\x1f\xe4\xa8s" +
+	"57\xb4}g\x85\xf6\xaa\x99\x9d\xa3 }\xcf\x87\xce" +
+	"\xdd \xda\x97\x0a44\x08\xec5\x0an{\x9d\x8e\xf6" +
+	"\x0d\x01{\x91\x1c\xf4\x13\xaa\xa7\xf6\xbd\x12\xda\xb7/l" +
+	"\x82\xde\xed\xa2zj_\xcf\xa1\xbd\x1be\xdb\xc3V\xfd" +
+	"\xf6f/\x02\xd1\xbe\xffb\xc3a\xab~\x17e\x17\xdd" +
+	"h\xdfh2\xb5\xde\xaa\xdf\xbe\xec\x12\x14\xed\xf54\xeb" +
+	"h\xe4\xf5\x9bb8\xca\xbb\x8e\xa8UD\xa3V_N" +
+	"e\x9a\xafa\xac\x876\x8bJMEQ\"O\x17\xde" +
+	"\xe1\xe4m\xb5\x0aM\xc3s5;\x05\xa7[b\xe4\xcb" +
+	"\xc9\xc4\xcf\xb5\x1b\xcd\x1b\xd8?\xc7\xb8Y\xb8\x9f(\xd0" +
+	"P\xbb\xf3(\x9d\xd2bX\xe6L\x169\xa1\xec\x99m" +
+	"\x1c\x9e\xcb\x84}j\xda\xee\x89/c\xa7\x96\xbfX)" +
+	"\xd4\xc7\xe7\xa2?\xda@\xe4\xcb\x94cW\x7fO:F" +
+	"ETV\xd3\xe6\xc9\xccl\x9e:\x9a\x9d\xae\x7f\x81p" +
+	"\xd1\xcc\xb4;k\x1a\x9d\xbe_\x8a'6$\xb1\xcc}" +
+	"\xa1H\x06\x09\xa5S\xeaP\"\xa7\x92\xce\x89;s\x17" +
+	"L\xbe\x92\xb3\xf1\xfc\xff\x06\x00:\xe7\xe5P"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_9a51e53177277763,
 		Nodes: []uint64{
+			0x823b5c54a47330c8,
+			0x86e3410d1abd406b,
+			0x87cbe00b93cb9c46,
 			0x8dbc523bcfdca829,
+			0x8dcc0b14234facc7,
+			0x90f58dae1cf0cca9,
 			0x91b6120f2a2e3ebe,
 			0x97a28cda532de0ff,
+			0x99f538bdc3c3d387,
 			0x9a476b9f1a755580,
+			0x9d6074459fa0602b,
+			0xa1bf4878bc46fffc,
+			0xa4603b136c67e9b4,
 			0xa7600db255bca0c7,
+			0xa85d5eebef9df7f3,
 			0xaab0eb92d588b81e,
+			0xaf59a13a1ad4966a,
 			0xb2c6f1c55b7403f4,
 			0xb4c6412facf739e9,
+			0xb72541d950858a60,
+			0xbcab3a53a92a2224,
+			0xbeefb36f3bb69a38,
 			0xc25a17a8499cfe55,
+			0xc3153fa5a13d8a26,
 			0xc53168b273d497ee,
 			0xccc01fd29eb6c672,
+			0xce2c5679a7828874,
+			0xcfdb9668711b98df,
+			0xd22f75df06c187e8,
 			0xd72ab4a0243047ac,
+			0xd8a16fa7c41c6d1b,
+			0xd93c9aa0627bc93c,
 			0xda227d43770b4d13,
 			0xda23f0d3a8250633,
 			0xda9aeb6068ce2f08,
+			0xdac4ad2c402994f8,
+			0xdc277c6ed056c611,
+			0xdcc65655a4acaab8,
+			0xdcf37da4dc4b2fda,
 			0xdd266b5e92d80bb6,
+			0xded7c51957d47222,
+			0xe3651e8b6fa9c0c0,
 			0xe64ce403f6090174,
+			0xe6acf016150ad33d,
 			0xe84ba4855da630b6,
+			0xea404e36f022ea2e,
+			0xea4441dc72c8feb6,
+			0xea82702ef3ce149a,
+			0xeb752d2ac39bb2c0,
+			0xebacd20159020390,
 			0xeea7ae19b02f5d47,
 			0xef622b23fee0980e,
 			0xf51e7dd3fc20b968,
+			0xf589dc1668ea3d8f,
 			0xf694129c75eba87c,
+			0xf7de136b7f7bf9b6,
 			0xf9602cd2c3f65e0f,
 			0xf96299218f4522e8,
 			0xf9694ae208dbb3e3,
+			0xfc2e1e2b3df2697d,
+			0xfca94c1fc0347f9a,
+			0xffdd9f94c7c599cb,
 		},
 		Compressed: true,
 	})
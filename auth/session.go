@@ -7,6 +7,12 @@ import (
 	"capnproto.org/go/capnp/v3"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/anchor"
+	"github.com/wetware/pkg/cap/capstore"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/meta"
+	"github.com/wetware/pkg/cap/pubsub"
+	"github.com/wetware/pkg/cap/scheduler"
 	"github.com/wetware/pkg/cap/view"
 	"github.com/wetware/pkg/cluster/routing"
 )
@@ -21,6 +27,24 @@ func (sess Session) WithPolicy(p Policy) TerminalServer {
 }
 
 func (sess Session) Clone() Session {
+	raw := core.Session(sess.Bare())
+
+	// Copy capabilities.  Note how we increment the refcounts.
+	must(raw.SetView(core.Session(sess).View().AddRef()))
+	must(raw.SetExecutor(core.Session(sess).Executor().AddRef()))
+	must(raw.SetPubSub(core.Session(sess).PubSub().AddRef()))
+	must(raw.SetCapStore(core.Session(sess).CapStore().AddRef()))
+	must(raw.SetAnchor(core.Session(sess).Anchor().AddRef()))
+	must(raw.SetMeta(core.Session(sess).Meta().AddRef()))
+	must(raw.SetBoot(core.Session(sess).Boot().AddRef()))
+	must(raw.SetScheduler(core.Session(sess).Scheduler().AddRef()))
+
+	return Session(raw)
+}
+
+// Bare returns a session that describes the same host as sess, but
+// holds none of its capabilities.
+func (sess Session) Bare() Session {
 	// We start by allocating a single-segment arena.
 	// This will never fail to allocate, so any errors
 	// are due to undefined behavior. We use must(err)
@@ -38,10 +62,6 @@ func (sess Session) Clone() Session {
 	must(err)
 	must(raw.Local().SetHost(hostname))
 
-	// Copy bootstrap capability.  Note how we increment the refcount.
-	boot := core.Session(sess).View().AddRef()
-	must(raw.SetView(boot))
-
 	return Session(raw)
 }
 
@@ -83,6 +103,44 @@ func (sess Session) View() view.View {
 	return view.View(client)
 }
 
+func (sess Session) Executor() csp.Executor {
+	client := core.Session(sess).Executor()
+	return csp.Executor(client)
+}
+
+func (sess Session) PubSub() pubsub.Router {
+	client := core.Session(sess).PubSub()
+	return pubsub.Router(client)
+}
+
+func (sess Session) CapStore() capstore.CapStore {
+	client := core.Session(sess).CapStore()
+	return capstore.CapStore(client)
+}
+
+func (sess Session) Anchor() anchor.Anchor {
+	client := core.Session(sess).Anchor()
+	return anchor.Anchor(client)
+}
+
+func (sess Session) Meta() meta.Meta {
+	client := core.Session(sess).Meta()
+	return meta.Meta(client)
+}
+
+func (sess Session) Scheduler() scheduler.Scheduler {
+	client := core.Session(sess).Scheduler()
+	return scheduler.Scheduler(client)
+}
+
+// BootContext returns the boot context of the process to which the
+// session was granted.  It is null if the session was not granted to
+// a process.
+func (sess Session) BootContext() csp.BootContext {
+	client := core.Session(sess).Boot()
+	return csp.BootContext(client)
+}
+
 func (sess Session) Vat() routing.ID {
 	local := core.Session(sess).Local()
 	return routing.ID(local.Server())
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"errors"
 	"testing"
 
 	"capnproto.org/go/capnp/v3"
@@ -8,7 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	capstore_api "github.com/wetware/pkg/api/capstore"
+	cluster_api "github.com/wetware/pkg/api/cluster"
 	api "github.com/wetware/pkg/api/core"
+	pubsub_api "github.com/wetware/pkg/api/pubsub"
 	"github.com/wetware/pkg/auth"
 )
 
@@ -42,6 +46,11 @@ func TestSessionCopy(t *testing.T) {
 	_ = api.Session(want).Local().SetPeer("peer.ID")
 	api.Session(want).Local().SetServer(9001) // routing.ID
 	_ = api.Session(want).Local().SetHost("hostname")
+	_ = api.Session(want).SetExecutor(api.Executor(mkClient()))
+	_ = api.Session(want).SetPubSub(pubsub_api.Router(mkClient()))
+	_ = api.Session(want).SetCapStore(capstore_api.CapStore(mkClient()))
+	_ = api.Session(want).SetAnchor(mkClient())
+	_ = api.Session(want).SetMeta(cluster_api.Meta(mkClient()))
 	got := want.Clone()
 
 	t.Run("TestDataCopied", func(t *testing.T) {
@@ -59,6 +68,19 @@ func TestSessionCopy(t *testing.T) {
 		assert.Equal(t, "hostname", hostname)
 	})
 
+	t.Run("TestCapabilitiesCopied", func(t *testing.T) {
+		assert.True(t, capnp.Client(got.Executor()).IsSame(capnp.Client(want.Executor())),
+			"should copy executor")
+		assert.True(t, capnp.Client(got.PubSub()).IsSame(capnp.Client(want.PubSub())),
+			"should copy pubsub router")
+		assert.True(t, capnp.Client(got.CapStore()).IsSame(capnp.Client(want.CapStore())),
+			"should copy capstore")
+		assert.True(t, capnp.Client(got.Anchor()).IsSame(capnp.Client(want.Anchor())),
+			"should copy anchor")
+		assert.True(t, capnp.Client(got.Meta()).IsSame(capnp.Client(want.Meta())),
+			"should copy meta")
+	})
+
 	t.Run("TestArenaSeparation", func(t *testing.T) {
 		api.Session(got).Local().SetServer(42)
 		assert.NotEqual(t,
@@ -76,6 +98,10 @@ func TestSessionCopy(t *testing.T) {
 
 }
 
+func mkClient() capnp.Client {
+	return capnp.ErrorClient(errors.New("test"))
+}
+
 func newSession() auth.Session {
 	_, seg := capnp.NewSingleSegmentMessage(nil)
 	sess, _ := api.NewRootSession(seg)
@@ -6,6 +6,8 @@ import (
 	"capnproto.org/go/capnp/v3"
 	api "github.com/wetware/pkg/api/anchor"
 	"github.com/wetware/pkg/cap/internal/bounded"
+	"github.com/wetware/pkg/util/casm"
+	"github.com/wetware/pkg/util/watch"
 )
 
 type Anchor api.Anchor
@@ -36,6 +38,130 @@ func (a Anchor) Walk(ctx context.Context, path string) (Anchor, capnp.ReleaseFun
 	return Anchor(f.Anchor()), release
 }
 
+// Cell returns the loader and storer for the value held by the
+// anchor.  Callers MUST call the ReleaseFunc when finished with both
+// capabilities.
+func (a Anchor) Cell(ctx context.Context) (Loader, Storer, capnp.ReleaseFunc) {
+	// If the anchor is an unresolved promise (e.g. returned by Walk),
+	// calls pipelined on the loader and storer are misdirected to the
+	// promise's own answer.   Wait for resolution to avoid this.  Any
+	// error will be reported by calls to the loader and storer.
+	_ = capnp.Client(a).Resolve(ctx)
+
+	f, release := api.Anchor(a).Cell(ctx, nil)
+	return Loader(f.Loader()), Storer(f.Storer()), release
+}
+
+// Watch for changes to the anchor's immediate children and to its
+// cell.  Callers MUST call the provided ReleaseFunc when finished
+// with the watcher, or a resource leak will occur.
+func (a Anchor) Watch(ctx context.Context) (Watcher, capnp.ReleaseFunc) {
+	// Aborting early simplifies the lifecycle logic for the handler.
+	// We still invoke api.Anchor.Watch() in order to report the null
+	// capability error to the caller.
+	if !api.Anchor(a).IsValid() {
+		f, release := api.Anchor(a).Watch(ctx, nil)
+		return Watcher{Future: casm.Future(f)}, release
+	}
+
+	// The user needs to be able to abort the call, so we derive a
+	// context and wrap its CancelFunc in the release function.
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		h          = handler{make(watch.Handler[Event], 16)}
+		f, release = api.Anchor(a).Watch(ctx, h.Params)
+	)
+
+	return Watcher{
+		Future: casm.Future(f),
+		Seq:    h,
+	}, func() {
+		cancel()
+		release()
+	}
+}
+
+// Load the value held by the anchor.  The returned pointer is
+// valid until the ReleaseFunc is called. A null pointer indicates
+// that the anchor holds no value.
+func (a Anchor) Load(ctx context.Context) (capnp.Ptr, capnp.ReleaseFunc, error) {
+	loader, _, release := a.Cell(ctx)
+	defer release()
+
+	return loader.Load(ctx)
+}
+
+// Store the value in the anchor.  See Storer.Store.
+func (a Anchor) Store(ctx context.Context, v capnp.Ptr, overwrite bool) (bool, error) {
+	_, storer, release := a.Cell(ctx)
+	defer release()
+
+	return storer.Store(ctx, v, overwrite)
+}
+
+// Loader is a capability that reads the value held by an anchor.
+type Loader api.Anchor_Loader
+
+func (l Loader) AddRef() Loader {
+	return Loader(api.Anchor_Loader(l).AddRef())
+}
+
+func (l Loader) Release() {
+	capnp.Client(l).Release()
+}
+
+// Load the value held by the anchor.  The returned pointer is valid
+// until the ReleaseFunc is called.  A null pointer indicates that the
+// anchor holds no value.
+func (l Loader) Load(ctx context.Context) (capnp.Ptr, capnp.ReleaseFunc, error) {
+	f, release := api.Anchor_Loader(l).Load(ctx, nil)
+
+	res, err := f.Struct()
+	if err != nil {
+		release()
+		return capnp.Ptr{}, nil, err
+	}
+
+	v, err := res.Value()
+	if err != nil {
+		release()
+		return capnp.Ptr{}, nil, err
+	}
+
+	return v, release, nil
+}
+
+// Storer is a capability that sets the value held by an anchor.
+type Storer api.Anchor_Storer
+
+func (s Storer) AddRef() Storer {
+	return Storer(api.Anchor_Storer(s).AddRef())
+}
+
+func (s Storer) Release() {
+	capnp.Client(s).Release()
+}
+
+// Store a copy of v in the anchor.  Capabilities are copied by
+// reference.  If overwrite is false, the value is stored only if
+// the anchor is empty, and Store reports whether it succeeded.  A
+// null pointer clears the anchor.
+func (s Storer) Store(ctx context.Context, v capnp.Ptr, overwrite bool) (bool, error) {
+	f, release := api.Anchor_Storer(s).Store(ctx, func(ps api.Anchor_Storer_store_Params) error {
+		ps.SetOverwrite(overwrite)
+		return ps.SetValue(v)
+	})
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return false, err
+	}
+
+	return res.Succeeded(), nil
+}
+
 func destination(path Path) func(api.Anchor_walk_Params) error {
 	return func(ps api.Anchor_walk_Params) error {
 		return path.bind(func(s string) bounded.Type[string] {
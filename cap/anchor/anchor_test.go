@@ -37,9 +37,9 @@ func TestLs(t *testing.T) {
 		// These will be cleaned up when the test
 		// finishes.
 		s := server{Node: new(Node)}
-		defer s.Child("foo").AddRef().Release()
-		defer s.Child("bar").AddRef().Release()
-		defer s.Child("baz").AddRef().Release()
+		defer s.Child("foo").Release()
+		defer s.Child("bar").Release()
+		defer s.Child("baz").Release()
 
 		it, release := Anchor(s.Anchor()).Ls(context.Background())
 		defer release()
@@ -142,3 +142,129 @@ func TestWalk(t *testing.T) {
 			"should release after client")
 	})
 }
+
+func TestCell(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		s := server{Node: new(Node)}
+
+		root := Anchor(s.Anchor())
+		defer root.Release()
+
+		v, release, err := root.Load(ctx)
+		require.NoError(t, err, "should succeed")
+		defer release()
+
+		assert.False(t, v.IsValid(), "should return null pointer")
+	})
+
+	t.Run("StoreLoad", func(t *testing.T) {
+		t.Parallel()
+
+		s := server{Node: new(Node)}
+
+		root := Anchor(s.Anchor())
+		defer root.Release()
+
+		ok, err := root.Store(ctx, text("foo"), false)
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should store value in empty anchor")
+
+		v, release, err := root.Load(ctx)
+		require.NoError(t, err, "should succeed")
+		defer release()
+
+		assert.Equal(t, "foo", v.Text(), "should load stored value")
+	})
+
+	t.Run("CompareAndSet", func(t *testing.T) {
+		t.Parallel()
+
+		s := server{Node: new(Node)}
+
+		root := Anchor(s.Anchor())
+		defer root.Release()
+
+		ok, err := root.Store(ctx, text("foo"), false)
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should store value in empty anchor")
+
+		ok, err = root.Store(ctx, text("bar"), false)
+		require.NoError(t, err, "should succeed")
+		require.False(t, ok, "should not overwrite value")
+
+		ok, err = root.Store(ctx, text("baz"), true)
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should overwrite value")
+
+		v, release, err := root.Load(ctx)
+		require.NoError(t, err, "should succeed")
+		defer release()
+
+		assert.Equal(t, "baz", v.Text(), "should load overwritten value")
+	})
+
+	t.Run("Pin", func(t *testing.T) {
+		t.Parallel()
+
+		s := server{Node: new(Node)}
+
+		root := Anchor(s.Anchor())
+		defer root.Release()
+
+		child, release := root.Walk(ctx, "/foo")
+		ok, err := child.Store(ctx, text("foo"), false)
+		release()
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should store value in empty anchor")
+
+		// The child is only referenced by its cell.
+		it, release := root.Ls(ctx)
+		defer release()
+		require.Equal(t, "foo", it.Next(), "value should keep child alive")
+
+		ok, err = it.Anchor().Store(ctx, capnp.Ptr{}, true)
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should clear value")
+	})
+
+	t.Run("ReleaseCapability", func(t *testing.T) {
+		t.Parallel()
+
+		n := new(Node)
+		root := n.Anchor()
+
+		// Store the anchor for a separate tree; this holds a
+		// reference to the other tree's root node.
+		other := new(Node)
+		v := capability(other.Anchor())
+		ok, err := root.Store(ctx, v, false)
+		v.Segment().Message().Release()
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should store value in empty anchor")
+
+		root.Release()
+		assert.Eventually(t, func() bool {
+			return other.refs.Load() == 0
+		}, time.Second, time.Millisecond*10,
+			"should release stored capability when node is released")
+	})
+}
+
+func text(s string) capnp.Ptr {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	t, _ := capnp.NewText(seg, s)
+	return t.ToPtr()
+}
+
+func capability(a Anchor) capnp.Ptr {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	id := seg.Message().CapTable().Add(capnp.Client(a))
+	return capnp.NewInterface(seg, id).ToPtr()
+}
@@ -0,0 +1,126 @@
+package anchor
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/wetware/pkg/api/anchor"
+	"github.com/wetware/pkg/util/casm"
+	"github.com/wetware/pkg/util/watch"
+)
+
+// EventType designates the kind of change reported by an Event.
+type EventType uint8
+
+const (
+	EventCreated EventType = iota // child was created
+	EventRemoved                  // child was removed
+	EventStored                   // value was stored in the cell
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventCreated:
+		return "created"
+	case EventRemoved:
+		return "removed"
+	case EventStored:
+		return "stored"
+	}
+
+	return fmt.Sprintf("<unknown event type %d>", t)
+}
+
+// Event describes a change to an anchor's immediate children, or to
+// its cell.
+type Event struct {
+	Type EventType
+	Name string // name of the child; empty for EventStored
+}
+
+func (ev Event) String() string {
+	if ev.Type == EventStored {
+		return ev.Type.String()
+	}
+
+	return fmt.Sprintf("%s %s", ev.Type, ev.Name)
+}
+
+func event(ev Event) func(api.Anchor_Handler_recv_Params) error {
+	return func(ps api.Anchor_Handler_recv_Params) error {
+		e, err := ps.NewEvent()
+		if err != nil {
+			return err
+		}
+
+		switch ev.Type {
+		case EventCreated:
+			return e.SetCreated(ev.Name)
+		case EventRemoved:
+			return e.SetRemoved(ev.Name)
+		case EventStored:
+			e.SetStored()
+			return nil
+		}
+
+		return fmt.Errorf("invalid event type: %s", ev.Type)
+	}
+}
+
+func newEvent(e api.Anchor_Event) (ev Event, err error) {
+	switch e.Which() {
+	case api.Anchor_Event_Which_created:
+		ev.Type = EventCreated
+		ev.Name, err = e.Created()
+
+	case api.Anchor_Event_Which_removed:
+		ev.Type = EventRemoved
+		ev.Name, err = e.Removed()
+
+	case api.Anchor_Event_Which_stored:
+		ev.Type = EventStored
+
+	default:
+		err = fmt.Errorf("invalid event: %s", e.Which())
+	}
+
+	return
+}
+
+// Watcher is a stateful iterator over a stream of events.  See
+// Anchor.Watch.
+type Watcher casm.Iterator[Event]
+
+// Next blocks until the next event is received, and returns it.  The
+// boolean is false when the watcher has been exhausted, in which case
+// callers SHOULD check Err().
+func (w Watcher) Next() (Event, bool) {
+	return casm.Iterator[Event](w).Next()
+}
+
+// Err returns the first non-nil error encountered by the watcher.
+// If there is no error, Err() returns nil.
+func (w Watcher) Err() error {
+	return casm.Iterator[Event](w).Err()
+}
+
+// handler receives events from the server.
+type handler struct{ watch.Handler[Event] }
+
+func (h handler) Params(ps api.Anchor_watch_Params) error {
+	return ps.SetHandler(api.Anchor_Handler_ServerToClient(h))
+}
+
+func (h handler) Recv(ctx context.Context, call api.Anchor_Handler_recv) error {
+	e, err := call.Args().Event()
+	if err != nil {
+		return err
+	}
+
+	ev, err := newEvent(e)
+	if err != nil {
+		return err
+	}
+
+	return h.Send(ctx, ev)
+}
@@ -0,0 +1,103 @@
+package anchor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Events", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		root := new(Node).Anchor()
+		defer root.Release()
+
+		w, release := root.Watch(ctx)
+		defer release()
+
+		// Calls are delivered in order, so the watch is registered
+		// before the child is created.
+		foo, releaseFoo := root.Walk(ctx, "/foo")
+		require.NoError(t, capnp.Client(foo).Resolve(ctx), "should resolve child")
+
+		ok, err := root.Store(ctx, text("foo"), false)
+		require.NoError(t, err, "should succeed")
+		require.True(t, ok, "should store value")
+
+		// Releasing the last reference to the child removes it.
+		releaseFoo()
+
+		for _, want := range []Event{
+			{Type: EventCreated, Name: "foo"},
+			{Type: EventStored},
+			{Type: EventRemoved, Name: "foo"},
+		} {
+			got, ok := w.Next()
+			require.True(t, ok, "should receive event")
+			assert.Equal(t, want, got, "should receive %s", want)
+		}
+
+		require.NoError(t, w.Err(), "should not fail")
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		t.Parallel()
+
+		root := new(Node).Anchor()
+		defer root.Release()
+
+		w, release := root.Watch(context.Background())
+		release()
+
+		_, ok := w.Next()
+		assert.False(t, ok, "should be exhausted after release")
+	})
+
+	t.Run("NullClient", func(t *testing.T) {
+		t.Parallel()
+
+		w, release := Anchor{}.Watch(context.Background())
+		defer release()
+
+		_, ok := w.Next()
+		assert.False(t, ok, "should be exhausted")
+		assert.Error(t, w.Err(), "should report null client")
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		t.Parallel()
+
+		n := new(Node)
+		events, cancel := n.Watch(1)
+		defer cancel()
+
+		defer n.Child("foo").Release()
+		defer n.Child("bar").Release()
+
+		ev, ok := <-events
+		require.True(t, ok, "should receive buffered event")
+		assert.Equal(t, Event{Type: EventCreated, Name: "foo"}, ev)
+
+		_, ok = <-events
+		assert.False(t, ok, "should drop watcher that fell behind")
+	})
+}
+
+func TestEventString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "created foo", Event{Type: EventCreated, Name: "foo"}.String())
+	assert.Equal(t, "removed foo", Event{Type: EventRemoved, Name: "foo"}.String())
+	assert.Equal(t, "stored", Event{Type: EventStored}.String())
+}
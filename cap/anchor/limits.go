@@ -0,0 +1,81 @@
+package anchor
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPathTooLong = errors.New("path too long")
+	ErrMaxDepth    = errors.New("max depth exceeded")
+	ErrMaxChildren = errors.New("max children exceeded")
+	ErrMaxNodes    = errors.New("max nodes exceeded")
+)
+
+// Limits bound the size of an anchor tree, preventing principals from
+// exhausting the host's memory by walking arbitrarily long paths, or
+// by creating arbitrarily many anchors.  Limits are enforced by Walk.
+//
+// A non-positive value designates the absence of a limit.
+type Limits struct {
+	// MaxDepth is the maximum number of path components that
+	// separate any node from the root.
+	MaxDepth int
+
+	// MaxChildren is the maximum number of children per node.
+	MaxChildren int
+
+	// MaxNodes is the maximum number of nodes in the tree, not
+	// including the root.
+	MaxNodes int
+
+	// MaxPathLen is the maximum length of a path, in bytes.
+	MaxPathLen int
+}
+
+func (l Limits) checkPath(path string) error {
+	if exceeds(len(path), l.MaxPathLen) {
+		return limitErr(ErrPathTooLong, l.MaxPathLen)
+	}
+
+	return nil
+}
+
+// checkChild reports whether parent can hold a new child, and if so,
+// reserves a slot for the child in the tree's node count.  The caller
+// MUST hold the parent's lock.
+func (l Limits) checkChild(parent *Node) error {
+	if exceeds(parent.depth+1, l.MaxDepth) {
+		return limitErr(ErrMaxDepth, l.MaxDepth)
+	}
+
+	if exceeds(len(parent.children)+1, l.MaxChildren) {
+		return limitErr(ErrMaxChildren, l.MaxChildren)
+	}
+
+	return l.reserve(parent.root())
+}
+
+// reserve a slot in the tree's node count.  Children of different
+// parents are created concurrently, so the count is checked and
+// incremented atomically.
+func (l Limits) reserve(root *Node) error {
+	for {
+		n := root.nodes.Load()
+		if exceeds(int(n)+1, l.MaxNodes) {
+			return limitErr(ErrMaxNodes, l.MaxNodes)
+		}
+
+		if root.nodes.CompareAndSwap(n, n+1) {
+			return nil
+		}
+	}
+}
+
+func exceeds(n, limit int) bool {
+	return limit > 0 && n > limit
+}
+
+func limitErr(err error, limit int) error {
+	return fmt.Errorf("%w (limit %d)", err, limit)
+}
@@ -0,0 +1,145 @@
+package anchor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wetware/pkg/api/anchor"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("MaxPathLen", func(t *testing.T) {
+		t.Parallel()
+
+		root := &Node{Limits: Limits{MaxPathLen: 8}}
+
+		_, err := root.Walk(NewPath("/" + strings.Repeat("a", 8)))
+		require.ErrorIs(t, err, ErrPathTooLong)
+		assert.Empty(t, root.children, "should not create nodes")
+
+		n, err := root.Walk(NewPath("/foo"))
+		require.NoError(t, err)
+		n.Release()
+	})
+
+	t.Run("MaxDepth", func(t *testing.T) {
+		t.Parallel()
+
+		root := &Node{Limits: Limits{MaxDepth: 2}}
+
+		n, err := root.Walk(NewPath("/foo/bar"))
+		require.NoError(t, err)
+		n.Release()
+
+		_, err = root.Walk(NewPath("/foo/bar/baz"))
+		require.ErrorIs(t, err, ErrMaxDepth)
+		assert.Empty(t, root.children, "should prune intermediate nodes")
+		assert.Zero(t, root.nodes.Load(), "should not count pruned nodes")
+	})
+
+	t.Run("MaxChildren", func(t *testing.T) {
+		t.Parallel()
+
+		root := &Node{Limits: Limits{MaxChildren: 1}}
+
+		foo, err := root.Walk(NewPath("/foo"))
+		require.NoError(t, err)
+		defer foo.Release()
+
+		_, err = root.Walk(NewPath("/bar"))
+		require.ErrorIs(t, err, ErrMaxChildren)
+
+		// existing children remain reachable
+		n, err := root.Walk(NewPath("/foo"))
+		require.NoError(t, err)
+		assert.Equal(t, foo, n)
+		n.Release()
+	})
+
+	t.Run("MaxNodes", func(t *testing.T) {
+		t.Parallel()
+
+		root := &Node{Limits: Limits{MaxNodes: 2}}
+
+		n, err := root.Walk(NewPath("/foo/bar"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), root.nodes.Load())
+
+		_, err = root.Walk(NewPath("/baz"))
+		require.ErrorIs(t, err, ErrMaxNodes)
+
+		// releasing nodes frees up capacity
+		n.Release()
+		assert.Zero(t, root.nodes.Load())
+
+		n, err = root.Walk(NewPath("/baz"))
+		require.NoError(t, err)
+		n.Release()
+	})
+
+	t.Run("MaxNodesConcurrent", func(t *testing.T) {
+		t.Parallel()
+
+		const parents, limit = 32, 64
+		root := &Node{Limits: Limits{MaxNodes: limit}}
+		for i := 0; i < parents; i++ {
+			defer root.Child(fmt.Sprintf("p%d", i)).Release()
+		}
+
+		// Walk paths under distinct parents, so that the node
+		// limit is the only thing that serializes them.
+		var (
+			wg    sync.WaitGroup
+			mu    sync.Mutex
+			nodes []*Node
+		)
+		for i := 0; i < limit*16; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				n, err := root.Walk(NewPath(fmt.Sprintf("/p%d/%d", i%parents, i)))
+				if err != nil {
+					require.ErrorIs(t, err, ErrMaxNodes)
+					return
+				}
+
+				mu.Lock()
+				nodes = append(nodes, n)
+				mu.Unlock()
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Len(t, nodes, limit-parents, "should not exceed node limit")
+		assert.Equal(t, int32(limit), root.nodes.Load())
+
+		for _, n := range nodes {
+			n.Release()
+		}
+		assert.Equal(t, int32(parents), root.nodes.Load())
+	})
+
+	t.Run("RPC", func(t *testing.T) {
+		t.Parallel()
+
+		root := &Node{Limits: Limits{MaxPathLen: 4}}
+		a := root.Anchor()
+		defer a.Release()
+
+		f, release := api.Anchor(a).Walk(context.Background(), destination(NewPath("/foobar")))
+		defer release()
+
+		_, err := f.Struct()
+		require.ErrorContains(t, err, ErrPathTooLong.Error())
+	})
+}
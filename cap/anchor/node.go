@@ -6,19 +6,30 @@ import (
 
 	"capnproto.org/go/capnp/v3"
 	api "github.com/wetware/pkg/api/anchor"
+	"github.com/wetware/pkg/util/watch"
 )
 
 type Node struct {
 	sync.Mutex
 
+	// Limits bound the size of the tree rooted at the present node.
+	// Limits are ignored on non-root nodes, and MUST NOT be modified
+	// after the node is in use.
+	Limits Limits
+
 	refs atomic.Int32
 
 	parent *Node
 	name   string
+	depth  int
+
+	tree  *Node        // root node; nil if the node is a root
+	nodes atomic.Int32 // number of descendants; set on root only
 
 	children map[string]*Node
 	client   *weakClient
-	// value api.Value
+	value    atomic.Pointer[capnp.Message] // nil if the cell is empty
+	watchers watch.Events[Event]
 }
 
 func (n *Node) AddRef() *Node {
@@ -27,43 +38,244 @@ func (n *Node) AddRef() *Node {
 }
 
 func (n *Node) Release() {
-	if refs := n.refs.Add(-1); refs == 0 && n.parent != nil {
-		defer n.parent.Release()
+	if refs := n.refs.Add(-1); refs == 0 {
+		defer n.release()
+
+		if n.parent != nil {
+			defer n.parent.Release()
 
-		n.parent.Lock()
-		delete(n.parent.children, n.name)
-		n.parent.Unlock()
+			n.parent.Lock()
+			n.parent.detach(n)
+			n.parent.Unlock()
+
+			n.root().nodes.Add(-1)
+		}
 
 	} else if refs < 0 {
 		panic("no references to release")
 	}
 }
 
+// acquire a reference to the node, unless its last reference has
+// already been released.
+func (n *Node) acquire() bool {
+	for {
+		refs := n.refs.Load()
+		if refs <= 0 {
+			return false
+		}
+
+		if n.refs.CompareAndSwap(refs, refs+1) {
+			return true
+		}
+	}
+}
+
+// detach the child from the node, if it has not been replaced.  The
+// caller MUST hold the lock.
+func (n *Node) detach(child *Node) {
+	if n.children[child.name] == child {
+		delete(n.children, child.name)
+		n.notify(Event{Type: EventRemoved, Name: child.name})
+	}
+}
+
+// release the value held in the node's cell, if any.  Releasing a
+// capability may call back into the anchor tree, so callers MUST NOT
+// hold the lock.
+func (n *Node) release() {
+	if value := n.value.Swap(nil); value != nil {
+		value.Release()
+	}
+}
+
+// Load passes the value held in the node's cell to the supplied
+// function, which MUST copy it before returning.  If the cell is
+// empty, the function is passed a null pointer.
+func (n *Node) Load(fn func(capnp.Ptr) error) error {
+	n.Lock()
+	defer n.Unlock()
+
+	value := n.value.Load()
+	if value == nil {
+		return fn(capnp.Ptr{})
+	}
+
+	v, err := value.Root()
+	if err != nil {
+		return err
+	}
+
+	return fn(v)
+}
+
+// Store a copy of v in the node's cell.  Capabilities are copied
+// by reference.  If overwrite is false, Store fails when the cell
+// is occupied, returning false; this is used as a compare-and-set
+// guard.  Storing a null pointer empties the cell.
+//
+// A non-root node holding a value is kept alive by its cell, even
+// if it has no other references.  Emptying the cell releases the
+// node.
+func (n *Node) Store(v capnp.Ptr, overwrite bool) (bool, error) {
+	var value *capnp.Message
+	if v.IsValid() {
+		msg, _ := capnp.NewSingleSegmentMessage(nil)
+		if err := msg.SetRoot(v); err != nil {
+			msg.Release()
+			return false, err
+		}
+
+		value = msg
+	}
+
+	n.Lock()
+	if n.value.Load() != nil && !overwrite {
+		n.Unlock()
+
+		if value != nil {
+			value.Release()
+		}
+
+		return false, nil
+	}
+
+	old := n.value.Swap(value)
+
+	pin := old == nil && value != nil
+	unpin := old != nil && value == nil
+	if pin && n.parent != nil {
+		n.AddRef()
+	}
+	n.notify(Event{Type: EventStored})
+	n.Unlock()
+
+	// The old value and the pin MUST be released without holding
+	// the lock.  Releasing a capability may call back into the
+	// anchor tree, and releasing the pin locks the parent.
+	if old != nil {
+		old.Release()
+	}
+
+	if unpin && n.parent != nil {
+		n.Release()
+	}
+
+	return true, nil
+}
+
 // Child returns the named child of the current node, creating it if
-// it does not exist.
+// it does not exist.  Child does not enforce limits.  The returned
+// node holds a reference that the caller MUST release.
 func (n *Node) Child(name string) *Node {
+	child, _ := n.child(name, Limits{})
+	return child
+}
+
+// child returns a reference to the named child, creating it if it
+// does not exist.  The reference is acquired while holding the lock,
+// so that the child cannot be released in the meantime.
+func (n *Node) child(name string, limits Limits) (*Node, error) {
 	n.Lock()
 	defer n.Unlock()
 
 	// Fast path;  child exists.
 	if child, ok := n.children[name]; ok {
-		return child
+		if child.acquire() {
+			return child, nil
+		}
+
+		// The child's last reference was released, and it is
+		// waiting for the lock to detach itself.  Detach it now,
+		// and replace it with a new child.
+		n.detach(child)
 	}
 
 	// Slow path; create new child.
 
+	if err := limits.checkChild(n); err != nil {
+		return nil, err
+	}
+
 	if n.children == nil {
 		n.children = make(map[string]*Node)
 	}
 
 	// The child holds the parent reference, releasing it when
 	// its own refcount hit zero.
-	n.children[name] = &Node{
+	child := &Node{
 		parent: n.AddRef(),
 		name:   name,
+		depth:  n.depth + 1,
+		tree:   n.root(),
 	}
+	child.refs.Store(1)
 
-	return n.children[name]
+	n.children[name] = child
+	n.notify(Event{Type: EventCreated, Name: name})
+
+	return child, nil
+}
+
+// Walk to the node located at path, creating nodes as needed, and
+// enforcing the limits of the tree's root.  The returned node holds
+// a reference that the caller MUST release.
+func (n *Node) Walk(path Path) (*Node, error) {
+	if err := path.Err(); err != nil {
+		return nil, err
+	}
+
+	limits := n.root().Limits
+	if err := limits.checkPath(path.String()); err != nil {
+		return nil, err
+	}
+
+	// Iteratively "walk" to designated path.  It's important to avoid
+	// recursion, so that RPCs can't blow up the stack.
+	//
+	// We hold a reference to each node along the path until we have
+	// acquired a reference to its child.  If a limit is exceeded, the
+	// release of the last reference will prune any nodes created by
+	// the walk.
+	node := n.AddRef()
+	for path, name := path.Next(); name != ""; path, name = path.Next() {
+		child, err := node.child(name, limits)
+		if err != nil {
+			node.Release()
+			return nil, err
+		}
+
+		node.Release()
+		node = child
+	}
+
+	return node, nil
+}
+
+// Watch registers a channel that receives events concerning the
+// node's immediate children and its cell.  If the channel's buffer
+// is full when an event is emitted, the channel is closed and the
+// watch is terminated.  Callers MUST call the returned function to
+// cancel the watch when finished.
+func (n *Node) Watch(buf int) (<-chan Event, func()) {
+	return n.watchers.Subscribe(buf)
+}
+
+// notify the node's watchers of the event.  The caller MUST hold
+// the lock, so that watchers observe events in the order in which
+// the changes were made.  Notify never blocks;  watchers that have
+// fallen behind are dropped.
+func (n *Node) notify(ev Event) {
+	n.watchers.Publish(ev)
+}
+
+// root returns the root of the tree containing the node.
+func (n *Node) root() *Node {
+	if n.tree != nil {
+		return n.tree
+	}
+
+	return n
 }
 
 func (n *Node) Anchor() Anchor {
@@ -73,9 +285,14 @@ func (n *Node) Anchor() Anchor {
 	// Fast path; a server is already running for this node.
 	// Node is guaranteed to have r > 1 refs.  This means we
 	// can release the refchain after client.AddRef returns.
+	//
+	// Note that the last client ref may have been released
+	// before the server has shut down, in which case we fall
+	// through to the slow path.
 	if n.client != nil {
-		client := n.client.AddRef()
-		return Anchor(client)
+		if client, ok := n.client.AddRef(); ok {
+			return Anchor(client)
+		}
 	}
 
 	// Slow path; spin up a new server, assign the weak client,
@@ -87,14 +304,16 @@ func (n *Node) Anchor() Anchor {
 	// when the last client ref has been released.
 
 	server := server{n.AddRef()}
-	client := capnp.NewClient(&nodeHook{
-		Locker:     n,
+	hook := &nodeHook{
+		Node:       n,
 		ClientHook: api.Anchor_NewServer(server),
-	})
+	}
+	client := capnp.NewClient(hook)
 
 	// Set the weak reference; subsequent calls to Anchor() will
 	// derive clients from the weakref, incrementing the refcount.
 	n.client = (*weakClient)(client.WeakRef())
+	hook.client = n.client
 
 	// Return first reference to caller;  The RPC connection will
 	// take ownership of it and release it when done.  When the
@@ -110,25 +329,30 @@ func (n *Node) Anchor() Anchor {
 
 type weakClient capnp.WeakClient
 
-func (wc *weakClient) AddRef() capnp.Client {
+// AddRef returns a strong reference to the underlying client.  It
+// returns false if the client has been released.
+func (wc *weakClient) AddRef() (capnp.Client, bool) {
 	c, ok := (*capnp.WeakClient)(wc).AddRef()
-
-	// Shutdown() ensures this never happens.
-	if !ok || c == (capnp.Client{}) {
-		panic("nil or released WeakClient")
-	}
-
-	return c
+	return c, ok && c != (capnp.Client{})
 }
 
 type nodeHook struct {
-	sync.Locker
+	*Node
 	capnp.ClientHook
+	client *weakClient
 }
 
 func (h *nodeHook) Shutdown() {
+	// A node holding a value can outlive its clients, so we clear
+	// the weak reference, unless a new server has replaced it.
 	h.Lock()
-	defer h.Unlock()
+	if h.Node.client == h.client {
+		h.Node.client = nil
+	}
+	h.Unlock()
 
+	// Shutting down the server releases the node, which may release
+	// the value held in its cell.  As with Store, this MUST happen
+	// without holding the lock.
 	h.ClientHook.Shutdown()
 }
@@ -2,6 +2,7 @@ package anchor
 
 import (
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,12 +16,15 @@ func TestWeakClient(t *testing.T) {
 
 	client := capnp.ErrorClient(errors.New("test")) // non-null client
 	wc := (*weakClient)(client.WeakRef())
-	assert.True(t, client.IsSame(wc.AddRef()),
+	ref, ok := wc.AddRef()
+	require.True(t, ok, "should succeed")
+	assert.True(t, client.IsSame(ref),
 		"should return strong reference to underlying *WeakClient")
 
 	wc = (*weakClient)(capnp.Client{}.WeakRef())
-	assert.Panics(t, func() { wc.AddRef() },
-		"should panic when creating reference to null *WeakClient")
+	_, ok = wc.AddRef()
+	assert.False(t, ok,
+		"should fail to create reference to null *WeakClient")
 }
 
 func TestNodeRelease(t *testing.T) {
@@ -40,9 +44,7 @@ func TestNode_Child(t *testing.T) {
 
 		n := new(Node)
 
-		// note the call to AddRef, nodes initially have a ref-
-		// count of zero.
-		u := n.Child("child").AddRef()
+		u := n.Child("child")
 		require.NotEqual(t, n, u,
 			"child should not be root anchor")
 		assert.Equal(t, int32(1), n.refs.Load(),
@@ -58,17 +60,13 @@ func TestNode_Child(t *testing.T) {
 
 		n := new(Node)
 
-		// note the call to AddRef, nodes initially have a ref-
-		// count of zero.
-		u := n.Child("foo").AddRef()
+		u := n.Child("foo")
 		require.NotEqual(t, n, u,
 			"child should not be root anchor")
 		require.Contains(t, n.children, "foo",
 			"root should contain child 'foo'")
 
-		// note the call to AddRef, nodes initially have a ref-
-		// count of zero.
-		u2 := n.Child("bar").AddRef()
+		u2 := n.Child("bar")
 		require.NotEqual(t, n, u2,
 			"child should not be root anchor")
 		require.Contains(t, n.children, "bar",
@@ -94,9 +92,8 @@ func TestNode_Child(t *testing.T) {
 
 		n := new(Node)
 
-		// note the call to AddRef, nodes initially have a ref-
-		// count of zero.
-		u := n.Child("foo").Child("bar").AddRef()
+		u, err := n.Walk(NewPath("/foo/bar"))
+		require.NoError(t, err)
 		require.NotZero(t, n.refs.Load(), "should not release root")
 		require.Contains(t, n.children, "foo",
 			"should contain child 'foo'")
@@ -114,16 +111,14 @@ func TestNode_Child(t *testing.T) {
 
 		n := new(Node)
 
-		// note the call to AddRef, nodes initially have a ref-
-		// count of zero.
-		u := n.Child("foo").Child("bar").Child("baz").AddRef()
+		u, err := n.Walk(NewPath("/foo/bar/baz"))
+		require.NoError(t, err)
 		require.NotZero(t, n.refs.Load(), "should not release root")
 		require.Contains(t, n.children, "foo",
 			"should contain child 'foo'")
 
-		// note the call to AddRef, nodes initially have a ref-
-		// count of zero.
-		u2 := n.Child("foo").Child("quxx").AddRef()
+		u2, err := n.Walk(NewPath("/foo/quxx"))
+		require.NoError(t, err)
 		require.NotZero(t, n.refs.Load(), "should not release root")
 
 		u.Release()
@@ -139,6 +134,34 @@ func TestNode_Child(t *testing.T) {
 
 		assert.Empty(t, n.children, "should prune children")
 	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		n := new(Node)
+
+		// Acquiring and releasing the same child concurrently must
+		// not pick up a child whose last reference was released.
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 10000; j++ {
+					u, err := n.Walk(NewPath("/foo"))
+					if assert.NoError(t, err) {
+						u.Release()
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Zero(t, n.refs.Load(), "should release root")
+		assert.Zero(t, n.nodes.Load(), "should count no nodes")
+		assert.Empty(t, n.children, "should prune children")
+	})
 }
 
 func TestNode_Anchor(t *testing.T) {
@@ -2,15 +2,15 @@ package anchor
 
 import (
 	"context"
-	"errors"
 
 	api "github.com/wetware/pkg/api/anchor"
+	"github.com/wetware/pkg/util/watch"
 )
 
 type server struct{ *Node }
 
 func (s server) Shutdown() {
-	s.Release() // nodeHook holds the lock when shutting down.
+	s.Release()
 }
 
 func (s server) Ls(ctx context.Context, call api.Anchor_ls) error {
@@ -49,15 +49,17 @@ func (s server) Ls(ctx context.Context, call api.Anchor_ls) error {
 	return err
 }
 
-// FIXME:  there is currently a vector for resource-exhaustion attacks.
-// We don't enforce a maximum depth on anchors, nor do we enforce a max
-// number of children per node. An attacker can exploit this by walking
-// an arbitrarily long path and/or by creating arbitrarily many anchors,
-// ultimately exhausting the attacker's memory.
+// Walk to the anchor located at the supplied path, subject to the
+// limits of the anchor's root.
 func (s server) Walk(ctx context.Context, call api.Anchor_walk) error {
-	path := newPath(call)
-	if path.Err() != nil {
-		return path.Err()
+	path, err := call.Args().Path()
+	if err != nil {
+		return err
+	}
+
+	// Check the raw path length before parsing the path.
+	if err = s.root().Limits.checkPath(path); err != nil {
+		return err
 	}
 
 	res, err := call.AllocResults()
@@ -65,32 +67,73 @@ func (s server) Walk(ctx context.Context, call api.Anchor_walk) error {
 		return err
 	}
 
-	// Iteratively "walk" to designated path.  It's important to avoid
-	// recursion, so that RPCs can't blow up the stack.
-	//
-	// Each iteration of the loop shadows the n symbol, including its
-	// embedded node, such that we are holding the final node when we
-	// exit the loop.
-	for path, name := path.Next(); name != ""; path, name = path.Next() {
-		s.Node = s.Child(name) // shallow copy
+	n, err := s.Node.Walk(NewPath(path))
+	if err != nil {
+		return err
 	}
+	defer n.Release()
 
-	return res.SetAnchor(anchor(s))
+	return res.SetAnchor(anchor(n))
 }
 
 func (s server) Cell(ctx context.Context, call api.Anchor_cell) error {
-	return errors.New("NOT IMPLEMENTED") // TODO(soon): implement Anchor.Cell()
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	// Each capability holds its own reference to the node, which
+	// is released when the capability is shut down.
+	loader := api.Anchor_Loader_ServerToClient(cell{s.AddRef()})
+	if err = res.SetLoader(loader); err != nil {
+		return err
+	}
+
+	storer := api.Anchor_Storer_ServerToClient(cell{s.AddRef()})
+	return res.SetStorer(storer)
 }
 
-func anchor(n interface{ Anchor() Anchor }) api.Anchor {
-	return api.Anchor(n.Anchor())
+// Watch streams the node's events to the handler until the call is
+// canceled, or until the handler falls too far behind.
+func (s server) Watch(ctx context.Context, call api.Anchor_watch) error {
+	events, cancel := s.Node.Watch(watch.Buffer)
+	defer cancel()
+
+	return watch.Serve(ctx, call, call.Args().Handler(), events, event)
 }
 
-func newPath(call api.Anchor_walk) Path {
-	path, err := call.Args().Path()
+// cell implements the Loader and Storer capabilities.
+type cell struct{ *Node }
+
+func (c cell) Shutdown() {
+	c.Release()
+}
+
+func (c cell) Load(ctx context.Context, call api.Anchor_Loader_load) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return c.Node.Load(res.SetValue)
+}
+
+func (c cell) Store(ctx context.Context, call api.Anchor_Storer_store) error {
+	v, err := call.Args().Value()
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
 	if err != nil {
-		return failure(err)
+		return err
 	}
 
-	return NewPath(path)
+	ok, err := c.Node.Store(v, call.Args().Overwrite())
+	res.SetSucceeded(ok)
+	return err
+}
+
+func anchor(n interface{ Anchor() Anchor }) api.Anchor {
+	return api.Anchor(n.Anchor())
 }
@@ -18,6 +18,19 @@ type Exchange interface {
 	GetBlock(context.Context, cid.Cid) (blocks.Block, error)
 }
 
+// Provider adds blocks to the local peer's blockstore, and announces
+// them to the network, so that peers can fetch them over BitSwap.
+type Provider interface {
+	AddBlock(context.Context, blocks.Block) error
+}
+
+// BlockService is a blockstore that is backed by the BitSwap exchange.
+// It is satisfied by the block services in github.com/ipfs/boxo.
+type BlockService interface {
+	Exchange
+	Provider
+}
+
 type BitSwap api.BitSwap
 
 func (bs BitSwap) AddRef() BitSwap {
@@ -52,11 +65,18 @@ func (bs BitSwap) GetBlock(ctx context.Context, key cid.Cid) (blocks.Block, erro
 		return nil, err
 	}
 
-	if b := blocks.NewBlock(data); b.Cid().Equals(key) {
-		return b, nil
+	// Hash the data using the key's hash function, so that blocks
+	// with arbitrary CID versions and multihashes can be verified.
+	sum, err := key.Prefix().Sum(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sum.Equals(key) {
+		return nil, blocks.ErrWrongHash
 	}
 
-	return nil, blocks.ErrWrongHash
+	return blocks.NewBlockWithCid(data, key)
 }
 
 type Server struct {
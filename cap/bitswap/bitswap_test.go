@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/wetware/pkg/cap/bitswap"
 	test_bitswap "github.com/wetware/pkg/cap/bitswap/test"
+	"github.com/wetware/pkg/rom"
 )
 
 var (
@@ -93,3 +94,30 @@ func TestBitSwap(t *testing.T) {
 		require.Nil(t, got, "should not return block")
 	})
 }
+
+func TestBitSwap_blake3(t *testing.T) {
+	t.Parallel()
+
+	/*
+		Test that blocks are verified using the hash function of the
+		requested CID, rather than the default SHA-256.
+	*/
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := []byte("hello, world!")
+	key := rom.ROM{Bytecode: data}.CID()
+
+	ex := test_bitswap.NewMockExchange(ctrl)
+	ex.EXPECT().
+		GetBlock(matchCtx, matchCID).
+		Return(blocks.NewBlock(data), nil).
+		Times(1)
+
+	bs := bitswap.Server{Exchange: ex}.BitSwap()
+	got, err := bs.GetBlock(context.Background(), key)
+	require.NoError(t, err)
+	require.True(t, got.Cid().Equals(key), "CIDs should match")
+	require.Equal(t, data, got.RawData())
+}
@@ -17,6 +17,9 @@ type Args struct {
 	Cmd  []string
 }
 
+// Encode the arguments as argv.
+//
+// Deprecated: processes obtain their arguments from the BootContext.
 func (a Args) Encode() []string {
 	return append([]string{
 		strconv.FormatUint(uint64(a.Pid), 10),
@@ -25,6 +28,9 @@ func (a Args) Encode() []string {
 	}, a.Cmd...)
 }
 
+// Decode arguments from argv produced by Encode.
+//
+// Deprecated: processes obtain their arguments from the BootContext.
 func (a *Args) Decode(argv []string) error {
 	if len(argv) < 3 {
 		return fmt.Errorf("args len %d < 3", len(argv))
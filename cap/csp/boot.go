@@ -0,0 +1,203 @@
+package csp
+
+import (
+	"context"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"github.com/ipfs/go-cid"
+
+	api "github.com/wetware/pkg/api/process"
+)
+
+// BootContext is provided to every process.  It contains the arguments
+// and capabilities passed to the process by its parent.
+type BootContext api.BootContext
+
+func (b BootContext) AddRef() BootContext {
+	return BootContext(capnp.Client(b).AddRef())
+}
+
+func (b BootContext) Release() {
+	capnp.Client(b).Release()
+}
+
+// Pid returns the PID of the process.
+func (b BootContext) Pid(ctx context.Context) (uint32, error) {
+	f, release := api.BootContext(b).Pid(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Pid(), nil
+}
+
+// Cid returns the CID of the process bytecode.
+func (b BootContext) Cid(ctx context.Context) (cid.Cid, error) {
+	f, release := api.BootContext(b).Cid(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	data, err := res.Cid()
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	return cid.Cast(data)
+}
+
+// Args returns the arguments passed to the process.
+func (b BootContext) Args(ctx context.Context) ([]string, error) {
+	f, release := api.BootContext(b).Args(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := res.Args()
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeTextList(args)
+}
+
+// Caps returns the capabilities passed to the process, in the order
+// in which they were passed to Exec.  Callers MUST release each of the
+// returned capabilities when finished.
+func (b BootContext) Caps(ctx context.Context) ([]capnp.Client, error) {
+	f, release := api.BootContext(b).Caps(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	caps, err := res.Caps()
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeCapList(caps)
+}
+
+// Cap returns the capability that the parent passed under name.  The
+// returned client is pipelined;  any error is reported when it is used.
+// Callers MUST call the ReleaseFunc when finished with the capability.
+func (b BootContext) Cap(ctx context.Context, name string) (capnp.Client, capnp.ReleaseFunc) {
+	f, release := api.BootContext(b).Cap(ctx, func(ps api.BootContext_cap_Params) error {
+		return ps.SetName(name)
+	})
+
+	return f.Cap(), release
+}
+
+// Term blocks until the process has been asked to terminate, and
+// returns nil.  Processes SHOULD exit promptly when Term returns, as
+// they will be killed once the grace period expires.  See Proc.Terminate.
+func (b BootContext) Term(ctx context.Context) error {
+	f, release := api.BootContext(b).Term(ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Cap is a capability passed to a process by its parent, along with
+// the name under which the process can retrieve it from its boot
+// context.  The name MAY be empty.
+type Cap struct {
+	Name   string
+	Client capnp.Client
+}
+
+// DecodeCaps returns the named capabilities in the list.  The caller
+// owns the returned references, and MUST release them.
+func DecodeCaps(l api.Cap_List) ([]Cap, error) {
+	caps := make([]Cap, l.Len())
+	for i := range caps {
+		name, err := l.At(i).Name()
+		if err != nil {
+			ReleaseCaps(caps[:i])
+			return nil, err
+		}
+
+		caps[i] = Cap{
+			Name:   name,
+			Client: l.At(i).Client().AddRef(),
+		}
+	}
+
+	return caps, nil
+}
+
+// BindCaps sets the elements of the list to the named capabilities, and
+// new references to their clients.  The list MUST have the same length
+// as caps.
+func BindCaps(l api.Cap_List, caps []Cap) error {
+	for i, c := range caps {
+		if err := l.At(i).SetName(c.Name); err != nil {
+			return err
+		}
+
+		if err := l.At(i).SetClient(c.Client.AddRef()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReleaseCaps releases the clients of each of the capabilities.
+func ReleaseCaps(caps []Cap) {
+	for _, c := range caps {
+		c.Client.Release()
+	}
+}
+
+// DecodeCapList returns the capabilities in the list.  The caller
+// owns the returned references, and MUST release them.
+func DecodeCapList(l capnp.PointerList) ([]capnp.Client, error) {
+	caps := make([]capnp.Client, l.Len())
+	for i := range caps {
+		p, err := l.At(i)
+		if err != nil {
+			ReleaseAll(caps[:i])
+			return nil, err
+		}
+
+		caps[i] = p.Interface().Client().AddRef()
+	}
+
+	return caps, nil
+}
+
+// BindCapList sets the elements of the list to new references to the
+// capabilities.  The list MUST have the same length as caps.
+func BindCapList(l capnp.PointerList, caps []capnp.Client) error {
+	seg := l.Segment()
+	for i, c := range caps {
+		id := seg.Message().CapTable().Add(c.AddRef())
+		if err := l.Set(i, capnp.NewInterface(seg, id).ToPtr()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReleaseAll releases each of the capabilities.
+func ReleaseAll(caps []capnp.Client) {
+	for _, c := range caps {
+		c.Release()
+	}
+}
@@ -3,6 +3,8 @@ package csp
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"strings"
 
 	capnp "capnproto.org/go/capnp/v3"
 	"lukechampine.com/blake3"
@@ -11,6 +13,18 @@ import (
 	core_api "github.com/wetware/pkg/api/core"
 )
 
+// ErrNotCached is returned by ExecCached when the executor does not
+// hold the requested bytecode.  Callers should fall back to Exec.
+var ErrNotCached = errors.New("bytecode not cached")
+
+// IsNotCached reports whether err indicates that the bytecode passed
+// to ExecCached was not cached.  Errors returned over RPC lose their
+// identity, so IsNotCached also matches on the error message.
+func IsNotCached(err error) bool {
+	return err != nil && (errors.Is(err, ErrNotCached) ||
+		strings.Contains(err.Error(), ErrNotCached.Error()))
+}
+
 // ByteCode is a representation of arbitrary executable data.
 type ByteCode []byte
 
@@ -38,12 +52,19 @@ func (ex Executor) Release() {
 
 // Exec spawns a new process from WASM bytecode bc. If the caller is a WASM process
 // spawned in this same executor, it should use its PID as ppid to mark the
-// new process as a subprocess.
+// new process as a subprocess.  Zero-valued limits are replaced by the
+// executor's defaults.  The caps are passed to the process through its
+// BootContext;  the caller retains ownership of them.  If caps are
+// given, the process is granted the caps and nothing else.  Otherwise,
+// it is served a clone of sess.  Callers SHOULD pass the capabilities
+// the process requires, rather than their full authority.
 func (ex Executor) Exec(
 	ctx context.Context,
 	sess core_api.Session,
 	bc []byte,
 	ppid uint32,
+	limits Limits,
+	caps []Cap,
 	argv ...string,
 ) (Proc, capnp.ReleaseFunc) {
 	f, release := core_api.Executor(ex).Exec(ctx,
@@ -58,6 +79,23 @@ func (ex Executor) Exec(
 				return err
 			}
 
+			l, err := ps.NewLimits()
+			if err != nil {
+				return err
+			}
+			limits.Bind(l)
+
+			if len(caps) > 0 {
+				cl, err := ps.NewCaps(int32(len(caps)))
+				if err != nil {
+					return err
+				}
+
+				if err = BindCaps(cl, caps); err != nil {
+					return err
+				}
+			}
+
 			ps.SetPpid(ppid)
 			return ps.SetSession(core_api.Session(sess))
 		})
@@ -65,12 +103,16 @@ func (ex Executor) Exec(
 }
 
 // ExecCached behaves the same way as Exec, but expects the bytecode to be already
-// cached at the executor.
+// cached at the executor.  If it is not, the executor attempts to fetch it from
+// its peers over BitSwap.  If this fails, the process fails with an error that
+// satisfies IsNotCached.
 func (ex Executor) ExecCached(
 	ctx context.Context,
 	sess core_api.Session,
 	cid cid.Cid,
 	ppid uint32,
+	limits Limits,
+	caps []Cap,
 	argv ...string,
 ) (Proc, capnp.ReleaseFunc) {
 	f, release := core_api.Executor(ex).ExecCached(ctx,
@@ -85,6 +127,23 @@ func (ex Executor) ExecCached(
 				return err
 			}
 
+			l, err := ps.NewLimits()
+			if err != nil {
+				return err
+			}
+			limits.Bind(l)
+
+			if len(caps) > 0 {
+				cl, err := ps.NewCaps(int32(len(caps)))
+				if err != nil {
+					return err
+				}
+
+				if err = BindCaps(cl, caps); err != nil {
+					return err
+				}
+			}
+
 			ps.SetPpid(ppid)
 			return ps.SetSession(core_api.Session(sess))
 		})
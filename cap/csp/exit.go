@@ -0,0 +1,186 @@
+package csp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tetratelabs/wazero/sys"
+
+	api "github.com/wetware/pkg/api/process"
+)
+
+// ErrLimitExceeded is returned by Proc.Wait when the process exceeded
+// one of its Limits.
+var ErrLimitExceeded = errors.New("resource limit exceeded")
+
+// TrapError is returned by Proc.Wait when the process trapped.
+type TrapError struct {
+	Reason string
+	Stack  []string // innermost frame first
+}
+
+func (e *TrapError) Error() string {
+	return "wasm trap: " + e.Reason
+}
+
+// HostError is returned by Proc.Wait when the host failed while running
+// the process.
+type HostError struct {
+	Message string
+}
+
+func (e *HostError) Error() string {
+	return "host error: " + e.Message
+}
+
+// ExitReason designates the way in which a process ended.
+type ExitReason uint8
+
+const (
+	ReasonExit      ExitReason = iota // process exited with an exit code
+	ReasonTrap                        // process trapped
+	ReasonHostError                   // host failed while running the process
+	ReasonKilled                      // process was forcibly stopped
+	ReasonLimit                       // process exceeded one of its limits
+)
+
+func (r ExitReason) String() string {
+	switch r {
+	case ReasonExit:
+		return "exit"
+	case ReasonTrap:
+		return "trap"
+	case ReasonHostError:
+		return "host error"
+	case ReasonKilled:
+		return "killed"
+	case ReasonLimit:
+		return "limit exceeded"
+	}
+
+	return fmt.Sprintf("<unknown exit reason %d>", r)
+}
+
+// ExitStatus describes how a process ended.  Code is only meaningful
+// when Reason is ReasonExit.  Message is the trap reason or the host
+// error, and Stack is only set for traps.
+type ExitStatus struct {
+	Reason  ExitReason
+	Code    uint32
+	Message string
+	Stack   []string
+}
+
+// DecodeExitStatus reads the exit status from the capnp struct.
+func DecodeExitStatus(s api.ExitStatus) (ExitStatus, error) {
+	switch s.Which() {
+	case api.ExitStatus_Which_exit:
+		return ExitStatus{Reason: ReasonExit, Code: s.Exit()}, nil
+
+	case api.ExitStatus_Which_trap:
+		trap, err := s.Trap()
+		if err != nil {
+			return ExitStatus{}, err
+		}
+
+		reason, err := trap.Reason()
+		if err != nil {
+			return ExitStatus{}, err
+		}
+
+		frames, err := trap.Stack()
+		if err != nil {
+			return ExitStatus{}, err
+		}
+
+		stack, err := DecodeTextList(frames)
+		if err != nil {
+			return ExitStatus{}, err
+		}
+
+		return ExitStatus{
+			Reason:  ReasonTrap,
+			Message: reason,
+			Stack:   stack,
+		}, nil
+
+	case api.ExitStatus_Which_hostError:
+		msg, err := s.HostError()
+		return ExitStatus{Reason: ReasonHostError, Message: msg}, err
+
+	case api.ExitStatus_Which_killed:
+		return ExitStatus{Reason: ReasonKilled}, nil
+
+	case api.ExitStatus_Which_limit:
+		return ExitStatus{Reason: ReasonLimit}, nil
+	}
+
+	return ExitStatus{}, fmt.Errorf("invalid exit status: %s", s.Which())
+}
+
+// Bind the exit status to the capnp struct.
+func (s ExitStatus) Bind(target api.ExitStatus) error {
+	switch s.Reason {
+	case ReasonExit:
+		target.SetExit(s.Code)
+
+	case ReasonTrap:
+		trap, err := target.NewTrap()
+		if err != nil {
+			return err
+		}
+
+		if err = trap.SetReason(s.Message); err != nil {
+			return err
+		}
+
+		stack, err := EncodeTextList(s.Stack)
+		if err != nil {
+			return err
+		}
+
+		return trap.SetStack(stack)
+
+	case ReasonHostError:
+		return target.SetHostError(s.Message)
+
+	case ReasonKilled:
+		target.SetKilled()
+
+	case ReasonLimit:
+		target.SetLimit()
+
+	default:
+		return fmt.Errorf("invalid exit reason: %s", s.Reason)
+	}
+
+	return nil
+}
+
+// Err returns the error that Proc.Wait reports for the status.  It is
+// nil if the process exited with code zero.  Non-zero exit codes are
+// reported as *sys.ExitError.
+func (s ExitStatus) Err() error {
+	switch s.Reason {
+	case ReasonExit:
+		if s.Code != 0 {
+			return sys.NewExitError(s.Code)
+		}
+
+		return nil
+
+	case ReasonTrap:
+		return &TrapError{Reason: s.Message, Stack: s.Stack}
+
+	case ReasonHostError:
+		return &HostError{Message: s.Message}
+
+	case ReasonKilled:
+		return ErrKilled
+
+	case ReasonLimit:
+		return ErrLimitExceeded
+	}
+
+	return fmt.Errorf("invalid exit reason: %s", s.Reason)
+}
@@ -0,0 +1,66 @@
+package csp
+
+import (
+	"time"
+
+	api "github.com/wetware/pkg/api/process"
+)
+
+// ExitLimitExceeded is the exit code of a process that was terminated
+// because it exceeded one of its Limits.  It is distinct from the exit
+// codes reserved by wazero.
+const ExitLimitExceeded uint32 = 0xdfffffff
+
+// Limits on the resources available to a process.  Zero-valued fields
+// are replaced by the executor's defaults.  See WithDefaults.
+type Limits struct {
+	// MemoryPages is the maximum size of the process' linear memory,
+	// in 64KiB pages.  It is checked on each guest function call and
+	// return, so a process that grows its memory past the limit is
+	// terminated at its next call or return.
+	MemoryPages uint32
+
+	// Timeout is the wall-clock deadline of the process, measured
+	// from the time at which it was spawned.
+	Timeout time.Duration
+
+	// Calls is the maximum number of guest function calls made by the
+	// process.  It does not count instructions, so code that loops
+	// without calling functions is bounded only by the Timeout.
+	Calls uint64
+}
+
+// DecodeLimits reads limits from the capnp struct.  It returns the
+// zero value if l is null.
+func DecodeLimits(l api.Limits) Limits {
+	return Limits{
+		MemoryPages: l.MemoryPages(),
+		Timeout:     time.Duration(l.Timeout()) * time.Millisecond,
+		Calls:       l.Calls(),
+	}
+}
+
+// Bind the limits to the capnp struct.
+func (l Limits) Bind(target api.Limits) {
+	target.SetMemoryPages(l.MemoryPages)
+	target.SetTimeout(uint64(l.Timeout / time.Millisecond))
+	target.SetCalls(l.Calls)
+}
+
+// WithDefaults returns a copy of l in which zero-valued fields are set
+// to the corresponding field in d.
+func (l Limits) WithDefaults(d Limits) Limits {
+	if l.MemoryPages == 0 {
+		l.MemoryPages = d.MemoryPages
+	}
+
+	if l.Timeout == 0 {
+		l.Timeout = d.Timeout
+	}
+
+	if l.Calls == 0 {
+		l.Calls = d.Calls
+	}
+
+	return l
+}
@@ -3,6 +3,7 @@ package csp
 import (
 	"context"
 	"errors"
+	"time"
 
 	capnp "capnproto.org/go/capnp/v3"
 	"github.com/tetratelabs/wazero/sys"
@@ -13,6 +14,10 @@ import (
 var (
 	ErrRunning    = errors.New("running")
 	ErrNotStarted = errors.New("not started")
+
+	// ErrKilled is returned by Wait when the process was forcibly
+	// stopped, rather than exiting on its own.
+	ErrKilled = errors.New("killed")
 )
 
 type Proc api.Process
@@ -27,7 +32,23 @@ func (p Proc) Release() {
 
 // Kill a process and any sub processes it might have spawned.
 func (p Proc) Kill(ctx context.Context) error {
-	f, release := api.Process(p).Kill(ctx, nil)
+	return p.kill(ctx, 0)
+}
+
+// Terminate asks the process to exit, and kills it along with any sub
+// processes if it is still running after the grace period.  It returns
+// when the process has exited.  Processes observe the request through
+// BootContext.Term.
+func (p Proc) Terminate(ctx context.Context, grace time.Duration) error {
+	// A zero grace period would kill the process immediately.
+	return p.kill(ctx, max(grace, time.Millisecond))
+}
+
+func (p Proc) kill(ctx context.Context, grace time.Duration) error {
+	f, release := api.Process(p).Kill(ctx, func(ps api.Process_kill_Params) error {
+		ps.SetGrace(uint64(grace / time.Millisecond))
+		return nil
+	})
 	defer release()
 
 	select {
@@ -64,6 +85,25 @@ func (p Proc) Wait(ctx context.Context) error {
 		return err
 	}
 
+	if res.HasStatus() {
+		s, err := res.Status()
+		if err != nil {
+			return err
+		}
+
+		status, err := DecodeExitStatus(s)
+		if err != nil {
+			return err
+		}
+
+		return status.Err()
+	}
+
+	// Executors that predate the exit status only report these.
+	if res.Killed() {
+		return ErrKilled
+	}
+
 	if code := res.ExitCode(); code != 0 {
 		err = sys.NewExitError(code)
 	}
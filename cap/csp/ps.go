@@ -0,0 +1,267 @@
+package csp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"github.com/ipfs/go-cid"
+
+	core_api "github.com/wetware/pkg/api/core"
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/util/casm"
+	"github.com/wetware/pkg/util/watch"
+)
+
+// ProcState is the state of a process.
+type ProcState uint8
+
+const (
+	Running ProcState = iota // process is running
+	Exited                   // process has exited
+)
+
+func (s ProcState) String() string {
+	switch s {
+	case Running:
+		return "running"
+	case Exited:
+		return "exited"
+	}
+
+	return fmt.Sprintf("<unknown state %d>", s)
+}
+
+// ProcInfo describes a process in an executor's process table.
+type ProcInfo struct {
+	Pid, Ppid uint32
+	Cid       cid.Cid
+	Args      []string
+	Start     time.Time
+	State     ProcState
+
+	// Memory is the size of the process' linear memory, in bytes.
+	Memory uint64
+
+	// Calls is the number of guest function calls made by the process.
+	// It is only counted for processes with a call limit, and is zero
+	// for others.
+	Calls uint64
+}
+
+// DecodeProcInfo reads process information from the capnp struct.
+func DecodeProcInfo(info api.Info) (ProcInfo, error) {
+	b, err := info.Cid()
+	if err != nil {
+		return ProcInfo{}, err
+	}
+
+	var id cid.Cid
+	if len(b) > 0 {
+		if _, id, err = cid.CidFromBytes(b); err != nil {
+			return ProcInfo{}, err
+		}
+	}
+
+	argl, err := info.Args()
+	if err != nil {
+		return ProcInfo{}, err
+	}
+
+	args, err := DecodeTextList(argl)
+	if err != nil {
+		return ProcInfo{}, err
+	}
+
+	return ProcInfo{
+		Pid:    info.Pid(),
+		Ppid:   info.Ppid(),
+		Cid:    id,
+		Args:   args,
+		Start:  time.Unix(0, info.StartTime()),
+		State:  ProcState(info.State()),
+		Memory: info.Memory(),
+		Calls:  info.Calls(),
+	}, nil
+}
+
+// Bind the process information to the capnp struct.
+func (p ProcInfo) Bind(target api.Info) error {
+	target.SetPid(p.Pid)
+	target.SetPpid(p.Ppid)
+	target.SetStartTime(p.Start.UnixNano())
+	target.SetState(api.Info_State(p.State))
+	target.SetMemory(p.Memory)
+	target.SetCalls(p.Calls)
+
+	if p.Cid.Defined() {
+		if err := target.SetCid(p.Cid.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	args, err := EncodeTextList(p.Args)
+	if err == nil {
+		err = target.SetArgs(args)
+	}
+
+	return err
+}
+
+// ProcEventType designates a change in the lifecycle of a process.
+type ProcEventType uint8
+
+const (
+	Spawn ProcEventType = iota // process was spawned
+	Exit                       // process exited
+)
+
+func (t ProcEventType) String() string {
+	switch t {
+	case Spawn:
+		return "spawn"
+	case Exit:
+		return "exit"
+	}
+
+	return fmt.Sprintf("<unknown event type %d>", t)
+}
+
+// ProcEvent is emitted by an executor when a process is spawned, or
+// when it exits.  ExitCode is only meaningful for Exit events.
+type ProcEvent struct {
+	Type     ProcEventType
+	Info     ProcInfo
+	ExitCode uint32
+}
+
+// DecodeProcEvent reads a process event from the capnp struct.
+func DecodeProcEvent(e api.Event) (ev ProcEvent, err error) {
+	switch e.Which() {
+	case api.Event_Which_spawn:
+		ev.Type = Spawn
+	case api.Event_Which_exit:
+		ev.Type, ev.ExitCode = Exit, e.Exit()
+	default:
+		return ev, fmt.Errorf("invalid event: %s", e.Which())
+	}
+
+	info, err := e.Info()
+	if err == nil {
+		ev.Info, err = DecodeProcInfo(info)
+	}
+
+	return
+}
+
+// Bind the event to the capnp struct.
+func (ev ProcEvent) Bind(target api.Event) error {
+	switch ev.Type {
+	case Spawn:
+		target.SetSpawn()
+	case Exit:
+		target.SetExit(ev.ExitCode)
+	default:
+		return fmt.Errorf("invalid event type: %s", ev.Type)
+	}
+
+	info, err := target.NewInfo()
+	if err == nil {
+		err = ev.Info.Bind(info)
+	}
+
+	return err
+}
+
+// Ps returns the processes that are running in the executor, ordered
+// by pid.
+func (ex Executor) Ps(ctx context.Context) ([]ProcInfo, error) {
+	f, release := core_api.Executor(ex).Ps(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	procs, err := res.Procs()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]ProcInfo, procs.Len())
+	for i := range infos {
+		if infos[i], err = DecodeProcInfo(procs.At(i)); err != nil {
+			return nil, err
+		}
+	}
+
+	return infos, nil
+}
+
+// Watch returns a watcher that receives Spawn and Exit events as they
+// occur in the executor.  Callers MUST call the provided ReleaseFunc
+// when finished with the watcher, or a resource leak will occur.
+func (ex Executor) Watch(ctx context.Context) (ProcWatcher, capnp.ReleaseFunc) {
+	// Aborting early simplifies the lifecycle logic for the handler.
+	// We still invoke Watch() in order to report the null capability
+	// error to the caller.
+	if !core_api.Executor(ex).IsValid() {
+		f, release := core_api.Executor(ex).Watch(ctx, nil)
+		return ProcWatcher{Future: casm.Future(f)}, release
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		h          = procEventHandler{make(watch.Handler[ProcEvent], 16)}
+		f, release = core_api.Executor(ex).Watch(ctx, h.Params)
+	)
+
+	return ProcWatcher{
+		Future: casm.Future(f),
+		Seq:    h,
+	}, func() {
+		cancel()
+		release()
+	}
+}
+
+// ProcWatcher is a stateful iterator over a stream of process events.
+// See Executor.Watch.
+type ProcWatcher casm.Iterator[ProcEvent]
+
+// Next blocks until the next event is received, and returns it.  The
+// boolean is false when the watcher has been exhausted, in which case
+// callers SHOULD check Err().
+func (w ProcWatcher) Next() (ProcEvent, bool) {
+	return casm.Iterator[ProcEvent](w).Next()
+}
+
+// Err returns the first non-nil error encountered by the watcher.
+// If there is no error, Err() returns nil.
+func (w ProcWatcher) Err() error {
+	return casm.Iterator[ProcEvent](w).Err()
+}
+
+// procEventHandler receives process events from the executor.
+type procEventHandler struct{ watch.Handler[ProcEvent] }
+
+func (h procEventHandler) Params(ps core_api.Executor_watch_Params) error {
+	return ps.SetHandler(api.EventHandler_ServerToClient(h))
+}
+
+func (h procEventHandler) Recv(ctx context.Context, call api.EventHandler_recv) error {
+	e, err := call.Args().Event()
+	if err != nil {
+		return err
+	}
+
+	ev, err := DecodeProcEvent(e)
+	if err != nil {
+		return err
+	}
+
+	return h.Send(ctx, ev)
+}
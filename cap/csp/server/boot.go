@@ -0,0 +1,188 @@
+package csp_server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"github.com/ipfs/go-cid"
+
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/csp"
+)
+
+// bootContext implements the BootContext capability.  It is provided
+// to the guest through the session that it obtains from the bootstrap
+// connection.
+type bootContext struct {
+	mu   sync.Mutex
+	args csp.Args
+	caps []csp.Cap
+	term <-chan struct{} // closed when the process is asked to terminate
+}
+
+// newBootContext returns a boot context for the process.  It holds its
+// own references to the capabilities, since it may outlive the process.
+func newBootContext(args csp.Args, caps []csp.Cap) *bootContext {
+	b := &bootContext{
+		args: args,
+		caps: make([]csp.Cap, len(caps)),
+	}
+
+	for i, c := range caps {
+		b.caps[i] = csp.Cap{
+			Name:   c.Name,
+			Client: c.Client.AddRef(),
+		}
+	}
+
+	return b
+}
+
+// checkCapNames returns an error if two of the capabilities share a
+// non-empty name.
+func checkCapNames(caps []csp.Cap) error {
+	names := make(map[string]struct{}, len(caps))
+	for _, c := range caps {
+		if c.Name == "" {
+			continue
+		}
+
+		if _, dup := names[c.Name]; dup {
+			return fmt.Errorf("duplicate capability name: %q", c.Name)
+		}
+		names[c.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// Shutdown releases the capabilities when the last reference to the
+// boot context is released.
+func (b *bootContext) Shutdown() {
+	csp.ReleaseCaps(b.caps)
+}
+
+func (b *bootContext) Pid(ctx context.Context, call api.BootContext_pid) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		b.mu.Lock()
+		res.SetPid(b.args.Pid)
+		b.mu.Unlock()
+	}
+
+	return err
+}
+
+func (b *bootContext) Cid(ctx context.Context, call api.BootContext_cid) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return res.SetCid(b.args.Cid.Bytes())
+}
+
+func (b *bootContext) Args(ctx context.Context, call api.BootContext_args) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	args, err := res.NewArgs(int32(len(b.args.Cmd)))
+	if err != nil {
+		return err
+	}
+
+	for i, arg := range b.args.Cmd {
+		if err = args.Set(i, arg); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func (b *bootContext) Caps(ctx context.Context, call api.BootContext_caps) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	clients := make([]capnp.Client, len(b.caps))
+	for i, c := range b.caps {
+		clients[i] = c.Client
+	}
+
+	caps, err := res.NewCaps(int32(len(clients)))
+	if err == nil {
+		err = csp.BindCapList(caps, clients)
+	}
+
+	return err
+}
+
+func (b *bootContext) Cap(ctx context.Context, call api.BootContext_cap) error {
+	name, err := call.Args().Name()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range b.caps {
+		if c.Name == name && name != "" {
+			res, err := call.AllocResults()
+			if err == nil {
+				err = res.SetCap(c.Client.AddRef())
+			}
+
+			return err
+		}
+	}
+
+	return fmt.Errorf("capability not found: %q", name)
+}
+
+// Term returns when the process has been asked to terminate.
+func (b *bootContext) Term(ctx context.Context, call api.BootContext_term) error {
+	call.Go()
+
+	select {
+	case <-b.term:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SetPid changes the PID reported by the boot context.  It does not
+// change the PID under which the executor tracks the process.
+func (b *bootContext) SetPid(ctx context.Context, call api.BootContext_setPid) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.args.Pid = call.Args().Pid()
+	return nil
+}
+
+// SetCid changes the CID reported by the boot context.
+func (b *bootContext) SetCid(ctx context.Context, call api.BootContext_setCid) error {
+	data, err := call.Args().Cid()
+	if err != nil {
+		return err
+	}
+
+	id, err := cid.Cast(data)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.args.Cid = id
+	return nil
+}
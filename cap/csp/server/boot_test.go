@@ -0,0 +1,189 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/api/core"
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/meta"
+	"github.com/wetware/pkg/rom"
+)
+
+func TestBootContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := rom.ROM{Bytecode: []byte("bytecode")}.CID()
+
+	// Pass a boot context to the process, so that we can check the
+	// identity of the capability it receives.
+	child := csp.BootContext(api.BootContext_ServerToClient(newBootContext(csp.Args{Pid: 42}, nil)))
+	defer child.Release()
+
+	b := csp.BootContext(api.BootContext_ServerToClient(newBootContext(csp.Args{
+		Pid:  2,
+		Ppid: 1,
+		Cid:  id,
+		Cmd:  []string{"foo", "bar"},
+	}, []csp.Cap{{
+		Name:   "child",
+		Client: capnp.Client(child),
+	}})))
+	defer b.Release()
+
+	pid, err := b.Pid(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), pid)
+
+	got, err := b.Cid(ctx)
+	require.NoError(t, err)
+	assert.True(t, got.Equals(id), "should report CID")
+
+	args, err := b.Args(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, args)
+
+	caps, err := b.Caps(ctx)
+	require.NoError(t, err)
+	require.Len(t, caps, 1, "should return capabilities")
+	defer csp.ReleaseAll(caps)
+
+	pid, err = csp.BootContext(caps[0]).Pid(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), pid, "should return capability passed at exec time")
+
+	c, release := b.Cap(ctx, "child")
+	defer release()
+
+	pid, err = csp.BootContext(c).Pid(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), pid, "should return capability by name")
+
+	c, release = b.Cap(ctx, "missing")
+	defer release()
+
+	_, err = csp.BootContext(c).Pid(ctx)
+	assert.ErrorContains(t, err, "capability not found",
+		"should fail to return missing capability")
+
+	// Setters change the values reported by the boot context.
+	f, release := api.BootContext(b).SetPid(ctx, func(ps api.BootContext_setPid_Params) error {
+		ps.SetPid(9)
+		return nil
+	})
+	defer release()
+	_, err = f.Struct()
+	require.NoError(t, err)
+
+	pid, err = b.Pid(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(9), pid)
+}
+
+func TestBootContext_term(t *testing.T) {
+	t.Parallel()
+
+	term := make(chan struct{})
+	b := newBootContext(csp.Args{}, nil)
+	b.term = term
+
+	c := csp.BootContext(api.BootContext_ServerToClient(b))
+	defer c.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.Term(ctx), context.DeadlineExceeded,
+		"should block until termination is requested")
+
+	close(term)
+	assert.NoError(t, c.Term(context.Background()),
+		"should return when termination is requested")
+}
+
+func TestExec_duplicateCaps(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	c := csp.BootContext(api.BootContext_ServerToClient(newBootContext(csp.Args{}, nil)))
+	defer c.Release()
+
+	ex := Runtime{
+		Cache: new(BytecodeCache),
+		Tree:  NewProcTree(ctx),
+		Log:   slog.Default(),
+	}.Executor()
+	defer ex.Release()
+
+	proc, release := ex.Exec(ctx, core.Session{}, []byte("bytecode"), 0, csp.Limits{}, []csp.Cap{
+		{Name: "foo", Client: capnp.Client(c)},
+		{Name: "foo", Client: capnp.Client(c)},
+	})
+	defer release()
+
+	err := proc.Wait(ctx)
+	assert.ErrorContains(t, err, "duplicate capability name",
+		"should reject capabilities with the same name")
+}
+
+func TestGuestSession(t *testing.T) {
+	t.Parallel()
+
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	root, err := core.NewRootSession(seg)
+	require.NoError(t, err)
+	defer root.Message().Release()
+
+	m := meta.Server{}.Meta()
+	require.NoError(t, root.SetMeta(cluster.Meta(m)))
+	require.NoError(t, root.SetView(cluster.View(capnp.ErrorClient(errors.New("view")))))
+	require.NoError(t, root.SetExecutor(core.Executor(capnp.ErrorClient(errors.New("executor")))))
+
+	t.Run("Clone", func(t *testing.T) {
+		sess, err := guestSession(root, newBootContext(csp.Args{}, nil))
+		require.NoError(t, err)
+		defer sess.Logout()
+
+		assert.True(t, core.Session(sess).Boot().IsValid(), "should grant boot context")
+		assert.True(t, core.Session(sess).View().IsValid(), "should grant parent's view")
+		assert.False(t, core.Session(sess).Meta().IsValid(),
+			"should not grant metadata updates to guests")
+		assert.True(t, root.Meta().IsValid(), "should not modify parent session")
+	})
+
+	t.Run("Caps", func(t *testing.T) {
+		c := capnp.ErrorClient(errors.New("foo"))
+		defer c.Release()
+
+		sess, err := guestSession(root, newBootContext(csp.Args{}, []csp.Cap{
+			{Name: "foo", Client: c},
+		}))
+		require.NoError(t, err)
+		defer sess.Logout()
+
+		assert.True(t, core.Session(sess).Boot().IsValid(), "should grant boot context")
+		assert.False(t, core.Session(sess).View().IsValid(),
+			"should not grant capabilities that were not passed")
+		assert.False(t, core.Session(sess).Executor().IsValid(),
+			"should not grant capabilities that were not passed")
+		assert.False(t, core.Session(sess).Meta().IsValid(),
+			"should not grant metadata updates to guests")
+
+		// The guest reaches the capabilities it was given through the
+		// boot context, and nothing else.
+		caps, err := csp.BootContext(core.Session(sess).Boot()).Caps(context.Background())
+		require.NoError(t, err)
+		defer csp.ReleaseAll(caps)
+		assert.Len(t, caps, 1, "should grant only the given caps")
+	})
+}
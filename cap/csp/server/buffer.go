@@ -0,0 +1,230 @@
+package csp_server
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	api "github.com/wetware/pkg/api/channel"
+)
+
+var _ ChanServer = (*BufferedChan)(nil)
+
+// BufferedChan is a buffered channel server.  Senders block only
+// while the buffer is full, and receivers block while it is empty.
+//
+// Closing the channel causes pending and subsequent sends to fail
+// with ErrClosed.  Receivers drain the values that were buffered
+// before the channel was closed, after which Recv fails with
+// ErrClosed.
+//
+// The buffered values are released when the last client of the
+// channel is released.  A BufferedChan MUST be served by exactly one
+// client, e.g. NewChan(ch).  The clients returned by NewSender,
+// NewRecver, NewCloser and NewSendCloser share its buffer.
+type BufferedChan struct {
+	mu      sync.Mutex
+	size    int       // capacity of the buffer; unbounded if negative
+	buf     list.List // *capnp.Message, whose root is a value
+	refs    int       // number of clients that serve the channel
+	closed  bool
+	changed cond // broadcast when the state changes
+}
+
+// NewBufferedChan returns a channel that buffers up to size values.
+// The size must be positive.  Use SyncChan for unbuffered channels.
+func NewBufferedChan(size int) *BufferedChan {
+	if size <= 0 {
+		panic("buffered channel size must be positive")
+	}
+
+	return &BufferedChan{size: size, refs: 1}
+}
+
+// NewQueue returns a channel with an unbounded buffer.  Sends never
+// block.
+func NewQueue() *BufferedChan {
+	return &BufferedChan{size: -1, refs: 1}
+}
+
+// Shutdown is called when a client of the channel is released.  When
+// the last one is released, the channel is closed and the buffered
+// values are released.
+func (ch *BufferedChan) Shutdown() {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	if ch.refs--; ch.refs > 0 {
+		return
+	}
+
+	// Fail any sends that are still pending, so that they release
+	// their values instead of buffering them.
+	ch.closed = true
+	ch.changed.broadcast()
+
+	for e := ch.buf.Front(); e != nil; e = ch.buf.Front() {
+		ch.buf.Remove(e).(*capnp.Message).Release()
+	}
+}
+
+func (ch *BufferedChan) Close(ctx context.Context, call MethodClose) error {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	if ch.closed {
+		return errors.New("already closed")
+	}
+
+	ch.closed = true
+	ch.changed.broadcast()
+
+	return nil
+}
+
+func (ch *BufferedChan) Send(ctx context.Context, call MethodSend) error {
+	// Do this first.  The value is only valid for the duration of the
+	// call, so we copy it into a message that is owned by the buffer.
+	val, err := call.Args().Value()
+	if err != nil {
+		return err
+	}
+
+	msg, err := copyValue(val)
+	if err != nil {
+		return err
+	}
+
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	for !ch.closed && ch.full() {
+		// slow path; we're going to have to wait
+		call.Go()
+
+		// wait for a receiver; temporarily unlocks mu
+		if err = ch.changed.wait(ctx, &ch.mu); err != nil {
+			msg.Release()
+			return err // always a context error
+		}
+	}
+
+	if ch.closed {
+		msg.Release()
+		return ErrClosed
+	}
+
+	ch.buf.PushBack(msg)
+	ch.changed.broadcast()
+
+	return nil
+}
+
+func (ch *BufferedChan) Recv(ctx context.Context, call MethodRecv) error {
+	// Do this first.  If something goes wrong, we can still back out
+	// without affecting the buffer's state.
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	for ch.buf.Len() == 0 {
+		if ch.closed {
+			return ErrClosed
+		}
+
+		call.Go()
+
+		// wait for a sender; temporarily unlocks mu
+		if err = ch.changed.wait(ctx, &ch.mu); err != nil {
+			return err // always a context error
+		}
+	}
+
+	// As with SyncChan, a value that fails to bind is left in the
+	// buffer, so that another receiver can try its luck.
+	next := ch.buf.Front()
+	msg := next.Value.(*capnp.Message)
+
+	val, err := msg.Root()
+	if err == nil {
+		err = res.SetValue(val) // copies val, including capabilities
+	}
+
+	if err == nil {
+		ch.buf.Remove(next) // commit
+		ch.changed.broadcast()
+		msg.Release()
+	}
+
+	return err
+}
+
+// full reports whether sends must wait for a receiver.
+//
+// Callers MUST hold mu.
+func (ch *BufferedChan) full() bool {
+	return ch.size >= 0 && ch.buf.Len() >= ch.size
+}
+
+// acquire a reference for a new client of the channel.  The client
+// releases it when it shuts down.
+func (ch *BufferedChan) acquire() *BufferedChan {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.refs++
+	return ch
+}
+
+// copyValue into a new message, which the caller MUST release.
+func copyValue(val capnp.Ptr) (*capnp.Message, error) {
+	msg, _, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = msg.SetRoot(val); err != nil { // increments capability refcounts
+		msg.Release()
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (ch *BufferedChan) NewSender(ctx context.Context, call MethodNewSender) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetSender(api.Sender_ServerToClient(ch.acquire()))
+	}
+	return err
+}
+
+func (ch *BufferedChan) NewRecver(ctx context.Context, call MethodNewRecver) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetRecver(api.Recver_ServerToClient(ch.acquire()))
+	}
+	return err
+}
+
+func (ch *BufferedChan) NewCloser(ctx context.Context, call MethodNewCloser) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetCloser(api.Closer_ServerToClient(ch.acquire()))
+	}
+	return err
+}
+
+func (ch *BufferedChan) NewSendCloser(ctx context.Context, call MethodNewSendCloser) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetSendCloser(api.SendCloser_ServerToClient(ch.acquire()))
+	}
+	return err
+}
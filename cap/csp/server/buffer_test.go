@@ -0,0 +1,218 @@
+package csp_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wetware/pkg/api/channel"
+	"github.com/wetware/pkg/util/casm"
+)
+
+func TestBufferedChan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SendWithoutRecver", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		ch := NewChan(NewBufferedChan(2))
+		defer ch.Release()
+
+		require.NoError(t, ch.Send(ctx, Text("alpha")), "should not block")
+		require.NoError(t, ch.Send(ctx, Text("bravo")), "should not block")
+
+		assert.Equal(t, "alpha", recvText(ctx, t, ch))
+		assert.Equal(t, "bravo", recvText(ctx, t, ch))
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		ch := NewChan(NewBufferedChan(1))
+		defer ch.Release()
+
+		require.NoError(t, ch.Send(ctx, Text("alpha")))
+
+		// The buffer is full, so the next send blocks until the
+		// first value is received.
+		sent := make(chan error, 1)
+		go func() {
+			sent <- ch.Send(ctx, Text("bravo"))
+		}()
+
+		select {
+		case err := <-sent:
+			t.Fatalf("send should block while buffer is full (err=%v)", err)
+		case <-time.After(time.Millisecond * 50):
+		}
+
+		assert.Equal(t, "alpha", recvText(ctx, t, ch))
+		require.NoError(t, <-sent, "should send once buffer has room")
+		assert.Equal(t, "bravo", recvText(ctx, t, ch))
+	})
+
+	t.Run("RecverFirst", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		ch := NewChan(NewBufferedChan(1))
+		defer ch.Release()
+
+		f, release := ch.Recv(ctx)
+		defer release()
+
+		require.NoError(t, ch.Send(ctx, Text("alpha")))
+		require.NoError(t, f.Await(ctx))
+
+		ptr, err := f.Ptr()
+		require.NoError(t, err)
+		assert.Equal(t, "alpha", ptr.Text())
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		ch := NewChan(NewBufferedChan(1))
+		defer ch.Release()
+
+		require.NoError(t, ch.Send(ctx, Text("alpha")))
+
+		// Blocked sends fail when the channel is closed.  The server
+		// starts the close only once the send has called Go(), i.e.
+		// once it is waiting for room in the buffer.
+		sent, release := api.Sender(ch).Send(ctx, Text("bravo"))
+		defer release()
+
+		require.NoError(t, ch.Close(ctx))
+		assert.ErrorContains(t, casm.Future(sent).Await(ctx), ErrClosed.Error())
+		assert.Error(t, ch.Close(ctx), "should fail to close twice")
+
+		err := ch.Send(ctx, Text("charlie"))
+		assert.ErrorContains(t, err, ErrClosed.Error(), "should fail to send")
+
+		assert.Equal(t, "alpha", recvText(ctx, t, ch), "should drain buffer")
+
+		f, release := ch.Recv(ctx)
+		defer release()
+		assert.ErrorContains(t, f.Await(ctx), ErrClosed.Error())
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		ch := NewChan(NewQueue())
+		recver, release := ch.NewRecver(ctx)
+
+		alpha, bravo := make(notifier), make(notifier)
+		require.NoError(t, ch.Send(ctx, Client(NewCloser(alpha))))
+		require.NoError(t, ch.Send(ctx, Client(NewCloser(bravo))))
+
+		// The recver shares the buffer, so it outlives the chan.
+		ch.Release()
+
+		f, releaseValue := recver.Recv(ctx)
+		require.NoError(t, f.Await(ctx), "should receive value")
+		releaseValue()
+		awaitShutdown(ctx, t, alpha, "should release received value")
+
+		// Releasing the last client releases the buffered values.
+		release()
+		awaitShutdown(ctx, t, bravo, "should release buffered value")
+	})
+}
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	ch := NewChan(NewQueue())
+	defer ch.Release()
+
+	want := []string{"alpha", "bravo", "charlie", "delta", "echo"}
+	for _, s := range want {
+		require.NoError(t, ch.Send(ctx, Text(s)), "should never block")
+	}
+	require.NoError(t, ch.Close(ctx))
+
+	for _, s := range want {
+		assert.Equal(t, s, recvText(ctx, t, ch), "should preserve order")
+	}
+
+	f, release := ch.Recv(ctx)
+	defer release()
+	assert.ErrorContains(t, f.Await(ctx), ErrClosed.Error())
+}
+
+func TestBufferedChan_Client(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	ch := NewChan(NewQueue())
+	defer ch.Release()
+
+	// Capabilities outlive the send call that delivered them.
+	want := NewChan(NewQueue())
+	require.NoError(t, ch.Send(ctx, Client(want.AddRef())))
+
+	f, release := ch.Recv(ctx)
+	defer release()
+	require.NoError(t, f.Await(ctx))
+
+	ptr, err := f.Ptr()
+	require.NoError(t, err)
+
+	got := Chan(ptr.Interface().Client())
+	require.NoError(t, got.Send(ctx, Text("alpha")))
+	assert.Equal(t, "alpha", recvText(ctx, t, want))
+}
+
+func recvText(ctx context.Context, t *testing.T, ch Chan) string {
+	t.Helper()
+
+	f, release := ch.Recv(ctx)
+	defer release()
+
+	require.NoError(t, f.Await(ctx), "should receive value")
+
+	ptr, err := f.Ptr()
+	require.NoError(t, err)
+
+	return ptr.Text()
+}
+
+// notifier is a capability that reports when it is released.
+type notifier chan struct{}
+
+func (n notifier) Close(context.Context, MethodClose) error { return nil }
+func (n notifier) Shutdown()                                { close(n) }
+
+func awaitShutdown(ctx context.Context, t *testing.T, n notifier, msg string) {
+	t.Helper()
+
+	select {
+	case <-n:
+	case <-ctx.Done():
+		t.Fatal(msg)
+	}
+}
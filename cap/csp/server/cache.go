@@ -1,31 +1,189 @@
 package csp_server
 
 import (
+	"bytes"
+	"container/list"
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+	"sync/atomic"
 
+	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/bitswap"
 	"github.com/wetware/pkg/rom"
 )
 
-// TODO mikel
-// Make BytecodeCache keep a list of the bcs it has sorted by last usage
-// Set and enforce a limited list size and a limited memory size
-type BytecodeCache map[string][]byte
+const (
+	// DefaultMaxBytecodes is the default number of entries in a
+	// BytecodeCache.
+	DefaultMaxBytecodes = 256
 
-func (c BytecodeCache) put(bc []byte) cid.Cid {
-	rom := rom.ROM{Bytecode: bc}
-	cid := rom.CID()
+	// DefaultMaxBytecodeBytes is the default size of a BytecodeCache.
+	DefaultMaxBytecodeBytes = 256 << 20 // 256MiB
+)
+
+// BytecodeStore is a persistent backend for a BytecodeCache.  See
+// rom.Dir for a filesystem implementation.
+type BytecodeStore interface {
+	// Put stores the bytecode and returns its CID.
+	Put(bc []byte) (cid.Cid, error)
+
+	// Get returns the bytecode for the CID.  If the bytecode is not
+	// stored, the error satisfies errors.Is(err, fs.ErrNotExist).
+	Get(cid.Cid) ([]byte, error)
+
+	// Has reports whether the bytecode is stored.
+	Has(cid.Cid) (bool, error)
+}
+
+// BytecodeCache stores bytecode, keyed by its CID.  When the cache is
+// full, the least-recently used bytecode is evicted.  It is safe for
+// concurrent use.
+//
+// If Store is set, bytecode is written through to it, and bytecode
+// that is missing from memory is loaded from it.  This allows cached
+// bytecode to survive restarts, and to outlive eviction.
+//
+// If Provider is set, bytecode is added to it as well, so that peers
+// can fetch it over BitSwap.
+//
+// The zero value is ready to use, and stores bytecode in memory only.
+type BytecodeCache struct {
+	// Store is an optional persistent backend.
+	Store BytecodeStore
+
+	// Provider optionally announces cached bytecode to peers.
+	Provider bitswap.Provider
+
+	// MaxEntries is the maximum number of entries in the cache.  If
+	// zero, DefaultMaxBytecodes is used.
+	MaxEntries int
+
+	// MaxBytes is the maximum total size of the cached bytecode.  If
+	// zero, DefaultMaxBytecodeBytes is used.  The most recently added
+	// bytecode is never evicted, even if it exceeds MaxBytes.
+	MaxBytes int
+
+	mu      sync.Mutex
+	size    int
+	lru     list.List // *bytecodeEntry, most-recently used first
+	entries map[string]*list.Element
+
+	hits, misses, evictions atomic.Uint64
+}
+
+type bytecodeEntry struct {
+	key      string
+	bytecode []byte
+}
+
+// CacheStats is a snapshot of a BytecodeCache's counters.
+type CacheStats struct {
+	Entries, Bytes          int
+	Hits, Misses, Evictions uint64
+}
+
+// Stats returns the current state of the cache's counters.
+func (c *BytecodeCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{
+		Entries:   c.lru.Len(),
+		Bytes:     c.size,
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+	}
+}
+
+// put the bytecode in the cache, and in the store, and provide it to
+// peers.  The bytecode is cached in memory even if it cannot be stored
+// or provided.
+func (c *BytecodeCache) put(ctx context.Context, bc []byte) (cid.Cid, error) {
+	id := c.insert(rom.ROM{Bytecode: bc}.CID(), bc)
+
+	if c.Store != nil {
+		if _, err := c.Store.Put(bc); err != nil {
+			return id, err
+		}
+	}
+
+	if c.Provider != nil {
+		// The block outlives the call that delivered the bytecode,
+		// so it must not share its buffer.
+		b, err := blocks.NewBlockWithCid(bytes.Clone(bc), id)
+		if err == nil {
+			err = c.Provider.AddBlock(ctx, b)
+		}
+		if err != nil {
+			return id, fmt.Errorf("provide: %w", err)
+		}
+	}
+
+	return id, nil
+}
+
+// insert a copy of the bytecode in memory.
+func (c *BytecodeCache) insert(cid cid.Cid, bc []byte) cid.Cid {
 	key := cid.String()
-	if _, found := c[key]; !found {
-		cached := make([]byte, len(bc))
-		copy(cached, bc)
-		c[key] = cached
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.entries[key]; found {
+		c.lru.MoveToFront(elem)
+		return cid
 	}
+
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+	}
+
+	cached := make([]byte, len(bc))
+	copy(cached, bc)
+	c.entries[key] = c.lru.PushFront(&bytecodeEntry{
+		key:      key,
+		bytecode: cached,
+	})
+	c.size += len(cached)
+	c.trim()
+
 	return cid
 }
 
-func (c BytecodeCache) Put(ctx context.Context, call api.BytecodeCache_put) error {
+// trim evicts least-recently used entries until the cache is within
+// its bounds.  The caller MUST hold mu.
+func (c *BytecodeCache) trim() {
+	for c.lru.Len() > 1 && (c.lru.Len() > c.maxEntries() || c.size > c.maxBytes()) {
+		e := c.lru.Remove(c.lru.Back()).(*bytecodeEntry)
+		delete(c.entries, e.key)
+		c.size -= len(e.bytecode)
+		c.evictions.Add(1)
+	}
+}
+
+func (c *BytecodeCache) maxEntries() int {
+	if c.MaxEntries <= 0 {
+		return DefaultMaxBytecodes
+	}
+
+	return c.MaxEntries
+}
+
+func (c *BytecodeCache) maxBytes() int {
+	if c.MaxBytes <= 0 {
+		return DefaultMaxBytecodeBytes
+	}
+
+	return c.MaxBytes
+}
+
+func (c *BytecodeCache) Put(ctx context.Context, call api.BytecodeCache_put) error {
 	res, err := call.AllocResults()
 	if err != nil {
 		return err
@@ -36,15 +194,56 @@ func (c BytecodeCache) Put(ctx context.Context, call api.BytecodeCache_put) erro
 		return err
 	}
 
-	cid := c.put(bc)
+	cid, err := c.put(ctx, bc)
+	if err != nil {
+		return err
+	}
+
 	return res.SetCid(cid.Bytes())
 }
 
-func (c BytecodeCache) get(cid cid.Cid) []byte {
-	return c[cid.String()]
+// get the bytecode for the CID, or nil if it is not cached.  Bytecode
+// that is loaded from the store is cached in memory.  Callers MUST NOT
+// modify the returned bytecode.
+func (c *BytecodeCache) get(cid cid.Cid) ([]byte, error) {
+	if bc := c.load(cid); bc != nil {
+		c.hits.Add(1)
+		return bc, nil
+	}
+
+	if c.Store == nil {
+		c.misses.Add(1)
+		return nil, nil
+	}
+
+	bc, err := c.Store.Get(cid)
+	if errors.Is(err, fs.ErrNotExist) {
+		c.misses.Add(1)
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.hits.Add(1)
+	c.insert(cid, bc)
+	return bc, nil
+}
+
+// load the bytecode from memory.
+func (c *BytecodeCache) load(cid cid.Cid) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[cid.String()]
+	if !ok {
+		return nil
+	}
+
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*bytecodeEntry).bytecode
 }
 
-func (c BytecodeCache) Get(ctx context.Context, call api.BytecodeCache_get) error {
+func (c *BytecodeCache) Get(ctx context.Context, call api.BytecodeCache_get) error {
 	res, err := call.AllocResults()
 	if err != nil {
 		return err
@@ -60,18 +259,33 @@ func (c BytecodeCache) Get(ctx context.Context, call api.BytecodeCache_get) erro
 		return err
 	}
 
-	return res.SetBytecode(c.get(cid))
+	bc, err := c.get(cid)
+	if err != nil {
+		return err
+	}
+
+	return res.SetBytecode(bc)
 }
 
-func (c BytecodeCache) has(cid cid.Cid) bool {
+// has reports whether the bytecode is cached.  Unlike get, it does not
+// affect the cache's counters or LRU order.
+func (c *BytecodeCache) has(cid cid.Cid) (bool, error) {
 	if cid.ByteLen() == 0 {
-		return false
+		return false, nil
+	}
+
+	c.mu.Lock()
+	_, ok := c.entries[cid.String()]
+	c.mu.Unlock()
+
+	if ok || c.Store == nil {
+		return ok, nil
 	}
 
-	return c.get(cid) != nil
+	return c.Store.Has(cid)
 }
 
-func (c BytecodeCache) Has(ctx context.Context, call api.BytecodeCache_has) error {
+func (c *BytecodeCache) Has(ctx context.Context, call api.BytecodeCache_has) error {
 	res, err := call.AllocResults()
 	if err != nil {
 		return err
@@ -87,6 +301,7 @@ func (c BytecodeCache) Has(ctx context.Context, call api.BytecodeCache_has) erro
 		return err
 	}
 
-	res.SetHas(c.has(cid))
-	return nil
+	ok, err := c.has(cid)
+	res.SetHas(ok)
+	return err
 }
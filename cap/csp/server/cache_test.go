@@ -0,0 +1,273 @@
+package csp_server
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/bitswap"
+	test_bitswap "github.com/wetware/pkg/cap/bitswap/test"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/rom"
+)
+
+func TestBytecodeCache(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("LRU", func(t *testing.T) {
+		t.Parallel()
+
+		cache := BytecodeCache{MaxEntries: 2}
+		a := put(t, &cache, []byte("a"))
+		b := put(t, &cache, []byte("b"))
+
+		// Use a, so that b is the least-recently used entry.
+		assert.Equal(t, []byte("a"), get(t, &cache, a))
+
+		c := put(t, &cache, []byte("c"))
+		assert.True(t, has(t, &cache, a), "should retain recently used entry")
+		assert.False(t, has(t, &cache, b), "should evict least-recently used entry")
+		assert.True(t, has(t, &cache, c), "should retain new entry")
+
+		assert.Nil(t, get(t, &cache, b), "should miss evicted entry")
+		assert.Equal(t, CacheStats{
+			Entries:   2,
+			Bytes:     2,
+			Hits:      1,
+			Misses:    1,
+			Evictions: 1,
+		}, cache.Stats())
+	})
+
+	t.Run("MaxBytes", func(t *testing.T) {
+		t.Parallel()
+
+		cache := BytecodeCache{MaxBytes: 4}
+		a := put(t, &cache, []byte("aa"))
+		b := put(t, &cache, []byte("bb"))
+		assert.Equal(t, 4, cache.Stats().Bytes)
+
+		c := put(t, &cache, []byte("cc"))
+		assert.False(t, has(t, &cache, a), "should evict to stay within MaxBytes")
+		assert.True(t, has(t, &cache, b))
+		assert.True(t, has(t, &cache, c))
+
+		// Oversized bytecode is cached, since it is the most recent.
+		d := put(t, &cache, []byte("dddddd"))
+		assert.True(t, has(t, &cache, d), "should retain most recent entry")
+		assert.Equal(t, 1, cache.Stats().Entries)
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		t.Parallel()
+
+		var cache BytecodeCache
+		bc := []byte("foo")
+		id := put(t, &cache, bc)
+		bc[0] = 'b'
+
+		assert.Equal(t, []byte("foo"), get(t, &cache, id), "should copy bytecode")
+		assert.Equal(t, rom.ROM{Bytecode: []byte("foo")}.CID(), id)
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		cache := BytecodeCache{MaxEntries: 4}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				id, _ := cache.put(context.Background(), []byte{byte(i)})
+				cache.get(id)
+				cache.has(id)
+			}(i)
+		}
+		wg.Wait()
+
+		stats := cache.Stats()
+		assert.Equal(t, 4, stats.Entries)
+		assert.Equal(t, uint64(12), stats.Evictions)
+		assert.Equal(t, uint64(16), stats.Hits+stats.Misses)
+	})
+}
+
+func TestBytecodeCache_store(t *testing.T) {
+	t.Parallel()
+
+	store := rom.Dir{Path: t.TempDir()}
+
+	cache := BytecodeCache{Store: store, MaxEntries: 1}
+	a := put(t, &cache, []byte("a"))
+	put(t, &cache, []byte("b")) // evicts a from memory
+
+	ok, err := store.Has(a)
+	require.NoError(t, err)
+	assert.True(t, ok, "should write bytecode through to store")
+
+	assert.True(t, has(t, &cache, a), "should find evicted bytecode in store")
+	assert.Equal(t, []byte("a"), get(t, &cache, a), "should load evicted bytecode")
+
+	// Simulate a restart.
+	restarted := BytecodeCache{Store: store}
+	assert.Equal(t, []byte("a"), get(t, &restarted, a), "should load bytecode from store")
+	assert.Equal(t, 1, restarted.Stats().Entries, "should cache loaded bytecode")
+
+	missing := rom.ROM{Bytecode: []byte("missing")}.CID()
+	assert.Nil(t, get(t, &restarted, missing), "should miss bytecode absent from store")
+	assert.Equal(t, uint64(1), restarted.Stats().Misses)
+}
+
+func TestBytecodeCache_provider(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bc := []byte("bytecode")
+	id := rom.ROM{Bytecode: bc}.CID()
+
+	var provided blocks.Block
+	p := test_bitswap.NewMockProvider(ctrl)
+	p.EXPECT().
+		AddBlock(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, b blocks.Block) error {
+			assert.True(t, b.Cid().Equals(id), "should provide block under ROM's CID")
+			assert.Equal(t, bc, b.RawData())
+			provided = b
+			return nil
+		}).
+		Times(1)
+
+	cache := BytecodeCache{Provider: p}
+	assert.Equal(t, id, put(t, &cache, bc))
+
+	// The caller's buffer may be reused after the call returns.
+	copy(bc, "xxxxxxxx")
+	assert.Equal(t, []byte("bytecode"), provided.RawData(),
+		"should not share buffer with caller")
+}
+
+func TestExecCached_notCached(t *testing.T) {
+	t.Parallel()
+
+	r := Runtime{Cache: new(BytecodeCache)}
+	ex := r.Executor()
+	defer ex.Release()
+
+	id := rom.ROM{Bytecode: []byte("missing")}.CID()
+	proc, release := ex.ExecCached(context.Background(), core.Session{}, id, 0, csp.Limits{}, nil)
+	defer release()
+
+	err := proc.Wait(context.Background())
+	require.Error(t, err, "should fail to spawn process")
+	assert.True(t, csp.IsNotCached(err), "should report that bytecode is not cached")
+}
+
+func TestRuntime_fetch(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	bc := []byte("bytecode")
+	id := rom.ROM{Bytecode: bc}.CID()
+
+	t.Run("Succeed", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ex := test_bitswap.NewMockExchange(ctrl)
+		ex.EXPECT().
+			GetBlock(gomock.Any(), gomock.Any()).
+			Return(blocks.NewBlock(bc), nil).
+			Times(1)
+
+		r := Runtime{
+			Cache:   new(BytecodeCache),
+			BitSwap: bitswap.Server{Exchange: ex}.BitSwap(),
+			Log:     slog.Default(),
+		}
+		defer r.BitSwap.Release()
+
+		got, err := r.fetch(context.Background(), id)
+		require.NoError(t, err, "should fetch bytecode")
+		assert.Equal(t, bc, got)
+		assert.Equal(t, bc, get(t, r.Cache, id), "should cache fetched bytecode")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ex := test_bitswap.NewMockExchange(ctrl)
+		ex.EXPECT().
+			GetBlock(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ cid.Cid) (blocks.Block, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).
+			Times(1)
+
+		r := Runtime{
+			Cache:        new(BytecodeCache),
+			BitSwap:      bitswap.Server{Exchange: ex}.BitSwap(),
+			FetchTimeout: time.Millisecond,
+		}
+		defer r.BitSwap.Release()
+
+		_, err := r.fetch(context.Background(), id)
+		require.Error(t, err, "should time out")
+		assert.True(t, csp.IsNotCached(err), "should report that bytecode is not cached")
+		assert.Nil(t, get(t, r.Cache, id), "should not cache bytecode")
+	})
+
+	t.Run("NoBitSwap", func(t *testing.T) {
+		t.Parallel()
+
+		r := Runtime{Cache: new(BytecodeCache)}
+
+		_, err := r.fetch(context.Background(), id)
+		require.Error(t, err, "should fail without BitSwap")
+		assert.True(t, csp.IsNotCached(err), "should report that bytecode is not cached")
+	})
+}
+
+func put(t *testing.T, c *BytecodeCache, bc []byte) cid.Cid {
+	t.Helper()
+
+	id, err := c.put(context.Background(), bc)
+	require.NoError(t, err, "must put bytecode")
+	return id
+}
+
+func get(t *testing.T, c *BytecodeCache, id cid.Cid) []byte {
+	t.Helper()
+
+	bc, err := c.get(id)
+	require.NoError(t, err, "must get bytecode")
+	return bc
+}
+
+func has(t *testing.T, c *BytecodeCache, id cid.Cid) bool {
+	t.Helper()
+
+	ok, err := c.has(id)
+	require.NoError(t, err, "must check bytecode")
+	return ok
+}
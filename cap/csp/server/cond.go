@@ -0,0 +1,45 @@
+package csp_server
+
+import (
+	"context"
+	"sync"
+)
+
+// cond is a condition variable whose waiters can be canceled.  Waiters
+// are woken by closing a channel, which is replaced on each broadcast.
+//
+// The zero-value cond is ready to use.
+type cond struct {
+	changed chan struct{} // closed and replaced on broadcast
+}
+
+// wait for a broadcast, or for the context to expire.  The lock is
+// released while waiting, and reacquired before wait returns.
+//
+// Callers MUST hold the lock.
+func (c *cond) wait(ctx context.Context, l sync.Locker) error {
+	if c.changed == nil {
+		c.changed = make(chan struct{})
+	}
+	changed := c.changed
+
+	l.Unlock()
+	defer l.Lock()
+
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// broadcast wakes all waiters.
+//
+// Callers MUST hold the lock passed to wait.
+func (c *cond) broadcast() {
+	if c.changed != nil {
+		close(c.changed)
+		c.changed = nil
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"net"
-	"os"
 	"time"
 
 	"log/slog"
@@ -19,22 +19,37 @@ import (
 	"github.com/stealthrocket/wazergo"
 	"github.com/tetratelabs/wazero"
 	wasm "github.com/tetratelabs/wazero/api"
-	"github.com/tetratelabs/wazero/experimental/sock"
 
+	cluster_api "github.com/wetware/pkg/api/cluster"
 	core_api "github.com/wetware/pkg/api/core"
 	proc_api "github.com/wetware/pkg/api/process"
 	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/bitswap"
 	"github.com/wetware/pkg/cap/csp"
 	"github.com/wetware/pkg/cap/csp/proc"
 	"github.com/wetware/pkg/system"
 	"github.com/wetware/pkg/util/log"
 )
 
+// ErrProcLimit is returned by Exec and ExecCached when the runtime is
+// already running MaxProcs processes.
+var ErrProcLimit = errors.New("process limit reached")
+
+// DefaultFetchTimeout bounds the time ExecCached spends fetching
+// bytecode over BitSwap, if Runtime.FetchTimeout is zero.
+const DefaultFetchTimeout = 30 * time.Second
+
 // components the Runtime requires to build a process.
 type components struct {
 	args     csp.Args
 	bytecode []byte
 	session  core_api.Session
+	limits   csp.Limits
+	caps     []csp.Cap // released when the process exits
+
+	stdout, stderr *output
+	stdin          *io.PipeWriter // nil if stdin carries the system socket
+	term           chan struct{}  // closed when the process is asked to terminate
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -44,6 +59,8 @@ type execArgs interface {
 	Args() (capnp.TextList, error)
 	Ppid() uint32
 	Session() (core_api.Session, error)
+	Limits() (proc_api.Limits, error)
+	Caps() (proc_api.Cap_List, error)
 }
 
 type execRes interface {
@@ -54,10 +71,33 @@ type execRes interface {
 // based processes.  The zero-value Runtime panics.
 type Runtime struct {
 	Runtime wazero.Runtime
-	Cache   BytecodeCache
+	Cache   *BytecodeCache
+	Modules *ModuleCache // optional
 	Tree    ProcTree
 	Log     log.Logger
 
+	// MaxProcs is the maximum number of processes that the runtime
+	// will run concurrently.  If zero, the number is unbounded.
+	MaxProcs uint32
+
+	// Limits are the default resource limits for processes.  They
+	// apply to limits that are not set by the caller of Exec.
+	Limits csp.Limits
+
+	// BitSwap fetches bytecode from peers when ExecCached is called
+	// with a CID that is missing from Cache.  If null, ExecCached
+	// fails immediately.
+	BitSwap bitswap.BitSwap // optional
+
+	// FetchTimeout bounds the time spent fetching bytecode over
+	// BitSwap.  If zero, DefaultFetchTimeout is used.
+	FetchTimeout time.Duration
+
+	// System is the "ww" host module, compiled and instantiated in
+	// Runtime once.  Guests that import it are bound to their own
+	// socket, and fail to spawn if System is nil.  See system.Compile.
+	System *wazergo.CompiledModule[*system.Host]
+
 	// HostModule is unused for now.
 	HostModule *wazergo.ModuleInstance[*proc.Module]
 }
@@ -78,10 +118,17 @@ func (r Runtime) Exec(ctx context.Context, call core_api.Executor_exec) error {
 		return err
 	}
 
-	// Cache new bytecodes every time they are received.
-	cid := r.Cache.put(bc)
-	r.Log.Info("cached bytecode",
-		"cid", cid.Encode(multibase.MustNewEncoder(multibase.Base58BTC)))
+	// Cache new bytecodes every time they are received.  Failing to
+	// persist the bytecode does not prevent us from running it.
+	cid, err := r.Cache.put(ctx, bc)
+	if err != nil {
+		r.Log.Warn("failed to store bytecode",
+			"cid", cid.Encode(multibase.MustNewEncoder(multibase.Base58BTC)),
+			"error", err)
+	} else {
+		r.Log.Info("cached bytecode",
+			"cid", cid.Encode(multibase.MustNewEncoder(multibase.Base58BTC)))
+	}
 
 	return r.exec(ctx, cid, bc, call.Args(), res)
 }
@@ -101,15 +148,66 @@ func (r Runtime) ExecCached(ctx context.Context, call core_api.Executor_execCach
 		return err
 	}
 
-	bc := r.Cache.get(cid)
-	if bc == nil {
-		return fmt.Errorf("bytecode for cid %s not found", cid)
+	bc, err := r.Cache.get(cid)
+	if err != nil {
+		return err
+	} else if bc == nil {
+		// Fetching can take a while; don't block the connection.
+		call.Go()
+
+		if bc, err = r.fetch(ctx, cid); err != nil {
+			return err
+		}
 	}
 
 	return r.exec(ctx, cid, bc, call.Args(), res)
 }
 
+// fetch bytecode that is missing from the cache from peers, over
+// BitSwap, and cache it.  The returned error satisfies IsNotCached,
+// so that callers can fall back on Exec.
+func (r Runtime) fetch(ctx context.Context, id cid.Cid) ([]byte, error) {
+	if !capnp.Client(r.BitSwap).IsValid() {
+		return nil, fmt.Errorf("%w: %s", csp.ErrNotCached, id)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.fetchTimeout())
+	defer cancel()
+
+	// The BitSwap client verifies the block against the CID.
+	b, err := r.BitSwap.GetBlock(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: fetch: %v", csp.ErrNotCached, id, err)
+	}
+	bc := b.RawData()
+
+	if _, err = r.Cache.put(ctx, bc); err != nil {
+		r.Log.Warn("failed to store bytecode",
+			"cid", id.Encode(multibase.MustNewEncoder(multibase.Base58BTC)),
+			"error", err)
+	} else {
+		r.Log.Info("fetched bytecode",
+			"cid", id.Encode(multibase.MustNewEncoder(multibase.Base58BTC)))
+	}
+
+	return bc, nil
+}
+
+func (r Runtime) fetchTimeout() time.Duration {
+	if r.FetchTimeout > 0 {
+		return r.FetchTimeout
+	}
+
+	return DefaultFetchTimeout
+}
+
 func (r Runtime) exec(ctx context.Context, id cid.Cid, bc []byte, ea execArgs, er execRes) error {
+	// Fail fast, before decoding the arguments.  Runtime.start checks
+	// again, since it is also called by supervisors.
+	if r.slots(r.procs()) == 0 {
+		return ErrProcLimit
+	}
+
 	sess, err := ea.Session()
 	if err != nil {
 		return err
@@ -124,41 +222,105 @@ func (r Runtime) exec(ctx context.Context, id cid.Cid, bc []byte, ea execArgs, e
 		return err
 	}
 
+	l, err := ea.Limits()
+	if err != nil {
+		return err
+	}
+
+	capl, err := ea.Caps()
+	if err != nil {
+		return err
+	}
+	caps, err := csp.DecodeCaps(capl)
+	if err != nil {
+		return err
+	}
+
+	p, err := r.start(ctx, procSpec{
+		cid:      id,
+		bytecode: bc,
+		session:  sess,
+		ppid:     ea.Ppid(),
+		argv:     argv,
+		limits:   csp.DecodeLimits(l),
+		caps:     caps,
+	})
+	if err != nil {
+		return err
+	}
+
+	return er.SetProcess(proc_api.Process_ServerToClient(p))
+}
+
+// procSpec describes a process to be started by Runtime.start.
+type procSpec struct {
+	cid      cid.Cid
+	bytecode []byte
+	session  core_api.Session
+	ppid     uint32
+	argv     []string
+	limits   csp.Limits
+	caps     []csp.Cap // owned by the process
+}
+
+// start a process.  It takes ownership of spec.caps, which are released
+// when the process exits, or immediately if start fails.
+func (r Runtime) start(ctx context.Context, spec procSpec) (*process, error) {
+	if r.slots(r.procs()) == 0 {
+		csp.ReleaseCaps(spec.caps)
+		return nil, ErrProcLimit
+	}
+
+	limits := spec.limits.WithDefaults(r.Limits)
+
 	args := csp.Args{
-		Ppid: r.Tree.PpidOrInit(ea.Ppid()),
-		Cid:  id,
+		Ppid: r.Tree.PpidOrInit(spec.ppid),
+		Cid:  spec.cid,
 		Pid:  r.Tree.NextPid(),
-		Cmd:  argv,
+		Cmd:  spec.argv,
 	}
 	r.Log.Info("exec",
 		"pid", args.Pid,
 		"ppid", args.Ppid,
-		"cid", id.Encode(multibase.MustNewEncoder(multibase.Base58BTC)),
-		"args", argv)
+		"cid", spec.cid.Encode(multibase.MustNewEncoder(multibase.Base58BTC)),
+		"args", spec.argv)
 
 	// NOTE:  we use context.Background instead of the context obtained from the
 	//        rpc handler. This ensures that a process can continue to run after
 	//        the rpc handler has returned. Note also that this context is bound
 	//        to the application lifetime, so processes cannot block a shutdown.
-	cctx, ccancel := context.WithCancel(context.Background())
+	cctx, ccancel := withLimits(context.Background(), limits)
+	context.AfterFunc(cctx, func() { csp.ReleaseCaps(spec.caps) })
+
+	if err := checkCapNames(spec.caps); err != nil {
+		ccancel()
+		return nil, err
+	}
+
 	c := components{
 		args:     args,
-		bytecode: bc,
-		session:  sess,
+		bytecode: spec.bytecode,
+		session:  spec.session,
+		limits:   limits,
+		caps:     spec.caps,
+		stdout:   newOutput(),
+		stderr:   newOutput(),
+		term:     make(chan struct{}),
 		ctx:      cctx,
 		cancel:   ccancel,
 	}
 
 	p, err := r.mkproc(ctx, c)
 	if err != nil {
-		return err
+		ccancel()
+		return nil, err
 	}
 
-	return er.SetProcess(proc_api.Process_ServerToClient(p))
+	return p, nil
 }
 
 func (r Runtime) mkproc(ctx context.Context, c components) (*process, error) {
-	mod, err := r.mkmod(ctx, c)
+	mod, err := r.mkmod(ctx, &c)
 	if err != nil {
 		return nil, err
 	}
@@ -168,36 +330,27 @@ func (r Runtime) mkproc(ctx context.Context, c components) (*process, error) {
 		return nil, errors.New("ww: missing export: _start")
 	}
 
-	proc := r.spawn(fn, c)
+	proc := r.spawn(fn, mod.Memory(), c)
 
 	return proc, nil
 }
 
-func (r Runtime) mkmod(ctx context.Context, c components) (wasm.Module, error) {
+// mkmod instantiates the process's module.  It binds the system host
+// module to c.ctx, which MUST be passed to the module's functions.
+func (r Runtime) mkmod(ctx context.Context, c *components) (wasm.Module, error) {
 	name := csp.ByteCode(c.bytecode).String() + uuid.NewString()
 
-	// TODO(perf):  cache compiled modules so that we can instantiate module
-	//              instances for concurrent use.
-	compiled, err := r.Runtime.CompileModule(ctx, c.bytecode)
+	compiled, release, err := r.compile(ctx, *c)
 	if err != nil {
 		return nil, err
 	}
+	context.AfterFunc(c.ctx, release) // release when the process exits
 
-	// TODO(perf): find a way of locating a free port without opening and
-	//             closing a connection.
-	// Find a free TCP port.
-	l, err := net.Listen("tcp", ":0")
-	if err != nil {
+	if err = checkMemory(compiled, c.limits); err != nil {
 		return nil, err
 	}
-	addr := l.Addr().(*net.TCPAddr)
 
-	r.Log.Info("instantiate module", "name", name, "port", addr.Port)
-	// Enables the creation of non-blocking TCP connections
-	// inside the WASM module. The host will pre-open the TCP
-	// port and pass it to the guest through a file descriptor.
-	sockCfg := sock.NewConfig().WithTCPListener("", addr.Port)
-	sockCtx := sock.WithConfig(ctx, sockCfg)
+	r.Log.Info("instantiate module", "name", name)
 	modCfg := wazero.NewModuleConfig().
 		WithStartFunctions(). // don't call _start until later
 		WithSysNanosleep().
@@ -206,110 +359,192 @@ func (r Runtime) mkmod(ctx context.Context, c components) (wasm.Module, error) {
 		WithRandSource(rand.Reader).
 		WithName(name).
 		WithEnv("ns", name).
-		WithStdin(os.Stdin).
-		WithStdout(os.Stdout).
-		WithStderr(os.Stderr).
-		WithArgs(c.args.Encode()...)
+		WithStdout(c.stdout).
+		WithStderr(c.stderr).
+		WithArgs(append([]string{c.args.Cid.String()}, c.args.Cmd...)...)
+
+	// Guests that import the system module exchange capnp messages with
+	// the host over an in-memory pipe.  The guest reads messages from
+	// stdin, and writes them through the system module's sock_send. The
+	// stdin of other guests is fed by the Process capability.
+	var (
+		host net.Conn
+		sess auth.Session
+	)
+	if importsSystem(compiled) {
+		if r.System == nil {
+			return nil, errors.New("ww: system module not available")
+		}
 
-	l.Close()
-	mod, err := r.Runtime.InstantiateModule(sockCtx, compiled, modCfg)
+		var guest net.Conn
+		host, guest = net.Pipe()
+
+		sys, err := r.System.Instantiate(ctx, system.WithWriter(guest))
+		if err != nil {
+			host.Close()
+			guest.Close()
+			return nil, err
+		}
+		context.AfterFunc(c.ctx, func() {
+			sys.Close(context.Background())
+			host.Close()
+		})
+
+		// The guest obtains its boot context from the session served
+		// over the bootstrap connection.
+		b := newBootContext(c.args, c.caps)
+		b.term = c.term
+		if sess, err = guestSession(c.session, b); err != nil {
+			return nil, err
+		}
+
+		c.ctx = wazergo.WithModuleInstance(c.ctx, sys)
+		modCfg = modCfg.WithStdin(guest)
+	} else {
+		stdin, w := io.Pipe()
+		context.AfterFunc(c.ctx, func() { stdin.Close() })
+
+		c.stdin = w
+		modCfg = modCfg.WithStdin(stdin)
+	}
+
+	mod, err := r.Runtime.InstantiateModule(ctx, compiled, modCfg)
 	if err != nil {
+		if host != nil {
+			sess.Logout()
+		}
 		return nil, err
 	}
 
-	r.Log.Info("serve module", "pid", c.args.Pid, "cid", c.args.Cid.String())
-	go ServeModule(c.ctx, addr, auth.Session(c.session).Clone())
+	if host != nil {
+		r.Log.Info("serve module", "pid", c.args.Pid, "cid", c.args.Cid.String())
+		go ServeModule(c.ctx, host, sess)
+	}
 
 	return mod, nil
 }
 
-func (r Runtime) spawn(fn wasm.Function, c components) *process {
-	done := make(chan execResult, 1)
+// compile the process's bytecode, or fetch it from r.Modules.  The
+// caller MUST call the returned function when the process exits.
+func (r Runtime) compile(ctx context.Context, c components) (wazero.CompiledModule, func(), error) {
+	key := c.args.Cid.String()
+	if metered(c.limits) {
+		// Metered modules are compiled with the limiter, so they are
+		// cached separately from their unmetered counterparts.
+		ctx = withLimiter(ctx)
+		key += "+metered"
+	}
 
+	if r.Modules != nil {
+		return r.Modules.compile(ctx, r.Runtime, key, c.bytecode)
+	}
+
+	compiled, err := r.Runtime.CompileModule(ctx, c.bytecode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return compiled, func() { compiled.Close(context.Background()) }, nil
+}
+
+// guestSession returns the session that is served to a guest, which
+// grants the boot context.  If the caller of exec passed capabilities,
+// the session grants nothing else, so that the guest's authority is
+// limited to the capabilities in its boot context.  Otherwise, it is a
+// clone of the session passed to exec.  Guests never receive the Meta
+// capability, so that they cannot change the metadata that the host
+// advertises;  they can read it through the View.
+func guestSession(parent core_api.Session, b *bootContext) (auth.Session, error) {
+	var sess auth.Session
+	if len(b.caps) > 0 {
+		sess = auth.Session(parent).Bare()
+	} else {
+		sess = auth.Session(parent).Clone()
+	}
+
+	err := core_api.Session(sess).SetMeta(cluster_api.Meta{})
+	if err == nil {
+		err = core_api.Session(sess).SetBoot(proc_api.BootContext_ServerToClient(b))
+	}
+
+	if err != nil {
+		sess.Logout()
+		return auth.Session{}, err
+	}
+
+	return sess, nil
+}
+
+func (r Runtime) spawn(fn wasm.Function, mem wasm.Memory, c components) *process {
 	killFunc := r.Tree.Kill
 	proc := &process{
 		pid:      c.args.Pid,
 		killFunc: killFunc,
-		done:     done,
 		cancel:   c.cancel,
+		mem:      mem,
+		stdout:   c.stdout,
+		stderr:   c.stderr,
+		stdin:    c.stdin,
+		args:     c.args,
+		start:    time.Now(),
+		exit:     make(chan struct{}),
+		term:     c.term,
 	}
+	proc.meter, _ = c.ctx.Value(keyMeter{}).(*meter)
 
 	// Register new process.
 	r.Tree.Insert(c.args.Pid, c.args.Ppid)
 	r.Tree.AddToMap(c.args.Pid, proc)
+	r.Tree.Events.Publish(csp.ProcEvent{
+		Type: csp.Spawn,
+		Info: proc.Info(),
+	})
 
 	go func() {
-		defer close(done)
+		defer c.stderr.Close()
+		defer c.stdout.Close()
 		defer c.cancel()                // stop the rpc provider
 		defer proc.killFunc(c.args.Pid) // terminate the process
 		vs, err := fn.Call(c.ctx)
+		err = exitError(c.ctx, err)
 
-		done <- execResult{
+		// The result is set exactly once, before exit is closed, so
+		// that every call to Wait observes it.
+		proc.err = err
+		proc.result = execResult{
 			Values: vs,
 			Err:    err,
+			Killed: proc.killed.Load(),
 		}
+		close(proc.exit)
+
+		r.Tree.Events.Publish(csp.ProcEvent{
+			Type:     csp.Exit,
+			Info:     proc.Info(),
+			ExitCode: exitCode(err),
+		})
 	}()
 
 	return proc
 }
 
-// ServeModule ensures the host side of the TCP connection with addr=addr
-// used for CAPNP RPCs is provided by client.
-func ServeModule(ctx context.Context, addr *net.TCPAddr, sess auth.Session) {
-	// defer func() {
-	// 	if r := recover(); r != nil {
-	// TODO @mikelsr @lthibault this is were modules non-bootstrapping
-	// modules fail. Recovering is not an option, I think we'd
-	// much rather find the cause and fix it. Still, leaving this here
-	// for reference.
-	// 	}
-	// }()
-
-	tcpConn, err := DialLoop(ctx, addr, 0)
-	if err != nil {
-		panic(err)
-	}
-	defer tcpConn.Close()
+// ServeModule provides sess to the guest over conn, which is the host
+// side of the guest's system socket.  It returns when ctx expires or
+// the connection is closed.  ServeModule takes ownership of sess.
+func ServeModule(ctx context.Context, conn io.ReadWriteCloser, sess auth.Session) {
+	defer sess.Logout()
+	defer conn.Close()
 
-	conn := rpc.NewConn(rpc.NewStreamTransport(tcpConn), &rpc.Options{
-		BootstrapClient: capnp.NewClient(core_api.Terminal_NewServer(sess.Clone())),
+	rpcConn := rpc.NewConn(rpc.NewStreamTransport(conn), &rpc.Options{
+		BootstrapClient: capnp.NewClient(core_api.Terminal_NewServer(sess)),
 		ErrorReporter: system.ErrorReporter{
 			Logger: slog.Default(),
 		},
 	})
-	defer conn.Close()
+	defer rpcConn.Close()
 
 	select {
 	case <-ctx.Done(): // close conn if the program is exiting
-		conn.Close()
-	case <-conn.Done(): // conn is closed by authenticate if auth fails
+	case <-rpcConn.Done(): // conn is closed by authenticate if auth fails
 	}
 }
-
-// DialLoop dials addr in waitTime intervals until it either succeeds or
-// the context is cancelled. Set retries to 0 for infinite loop.
-func DialLoop(ctx context.Context, addr *net.TCPAddr, retries int) (net.Conn, error) {
-	waitTime := 10 * time.Millisecond
-	var err error
-	var conn net.Conn
-
-	i := 0
-	for {
-		conn, err = net.Dial("tcp", addr.String())
-		if err == nil {
-			break
-		}
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(waitTime):
-			waitTime *= 2
-		}
-
-		if retries != 0 && i >= retries {
-			return nil, errors.New("retries exceeded")
-		}
-	}
-
-	return conn, err
-}
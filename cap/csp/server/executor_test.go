@@ -0,0 +1,133 @@
+package csp_server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+	wasi "github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/rom"
+	"github.com/wetware/pkg/system"
+)
+
+func TestRuntime_Exec(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true))
+	defer r.Close(context.Background())
+
+	_, err := wasi.Instantiate(ctx, r)
+	require.NoError(t, err, "must instantiate WASI")
+
+	sys, err := system.Compile(ctx, r)
+	require.NoError(t, err, "must compile system module")
+
+	ex := Runtime{
+		Runtime: r,
+		Cache:   new(BytecodeCache),
+		System:  sys,
+		Tree:    NewProcTree(ctx),
+		Log:     slog.Default(),
+	}.Executor()
+	defer ex.Release()
+
+	// The default ROM binds the system socket, so this checks that the
+	// guest is provided with a host module and pipe.
+	proc, release := ex.Exec(ctx, core.Session{}, rom.Default().Bytecode, 0, csp.Limits{}, nil)
+	defer release()
+
+	stdout, release := proc.Stdout(ctx)
+	defer release()
+
+	err = proc.Wait(ctx)
+	require.NoError(t, err, "process should exit cleanly")
+
+	out, err := io.ReadAll(stdout)
+	require.NoError(t, err, "should read stdout")
+	assert.Contains(t, string(out), "Hello, Wetware!",
+		"should stream output to caller")
+
+	// The default ROM's stdin carries the system socket.
+	stdin, release := proc.Stdin(ctx)
+	defer release()
+
+	_, err = stdin.Write([]byte("hello"))
+	if err == nil {
+		err = stdin.Close()
+	}
+	assert.ErrorContains(t, err, ErrStdinBound.Error(),
+		"should not feed stdin bound to system socket")
+}
+
+func TestRuntime_Exec_system(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true))
+	defer r.Close(context.Background())
+
+	sys, err := system.Compile(ctx, r)
+	require.NoError(t, err, "must compile system module")
+
+	ex := Runtime{
+		Runtime: r,
+		Cache:   new(BytecodeCache),
+		System:  sys,
+		Tree:    NewProcTree(ctx),
+		Log:     slog.Default(),
+	}.Executor()
+	defer ex.Release()
+
+	// Guests that import the system module share a single instance of
+	// it, so spawning them concurrently must not race to register it.
+	const n = 16
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			proc, release := ex.Exec(ctx, core.Session{}, systemModule(), 0, csp.Limits{}, nil)
+			defer release()
+
+			errs <- proc.Wait(ctx)
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		assert.NoError(t, <-errs, "process should exit cleanly")
+	}
+}
+
+// systemModule returns a WASM module that imports the system module,
+// and whose _start function returns immediately.
+func systemModule() []byte {
+	// imports: ww.sock_close, of type 1
+	imports := section(0x02, 0x01,
+		0x02, 'w', 'w',
+		0x0a, 's', 'o', 'c', 'k', '_', 'c', 'l', 'o', 's', 'e', 0x00, 0x01)
+
+	b := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00} // header
+	b = append(b, section(0x01, 0x02,                           // types: () -> (), () -> i32
+		0x60, 0x00, 0x00,
+		0x60, 0x00, 0x01, 0x7f)...)
+	b = append(b, imports...)
+	b = append(b, section(0x03, 0x01, 0x00)...)       // functions: 1 of type 0
+	b = append(b, section(0x05, 0x01, 0x00, 0x01)...) // memory: 1 page
+	b = append(b, section(0x07, 0x02,                 // exports
+		0x06, '_', 's', 't', 'a', 'r', 't', 0x00, 0x01,
+		0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00)...)
+	return append(b, section(0x0a, 0x01, 0x02, 0x00, 0x0b)...) // code: no-op
+}
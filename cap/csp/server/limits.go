@@ -0,0 +1,152 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	wasm "github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	"github.com/tetratelabs/wazero/sys"
+
+	"github.com/wetware/pkg/cap/csp"
+)
+
+const pageSize = 1 << 16 // 64KiB
+
+// errLimitExceeded terminates a process that exceeded its limits.  It
+// is raised by panicking from a function listener, which causes wazero
+// to return it from the guest's entrypoint.
+var errLimitExceeded = sys.NewExitError(csp.ExitLimitExceeded)
+
+// withLimits returns a context that enforces the limits on guest code
+// that is called with it.  The caller MUST call the returned cancel
+// function to release resources associated with the deadline.
+//
+// The context carries a meter only if l limits calls or memory.
+func withLimits(ctx context.Context, l csp.Limits) (context.Context, context.CancelFunc) {
+	if metered(l) {
+		ctx = context.WithValue(ctx, keyMeter{}, &meter{Limits: l})
+	}
+
+	if l.Timeout > 0 {
+		return context.WithTimeout(ctx, l.Timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// metered reports whether l is enforced by the limiter, rather than by
+// wazero.
+func metered(l csp.Limits) bool {
+	return l.Calls > 0 || l.MemoryPages > 0
+}
+
+// exitError translates errors returned by a process's entrypoint,
+// such that timeouts are reported with csp.ExitLimitExceeded.
+func exitError(ctx context.Context, err error) error {
+	var ee *sys.ExitError
+	if errors.As(err, &ee) && ee.ExitCode() == sys.ExitCodeDeadlineExceeded {
+		return errLimitExceeded
+	}
+
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errLimitExceeded
+	}
+
+	return err
+}
+
+// checkMemory returns an error if the module's memories are initially
+// larger than the pages permitted by the limits.  Growth beyond the
+// limit is enforced at runtime by the limiter.
+func checkMemory(mod interface {
+	ImportedMemories() []wasm.MemoryDefinition
+	ExportedMemories() map[string]wasm.MemoryDefinition
+}, l csp.Limits) error {
+	if l.MemoryPages == 0 {
+		return nil
+	}
+
+	defs := mod.ImportedMemories()
+	for _, def := range mod.ExportedMemories() {
+		defs = append(defs, def)
+	}
+
+	for _, def := range defs {
+		if def.Min() > l.MemoryPages {
+			return fmt.Errorf("memory limit exceeded: module requires %d pages (limit %d)",
+				def.Min(), l.MemoryPages)
+		}
+	}
+
+	return nil
+}
+
+type keyMeter struct{}
+
+// meter enforces the limits of a process on each guest function call.
+// A process's guest code runs on a single goroutine, but the number of
+// calls is read by the process table.
+type meter struct {
+	csp.Limits
+	count atomic.Uint64 // calls made by the process
+}
+
+// charge the process for a function call.  It panics with an exit
+// error if the process has exhausted its call budget.
+func (m *meter) charge() {
+	if m.Calls > 0 && m.count.Add(1) > m.Calls {
+		panic(errLimitExceeded)
+	}
+}
+
+// checkMemory panics with an exit error if the module's memory has
+// grown past the limit.
+func (m *meter) checkMemory(mod wasm.Module) {
+	if m.MemoryPages == 0 {
+		return
+	}
+
+	if mem := mod.Memory(); mem != nil && uint64(mem.Size()) > uint64(m.MemoryPages)*pageSize {
+		panic(errLimitExceeded)
+	}
+}
+
+// limiter is a function listener that enforces the process's meter on
+// each guest function call.  It is installed when compiling modules for
+// processes that have a call or memory limit, and is a no-op for calls
+// that were not made with a metered context.  See withLimits.
+//
+// The call budget counts function calls, not instructions.  Guest code
+// that loops without calling functions is bounded only by Timeout.
+// Likewise, memory is checked when guest functions are called and when
+// they return, so a process is terminated at the first call or return
+// after it grows past its memory limit.
+type limiter struct{}
+
+func (limiter) NewFunctionListener(wasm.FunctionDefinition) experimental.FunctionListener {
+	return limiter{}
+}
+
+func (limiter) Before(ctx context.Context, mod wasm.Module, _ wasm.FunctionDefinition, _ []uint64, _ experimental.StackIterator) {
+	if m, ok := ctx.Value(keyMeter{}).(*meter); ok {
+		m.checkMemory(mod)
+		m.charge()
+	}
+}
+
+func (limiter) After(ctx context.Context, mod wasm.Module, _ wasm.FunctionDefinition, _ []uint64) {
+	if m, ok := ctx.Value(keyMeter{}).(*meter); ok {
+		m.checkMemory(mod)
+	}
+}
+
+func (limiter) Abort(context.Context, wasm.Module, wasm.FunctionDefinition, error) {}
+
+// withLimiter returns a context that installs the limiter on modules
+// compiled with it.
+func withLimiter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, experimental.FunctionListenerFactoryKey{}, limiter{})
+}
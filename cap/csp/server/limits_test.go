@@ -0,0 +1,213 @@
+package csp_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/sys"
+
+	"github.com/wetware/pkg/cap/csp"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	for _, tt := range []struct {
+		name   string
+		limits csp.Limits
+	}{
+		{name: "Calls", limits: csp.Limits{Calls: 100}},
+		{name: "Timeout", limits: csp.Limits{Timeout: time.Millisecond * 10}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := run(t, loopModule(false), tt.limits)
+			require.IsType(t, new(sys.ExitError), err, "should exit with error")
+			assert.Equal(t, csp.ExitLimitExceeded, err.(*sys.ExitError).ExitCode(),
+				"should report that limits were exceeded")
+		})
+	}
+
+	t.Run("Unmetered", func(t *testing.T) {
+		t.Parallel()
+
+		// The limiter must not interfere with calls made without a
+		// call limit, even if it is installed on the module.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+			WithCloseOnContextDone(true))
+		defer r.Close(context.Background())
+
+		compiled, err := r.CompileModule(withLimiter(ctx), loopModule(false))
+		require.NoError(t, err, "must compile module")
+
+		mod, err := r.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+			WithStartFunctions())
+		require.NoError(t, err, "must instantiate module")
+
+		_, err = mod.ExportedFunction("_start").Call(ctx)
+		require.IsType(t, new(sys.ExitError), err, "should exit with error")
+		assert.Equal(t, sys.ExitCodeDeadlineExceeded, err.(*sys.ExitError).ExitCode(),
+			"should not be terminated by limiter")
+	})
+
+	t.Run("Memory", func(t *testing.T) {
+		t.Parallel()
+
+		r := wazero.NewRuntime(context.Background())
+		defer r.Close(context.Background())
+
+		for _, tt := range []struct {
+			name  string
+			bc    []byte
+			limit uint32
+			ok    bool
+		}{
+			{name: "Unlimited", bc: loopModule(true), ok: true},
+			{name: "WithinLimit", bc: boundedModule(4), limit: 4, ok: true},
+			{name: "NoMaximum", bc: loopModule(true), limit: 4, ok: true},
+			{name: "MaximumExceedsLimit", bc: boundedModule(5), limit: 4, ok: true},
+			{name: "MinimumExceedsLimit", bc: memoryModule(2), limit: 1},
+		} {
+			compiled, err := r.CompileModule(context.Background(), tt.bc)
+			require.NoError(t, err, "must compile module")
+
+			err = checkMemory(compiled, csp.Limits{MemoryPages: tt.limit})
+			if tt.ok {
+				assert.NoError(t, err, "should accept module (%s)", tt.name)
+			} else {
+				assert.Error(t, err, "should reject module (%s)", tt.name)
+			}
+		}
+	})
+
+	t.Run("Grow", func(t *testing.T) {
+		t.Parallel()
+
+		// The module declares no maximum, so it is terminated by the
+		// limiter when it grows past the limit.
+		ctx, cancel := withLimits(context.Background(), csp.Limits{MemoryPages: 4})
+		defer cancel()
+
+		r := wazero.NewRuntime(ctx)
+		defer r.Close(context.Background())
+
+		compiled, err := r.CompileModule(withLimiter(ctx), loopModule(true))
+		require.NoError(t, err, "must compile module")
+
+		mod, err := r.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+			WithStartFunctions())
+		require.NoError(t, err, "must instantiate module")
+
+		_, err = mod.ExportedFunction("_start").Call(ctx)
+		require.IsType(t, new(sys.ExitError), err, "should exit with error")
+		assert.Equal(t, csp.ExitLimitExceeded, err.(*sys.ExitError).ExitCode(),
+			"should report that limits were exceeded")
+		assert.Equal(t, uint32(5*pageSize), mod.Memory().Size(),
+			"should terminate at the first call past the limit")
+	})
+}
+
+func TestLimits_WithDefaults(t *testing.T) {
+	t.Parallel()
+
+	defaults := csp.Limits{MemoryPages: 16, Timeout: time.Second, Calls: 1}
+	got := csp.Limits{Calls: 42}.WithDefaults(defaults)
+	assert.Equal(t, csp.Limits{MemoryPages: 16, Timeout: time.Second, Calls: 42}, got)
+}
+
+// run the module's _start function, subject to the limits.
+func run(t *testing.T, bc []byte, l csp.Limits) error {
+	t.Helper()
+
+	ctx, cancel := withLimits(context.Background(), l)
+	defer cancel()
+
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true))
+	defer r.Close(context.Background())
+
+	if metered(l) {
+		ctx = withLimiter(ctx)
+	}
+
+	compiled, err := r.CompileModule(ctx, bc)
+	require.NoError(t, err, "must compile module")
+	require.NoError(t, checkMemory(compiled, l), "must be within memory limits")
+
+	mod, err := r.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+		WithStartFunctions())
+	require.NoError(t, err, "must instantiate module")
+
+	_, err = mod.ExportedFunction("_start").Call(ctx)
+	return exitError(ctx, err)
+}
+
+// loopModule returns a WASM module whose _start function calls a no-op
+// function in an infinite loop.  If grow is true, the loop also grows
+// linear memory by one page per iteration.
+func loopModule(grow bool) []byte {
+	funcs, code := loop(grow)
+	return module(1, funcs, code)
+}
+
+// boundedModule is like loopModule(true), but declares that its memory
+// may grow to at most max pages.
+func boundedModule(max byte) []byte {
+	funcs, code := loop(true)
+	return moduleWithMemory([]byte{0x01, 0x01, max}, funcs, code)
+}
+
+func loop(grow bool) (funcs, code []byte) {
+	body := []byte{0x00, 0x03, 0x40} // no locals; loop
+	if grow {
+		body = append(body, 0x41, 0x01, 0x40, 0x00, 0x1a) // drop(memory.grow(1))
+	}
+	body = append(body, 0x10, 0x01, 0x0c, 0x00, 0x0b, 0x0b) // call 1; br 0; end; end
+
+	return section(0x03, 0x02, 0x00, 0x00), // functions: 2 of type 0
+		section(0x0a, append(append([]byte{0x02, byte(len(body))}, body...),
+			0x02, 0x00, 0x0b)...) // code: _start, no-op
+}
+
+// memoryModule returns a WASM module whose memory is initially min
+// pages long.
+func memoryModule(min byte) []byte {
+	return module(min,
+		section(0x03, 0x02, 0x00, 0x00),
+		section(0x0a, 0x02, 0x02, 0x00, 0x0b, 0x02, 0x00, 0x0b))
+}
+
+// module returns a WASM module whose memory is initially pages long,
+// and has no declared maximum.
+func module(pages byte, funcs, code []byte) []byte {
+	return moduleWithMemory([]byte{0x00, pages}, funcs, code)
+}
+
+// moduleWithMemory returns a WASM module whose memory has the supplied
+// limits, in their binary encoding.
+func moduleWithMemory(limits, funcs, code []byte) []byte {
+	mem := append([]byte{0x01}, limits...) // one memory
+
+	b := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00} // header
+	b = append(b, section(0x01, 0x01, 0x60, 0x00, 0x00)...)     // types: () -> ()
+	b = append(b, funcs...)
+	b = append(b, section(0x05, mem...)...) // memory
+	b = append(b, section(0x07, 0x02,       // exports
+		0x06, '_', 's', 't', 'a', 'r', 't', 0x00, 0x00,
+		0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00)...)
+	return append(b, code...)
+}
+
+func section(id byte, body ...byte) []byte {
+	return append([]byte{id, byte(len(body))}, body...)
+}
@@ -0,0 +1,80 @@
+package csp_server
+
+import (
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+// Sample the resource usage of the runtime.  Sample satisfies the
+// pulse.Sampler interface, and is called each time the host emits
+// a heartbeat.
+func (r Runtime) Sample() routing.Load {
+	procs := r.procs()
+
+	return routing.Load{
+		Procs:  procs,
+		Memory: r.memory(),
+		CPU:    loadavg(),
+		Slots:  r.slots(procs),
+	}
+}
+
+// procs returns the number of running processes, excluding init.
+func (r Runtime) procs() uint32 {
+	return r.Tree.TPC.Get() - 1
+}
+
+// slots returns the number of processes that can be spawned before
+// MaxProcs is reached.
+func (r Runtime) slots(procs uint32) uint32 {
+	if r.MaxProcs == 0 {
+		return math.MaxUint32
+	}
+
+	if procs >= r.MaxProcs {
+		return 0
+	}
+
+	return r.MaxProcs - procs
+}
+
+// memory returns the total size of the linear memories of running
+// processes, in bytes.
+func (r Runtime) memory() (size uint64) {
+	r.Tree.Map.Range(func(_, v any) bool {
+		if p, ok := v.(*process); ok && p.mem != nil {
+			size += uint64(p.mem.Size())
+		}
+
+		return true
+	})
+
+	return
+}
+
+// loadavg returns the one-minute load average, divided by the number
+// of CPUs.  It returns zero if the load average is unavailable, as is
+// the case on non-Linux platforms.
+func loadavg() float32 {
+	b, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0
+	}
+
+	fields := strings.Fields(string(b))
+	if len(fields) == 0 {
+		return 0
+	}
+
+	avg, err := strconv.ParseFloat(fields[0], 32)
+	if err != nil {
+		return 0
+	}
+
+	return float32(avg / float64(runtime.NumCPU()))
+}
@@ -0,0 +1,35 @@
+package csp_server
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntime_Sample(t *testing.T) {
+	t.Parallel()
+
+	r := Runtime{Tree: NewProcTree(context.Background())}
+
+	load := r.Sample()
+	assert.Zero(t, load.Procs, "should not count init process")
+	assert.Zero(t, load.Memory, "should not report memory without processes")
+	assert.Equal(t, uint32(math.MaxUint32), load.Slots,
+		"should report unbounded slots if MaxProcs is unset")
+
+	r.MaxProcs = 2
+	for pid := uint32(2); pid < 4; pid++ {
+		r.Tree.Insert(pid, INIT_PID)
+		r.Tree.AddToMap(pid, &process{pid: pid})
+	}
+
+	load = r.Sample()
+	assert.Equal(t, uint32(2), load.Procs, "should count processes")
+	assert.Zero(t, load.Slots, "should report no free slots")
+
+	err := r.exec(context.Background(), cid.Undef, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrProcLimit, "should enforce MaxProcs")
+}
@@ -0,0 +1,214 @@
+package csp_server
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	"github.com/tetratelabs/wazero"
+)
+
+const (
+	// DefaultMaxModules is the default number of entries in a
+	// ModuleCache.
+	DefaultMaxModules = 64
+
+	// DefaultMaxModuleBytes is the default bytecode budget of a
+	// ModuleCache.
+	DefaultMaxModuleBytes = 256 << 20 // 256MiB
+)
+
+// ModuleCache is a bounded cache of compiled modules, keyed by the CID
+// of their bytecode.  A compiled module can be instantiated any number
+// of times, concurrently.
+//
+// When the cache is full, the least-recently used modules are evicted.
+// An evicted module is closed once it has been released by each of
+// its users, so that running processes are not affected by eviction.
+//
+// The zero value is ready to use.
+type ModuleCache struct {
+	// MaxEntries is the maximum number of compiled modules held by
+	// the cache.  If zero, DefaultMaxModules is used.
+	MaxEntries int
+
+	// MaxBytes bounds the total size of the bytecode from which the
+	// cached modules were compiled.  The memory used by compiled code
+	// is proportional to the size of the bytecode, so MaxBytes evicts
+	// modules under memory pressure.  If zero, DefaultMaxModuleBytes
+	// is used.
+	MaxBytes int
+
+	mu      sync.Mutex
+	size    int
+	lru     list.List // *moduleEntry, most-recently used first
+	entries map[string]*list.Element
+}
+
+type moduleEntry struct {
+	key  string
+	size int
+
+	ready    chan struct{} // closed when compiled
+	compiled wazero.CompiledModule
+	err      error
+
+	refs int // guarded by ModuleCache.mu
+}
+
+// Compile returns the compiled module for bc, whose CID is id.  If the
+// module is not cached, it is compiled using r.  Concurrent calls for
+// the same CID compile the module once.
+//
+// Callers MUST call the returned release function when they no longer
+// need the compiled module, including any instances of it.
+func (c *ModuleCache) Compile(ctx context.Context, r wazero.Runtime, id cid.Cid, bc []byte) (wazero.CompiledModule, func(), error) {
+	return c.compile(ctx, r, id.String(), bc)
+}
+
+// compile is like Compile, but caches the module under an arbitrary
+// key.  It allows modules compiled with different options to coexist.
+//
+// The module is compiled on behalf of every caller waiting for it, so
+// the owner compiles it with a context that is not canceled with its
+// own.  A caller whose context expires stops waiting, but compilation
+// proceeds for the others.
+func (c *ModuleCache) compile(ctx context.Context, r wazero.Runtime, key string, bc []byte) (wazero.CompiledModule, func(), error) {
+	e, owner := c.acquire(key, len(bc))
+	release := func() { c.release(e) }
+
+	if owner {
+		e.compiled, e.err = r.CompileModule(context.WithoutCancel(ctx), bc)
+		close(e.ready)
+
+		if e.err != nil {
+			c.evict(e)
+		}
+	}
+
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		release()
+		return nil, nil, ctx.Err()
+	}
+
+	if e.err != nil {
+		release()
+		return nil, nil, e.err
+	}
+
+	return e.compiled, release, nil
+}
+
+// Len returns the number of cached modules.
+func (c *ModuleCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Purge evicts all modules from the cache.
+func (c *ModuleCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+}
+
+// acquire a reference to the cache entry for key, creating it if
+// necessary.  Owner is true if the caller created the entry, in which
+// case it MUST compile the module.
+func (c *ModuleCache) acquire(key string, size int) (_ *moduleEntry, owner bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+
+		e := elem.Value.(*moduleEntry)
+		e.refs++
+		return e, false
+	}
+
+	e := &moduleEntry{
+		key:   key,
+		size:  size,
+		ready: make(chan struct{}),
+		refs:  2, // one for the cache, one for the caller
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	c.size += size
+
+	c.trim()
+	return e, true
+}
+
+func (c *ModuleCache) release(e *moduleEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.unref(e)
+}
+
+func (c *ModuleCache) evict(e *moduleEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[e.key]; ok && elem.Value == e {
+		c.remove(elem)
+	}
+}
+
+// trim evicts least-recently used modules until the cache is within
+// its bounds.  The most-recently used module is never evicted.  The
+// caller MUST hold mu.
+func (c *ModuleCache) trim() {
+	for c.lru.Len() > 1 && (c.lru.Len() > c.maxEntries() || c.size > c.maxBytes()) {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove the entry from the cache, and release the cache's reference.
+// The caller MUST hold mu.
+func (c *ModuleCache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*moduleEntry)
+	delete(c.entries, e.key)
+	c.size -= e.size
+
+	c.unref(e)
+}
+
+// unref decrements the entry's refcount, and closes the compiled module
+// when the count reaches zero.  The caller MUST hold mu.
+func (c *ModuleCache) unref(e *moduleEntry) {
+	if e.refs--; e.refs == 0 && e.compiled != nil {
+		// Close does not block, and is safe to call while instances
+		// of the module are running.
+		e.compiled.Close(context.Background())
+	}
+}
+
+func (c *ModuleCache) maxEntries() int {
+	if c.MaxEntries <= 0 {
+		return DefaultMaxModules
+	}
+
+	return c.MaxEntries
+}
+
+func (c *ModuleCache) maxBytes() int {
+	if c.MaxBytes <= 0 {
+		return DefaultMaxModuleBytes
+	}
+
+	return c.MaxBytes
+}
@@ -0,0 +1,181 @@
+package csp_server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/wetware/pkg/rom"
+)
+
+func TestModuleCache(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		r := newCountingRuntime(t)
+		bc := loopModule(false)
+		id := rom.ROM{Bytecode: bc}.CID()
+
+		var (
+			cache ModuleCache
+			wg    sync.WaitGroup
+		)
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				compiled, release, err := cache.Compile(context.Background(), r, id, bc)
+				if !assert.NoError(t, err, "should compile module") {
+					return
+				}
+				defer release()
+
+				// Compiled modules support concurrent instantiation.
+				mod, err := r.InstantiateModule(context.Background(), compiled,
+					wazero.NewModuleConfig().WithName("").WithStartFunctions())
+				if assert.NoError(t, err, "should instantiate module") {
+					mod.Close(context.Background())
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), r.compiled.Load(), "should compile module once")
+		assert.Equal(t, 1, cache.Len(), "should cache module")
+	})
+
+	t.Run("Evict", func(t *testing.T) {
+		t.Parallel()
+
+		r := newCountingRuntime(t)
+		cache := ModuleCache{MaxEntries: 1}
+
+		bc := loopModule(false)
+		id := rom.ROM{Bytecode: bc}.CID()
+		compiled, release, err := cache.Compile(context.Background(), r, id, bc)
+		require.NoError(t, err, "should compile module")
+
+		// Evict the first module by compiling another one.
+		bc2 := memoryModule(1)
+		_, release2, err := cache.Compile(context.Background(), r, rom.ROM{Bytecode: bc2}.CID(), bc2)
+		require.NoError(t, err, "should compile module")
+		defer release2()
+		assert.Equal(t, 1, cache.Len(), "should evict least-recently used module")
+
+		// The evicted module is still in use, so it must not be closed.
+		mod, err := r.InstantiateModule(context.Background(), compiled,
+			wazero.NewModuleConfig().WithStartFunctions())
+		require.NoError(t, err, "should instantiate evicted module that is still in use")
+		mod.Close(context.Background())
+
+		release()
+
+		_, err = r.InstantiateModule(context.Background(), compiled,
+			wazero.NewModuleConfig().WithStartFunctions())
+		assert.Error(t, err, "should close evicted module after release")
+
+		// Recompile on the next miss.
+		_, release, err = cache.Compile(context.Background(), r, id, bc)
+		require.NoError(t, err, "should compile module")
+		defer release()
+		assert.Equal(t, int32(3), r.compiled.Load(), "should recompile evicted module")
+	})
+
+	t.Run("MaxBytes", func(t *testing.T) {
+		t.Parallel()
+
+		r := newCountingRuntime(t)
+		cache := ModuleCache{MaxBytes: 1}
+
+		for _, bc := range [][]byte{loopModule(false), loopModule(true)} {
+			_, release, err := cache.Compile(context.Background(), r, rom.ROM{Bytecode: bc}.CID(), bc)
+			require.NoError(t, err, "should compile module")
+			release()
+		}
+
+		assert.Equal(t, 1, cache.Len(), "should retain most-recently used module")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		r := newCountingRuntime(t)
+		var cache ModuleCache
+
+		bc := []byte("not wasm")
+		_, _, err := cache.Compile(context.Background(), r, rom.ROM{Bytecode: bc}.CID(), bc)
+		assert.Error(t, err, "should fail to compile invalid bytecode")
+		assert.Zero(t, cache.Len(), "should not cache failed compilation")
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		t.Parallel()
+
+		r := newCountingRuntime(t)
+		var cache ModuleCache
+
+		// The first caller gives up, but the module is compiled for
+		// the callers that are still waiting.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		bc := loopModule(false)
+		id := rom.ROM{Bytecode: bc}.CID()
+		if _, release, err := cache.Compile(ctx, r, id, bc); err == nil {
+			release()
+		}
+
+		_, release, err := cache.Compile(context.Background(), r, id, bc)
+		require.NoError(t, err, "should not fail with the owner's context")
+		release()
+
+		assert.Equal(t, int32(1), r.compiled.Load(), "should compile module once")
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		t.Parallel()
+
+		r := newCountingRuntime(t)
+		var cache ModuleCache
+
+		bc := loopModule(false)
+		_, release, err := cache.Compile(context.Background(), r, rom.ROM{Bytecode: bc}.CID(), bc)
+		require.NoError(t, err, "should compile module")
+		release()
+
+		cache.Purge()
+		assert.Zero(t, cache.Len(), "should evict all modules")
+	})
+}
+
+// countingRuntime counts calls to CompileModule.
+type countingRuntime struct {
+	wazero.Runtime
+	compiled atomic.Int32
+}
+
+func newCountingRuntime(t *testing.T) *countingRuntime {
+	r := wazero.NewRuntime(context.Background())
+	t.Cleanup(func() { r.Close(context.Background()) })
+
+	return &countingRuntime{Runtime: r}
+}
+
+func (r *countingRuntime) CompileModule(ctx context.Context, bc []byte) (wazero.CompiledModule, error) {
+	r.compiled.Add(1)
+	if err := ctx.Err(); err != nil {
+		return nil, err // compilation was aborted
+	}
+
+	return r.Runtime.CompileModule(ctx, bc)
+}
@@ -2,33 +2,107 @@ package csp_server
 
 import (
 	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	wasm "github.com/tetratelabs/wazero/api"
 	"github.com/tetratelabs/wazero/sys"
 	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/csp"
 )
 
 // process is the main implementation of the Process capability.
 type process struct {
 	pid      uint32
-	done     <-chan execResult
 	killFunc func(uint32) // killFunc must call cancel()
 	cancel   context.CancelFunc
-	result   execResult
+	mem      wasm.Memory // nil if the module does not export memory
+
+	stdout, stderr *output
+	stdin          *io.PipeWriter // nil if stdin carries the system socket
+
+	args  csp.Args
+	start time.Time
+	meter *meter // nil if the process is unmetered
+
+	exit   chan struct{} // closed when the process exits
+	err    error         // error returned by the entrypoint; set before exit is closed
+	result execResult    // set before exit is closed
+
+	term     chan struct{} // closed when the process is asked to terminate
+	termOnce sync.Once
+	killed   atomic.Bool // set when the process is forcibly stopped
+}
+
+// Info returns a description of the process, for the process table.
+func (p *process) Info() csp.ProcInfo {
+	info := csp.ProcInfo{
+		Pid:   p.args.Pid,
+		Ppid:  p.args.Ppid,
+		Cid:   p.args.Cid,
+		Args:  p.args.Cmd,
+		Start: p.start,
+		State: csp.Running,
+	}
+
+	select {
+	case <-p.exit:
+		info.State = csp.Exited
+	default:
+	}
+
+	if p.mem != nil {
+		info.Memory = uint64(p.mem.Size())
+	}
+
+	if p.meter != nil {
+		info.Calls = p.meter.count.Load()
+	}
+
+	return info
 }
 
 func (p *process) Kill(ctx context.Context, call api.Process_kill) error {
-	p.killFunc(p.pid)
-	return nil
+	grace := time.Duration(call.Args().Grace()) * time.Millisecond
+	if grace == 0 {
+		p.killFunc(p.pid)
+		return nil
+	}
+
+	p.terminate(grace)
+
+	call.Go()
+	select {
+	case <-p.exit:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// terminate asks the process to exit, and stops it forcibly if it is
+// still running after the grace period.  The grace period is enforced
+// even if the caller goes away.  Subsequent calls are no-ops.
+func (p *process) terminate(grace time.Duration) {
+	p.termOnce.Do(func() {
+		close(p.term)
+
+		timer := time.AfterFunc(grace, func() { p.killFunc(p.pid) })
+		go func() {
+			<-p.exit
+			timer.Stop()
+		}()
+	})
 }
 
 func (p *process) Wait(ctx context.Context, call api.Process_wait) error {
 	call.Go()
 	select {
-	case res, ok := <-p.done:
-		if ok {
-			p.result = res
-		}
-
+	case <-p.exit:
 	case <-ctx.Done():
 		return ctx.Err()
 	}
@@ -41,15 +115,119 @@ func (p *process) Wait(ctx context.Context, call api.Process_wait) error {
 	return err
 }
 
+func (p *process) Stdout(ctx context.Context, call api.Process_stdout) error {
+	call.Go()
+	return p.stdout.Stream(ctx, call.Args().Writer())
+}
+
+func (p *process) Stderr(ctx context.Context, call api.Process_stderr) error {
+	call.Go()
+	return p.stderr.Stream(ctx, call.Args().Writer())
+}
+
+func (p *process) Stdin(ctx context.Context, call api.Process_stdin) error {
+	if p.stdin == nil {
+		return ErrStdinBound
+	}
+
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetWriter(api.Process_Writer_ServerToClient(input{p.stdin}))
+	}
+
+	return err
+}
+
+// exitCode returns the exit code reported for an error returned by a
+// process's entrypoint.  Errors other than *sys.ExitError, such as
+// traps, are reported as failures with exit code 1.
+func exitCode(err error) uint32 {
+	var ee *sys.ExitError
+	if errors.As(err, &ee) {
+		return ee.ExitCode()
+	}
+
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
 type execResult struct {
 	Values []uint64
 	Err    error
+	Killed bool
+}
+
+// Status describes how the process ended.
+func (r execResult) Status() csp.ExitStatus {
+	if r.Killed {
+		return csp.ExitStatus{Reason: csp.ReasonKilled}
+	}
+
+	var ee *sys.ExitError
+	if errors.As(r.Err, &ee) {
+		switch ee.ExitCode() {
+		case csp.ExitLimitExceeded:
+			return csp.ExitStatus{Reason: csp.ReasonLimit}
+		case sys.ExitCodeContextCanceled:
+			return csp.ExitStatus{Reason: csp.ReasonKilled}
+		}
+
+		return csp.ExitStatus{Reason: csp.ReasonExit, Code: ee.ExitCode()}
+	}
+
+	if r.Err == nil {
+		return csp.ExitStatus{Reason: csp.ReasonExit}
+	}
+
+	if reason, stack, ok := parseTrap(r.Err); ok {
+		return csp.ExitStatus{
+			Reason:  csp.ReasonTrap,
+			Message: reason,
+			Stack:   stack,
+		}
+	}
+
+	return csp.ExitStatus{
+		Reason:  csp.ReasonHostError,
+		Message: r.Err.Error(),
+	}
 }
 
 func (r execResult) Bind(res api.Process_wait_Results) error {
-	if r.Err != nil {
-		res.SetExitCode(r.Err.(*sys.ExitError).ExitCode())
+	status := r.Status()
+
+	res.SetExitCode(exitCode(r.Err))
+	res.SetKilled(status.Reason == csp.ReasonKilled)
+
+	s, err := res.NewStatus()
+	if err == nil {
+		err = status.Bind(s)
+	}
+
+	return err
+}
+
+// parseTrap splits an error returned by a guest function into the trap
+// reason and the guest's stack trace.  It returns false if err does not
+// report a trap.  Panics in host functions are not traps.
+func parseTrap(err error) (reason string, stack []string, ok bool) {
+	const (
+		prefix = "wasm error: "
+		trace  = "\nwasm stack trace:\n\t"
+	)
+
+	msg := err.Error()
+	if !strings.HasPrefix(msg, prefix) {
+		return "", nil, false
+	}
+
+	reason, frames, _ := strings.Cut(strings.TrimPrefix(msg, prefix), trace)
+	if frames != "" {
+		stack = strings.Split(frames, "\n\t")
 	}
 
-	return nil
+	return reason, stack, true
 }
@@ -0,0 +1,159 @@
+package csp_server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/csp"
+)
+
+func TestProcess_Kill(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Immediate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		proc, release := ex.Exec(ctx, core.Session{}, loopModule(false), 0, csp.Limits{}, nil)
+		defer release()
+
+		require.NoError(t, proc.Kill(ctx), "should kill process")
+		assert.ErrorIs(t, proc.Wait(ctx), csp.ErrKilled,
+			"should report that process was killed")
+	})
+
+	t.Run("GraceExpired", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		// The loop ignores the termination request.
+		proc, release := ex.Exec(ctx, core.Session{}, loopModule(false), 0, csp.Limits{}, nil)
+		defer release()
+
+		start := time.Now()
+		require.NoError(t, proc.Terminate(ctx, 20*time.Millisecond),
+			"should return once process has exited")
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond,
+			"should wait for grace period")
+		assert.ErrorIs(t, proc.Wait(ctx), csp.ErrKilled,
+			"should report that process was killed")
+	})
+
+	t.Run("ExitWithinGrace", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		// The process exits on its own when its timeout expires.
+		proc, release := ex.Exec(ctx, core.Session{}, loopModule(false), 0, csp.Limits{
+			Timeout: 20 * time.Millisecond,
+		}, nil)
+		defer release()
+
+		start := time.Now()
+		require.NoError(t, proc.Terminate(ctx, time.Minute),
+			"should return once process has exited")
+		assert.Less(t, time.Since(start), time.Minute,
+			"should not wait for grace period")
+
+		err := proc.Wait(ctx)
+		assert.Error(t, err, "should report limit exceeded")
+		assert.NotErrorIs(t, err, csp.ErrKilled,
+			"should not report that process was killed")
+	})
+}
+
+func TestProcess_Wait(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Exit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		proc, release := ex.Exec(ctx, core.Session{}, memoryModule(1), 0, csp.Limits{}, nil)
+		defer release()
+
+		assert.NoError(t, proc.Wait(ctx), "should exit cleanly")
+	})
+
+	t.Run("Trap", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		proc, release := ex.Exec(ctx, core.Session{}, trapModule(), 0, csp.Limits{}, nil)
+		defer release()
+
+		var trap *csp.TrapError
+		require.ErrorAs(t, proc.Wait(ctx), &trap, "should report trap")
+		assert.Equal(t, "unreachable", trap.Reason)
+		assert.NotEmpty(t, trap.Stack, "should report stack trace")
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		proc, release := ex.Exec(ctx, core.Session{}, loopModule(false), 0, csp.Limits{Calls: 100}, nil)
+		defer release()
+
+		assert.ErrorIs(t, proc.Wait(ctx), csp.ErrLimitExceeded,
+			"should report that limits were exceeded")
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		proc, release := ex.Exec(ctx, core.Session{}, loopModule(false), 0, csp.Limits{}, nil)
+		defer release()
+
+		// Every waiter observes the result, not just the first.
+		const waiters = 2
+		errs := make(chan error, waiters)
+		for i := 0; i < waiters; i++ {
+			go func() { errs <- proc.Wait(ctx) }()
+		}
+
+		require.NoError(t, proc.Kill(ctx), "should kill process")
+		for i := 0; i < waiters; i++ {
+			assert.ErrorIs(t, <-errs, csp.ErrKilled,
+				"should report that process was killed")
+		}
+
+		assert.ErrorIs(t, proc.Wait(ctx), csp.ErrKilled,
+			"should report result after process has exited")
+	})
+
+	t.Run("HostError", func(t *testing.T) {
+		t.Parallel()
+
+		// Errors that are neither exits nor traps must not panic.
+		r := execResult{Err: errors.New("boom")}
+		assert.Equal(t, csp.ExitStatus{
+			Reason:  csp.ReasonHostError,
+			Message: "boom",
+		}, r.Status())
+		assert.Equal(t, &csp.HostError{Message: "boom"}, r.Status().Err())
+	})
+}
+
+// trapModule returns a WASM module whose _start function executes an
+// unreachable instruction.
+func trapModule() []byte {
+	return module(1,
+		section(0x03, 0x01, 0x00),                   // functions: 1 of type 0
+		section(0x0a, 0x01, 0x03, 0x00, 0x00, 0x0b)) // code: unreachable
+}
@@ -3,6 +3,7 @@ package csp_server
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"sync/atomic"
 
@@ -29,18 +30,21 @@ type ProcTree struct {
 	Map *sync.Map
 	// Mutex to ensure thread safety.
 	Mut *sync.RWMutex
+	// Events receives process lifecycle events.  Optional.
+	Events *ProcEvents
 }
 
 // NewProcTree is the default constuctor for ProcTree, but it may
 // also be maually constructed.
 func NewProcTree(ctx context.Context) ProcTree {
 	return ProcTree{
-		Ctx:  ctx,
-		PIDC: NewAtomicCounter(INIT_PID),
-		TPC:  NewAtomicCounter(1),
-		Root: &ProcNode{Pid: INIT_PID},
-		Map:  &sync.Map{},
-		Mut:  &sync.RWMutex{},
+		Ctx:    ctx,
+		PIDC:   NewAtomicCounter(INIT_PID),
+		TPC:    NewAtomicCounter(1),
+		Root:   &ProcNode{Pid: INIT_PID},
+		Map:    &sync.Map{},
+		Mut:    &sync.RWMutex{},
+		Events: &ProcEvents{},
 	}
 }
 
@@ -114,7 +118,8 @@ func stop(ctx context.Context, p api.Process_Server) {
 	// thus we must avoid infinite recursivity. The process is
 	// killed with p.cancel() instead.
 	if ps, ok := p.(*process); ok {
-		fmt.Printf("killing process %d\n", p.(*process).pid)
+		slog.Debug("killing process", "pid", ps.pid)
+		ps.killed.Store(true)
 		ps.cancel()
 	} else {
 		// Generic implementation.
@@ -24,6 +24,10 @@ func (p *testProc) Wait(ctx context.Context, call api.Process_wait) error {
 	return nil
 }
 
+func (p *testProc) Stdout(context.Context, api.Process_stdout) error { return nil }
+func (p *testProc) Stderr(context.Context, api.Process_stderr) error { return nil }
+func (p *testProc) Stdin(context.Context, api.Process_stdin) error   { return nil }
+
 func testProcTree() csp.ProcTree {
 	/*
 	        0
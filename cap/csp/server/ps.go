@@ -0,0 +1,80 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	core_api "github.com/wetware/pkg/api/core"
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/util/watch"
+)
+
+// Ps returns the processes that are running in the executor.
+func (r Runtime) Ps(ctx context.Context, call core_api.Executor_ps) error {
+	procs := r.ps()
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	infos, err := res.NewProcs(int32(len(procs)))
+	if err != nil {
+		return err
+	}
+
+	for i, info := range procs {
+		if err = info.Bind(infos.At(i)); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+// ps returns the processes in the process table, ordered by pid.
+func (r Runtime) ps() []csp.ProcInfo {
+	var procs []csp.ProcInfo
+	r.Tree.Map.Range(func(_, v any) bool {
+		if p, ok := v.(*process); ok {
+			procs = append(procs, p.Info())
+		}
+
+		return true
+	})
+
+	sort.Slice(procs, func(i, j int) bool {
+		return procs[i].Pid < procs[j].Pid
+	})
+
+	return procs
+}
+
+// Watch streams process lifecycle events to the handler until the call
+// is canceled, or until the handler falls too far behind.
+func (r Runtime) Watch(ctx context.Context, call core_api.Executor_watch) error {
+	if r.Tree.Events == nil {
+		return errors.New("process events unavailable")
+	}
+
+	events, cancel := r.Tree.Events.Subscribe(watch.Buffer)
+	defer cancel()
+
+	return watch.Serve(ctx, call, call.Args().Handler(), events, event)
+}
+
+func event(ev csp.ProcEvent) func(api.EventHandler_recv_Params) error {
+	return func(ps api.EventHandler_recv_Params) error {
+		e, err := ps.NewEvent()
+		if err == nil {
+			err = ev.Bind(e)
+		}
+
+		return err
+	}
+}
+
+// ProcEvents is the set of channels receiving process events.
+type ProcEvents = watch.Events[csp.ProcEvent]
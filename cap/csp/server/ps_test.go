@@ -0,0 +1,73 @@
+package csp_server
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/rom"
+)
+
+func TestRuntime_Ps(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true))
+	defer r.Close(context.Background())
+
+	ex := Runtime{
+		Runtime: r,
+		Cache:   new(BytecodeCache),
+		Tree:    NewProcTree(ctx),
+		Log:     slog.Default(),
+	}.Executor()
+	defer ex.Release()
+
+	w, release := ex.Watch(ctx)
+	defer release()
+
+	bc := loopModule(false)
+	// Calls are only counted for processes with a call limit.
+	proc, release := ex.Exec(ctx, core.Session{}, bc, 0, csp.Limits{
+		Calls: math.MaxUint64,
+	}, nil, "loop")
+	defer release()
+
+	ev, ok := w.Next()
+	require.True(t, ok, "should receive spawn event")
+	assert.Equal(t, csp.Spawn, ev.Type)
+	assert.Equal(t, []string{"loop"}, ev.Info.Args)
+
+	procs, err := ex.Ps(ctx)
+	require.NoError(t, err, "should list processes")
+	require.Len(t, procs, 1, "should list running process")
+	assert.Equal(t, ev.Info.Pid, procs[0].Pid)
+	assert.Equal(t, uint32(INIT_PID), procs[0].Ppid)
+	assert.True(t, procs[0].Cid.Equals(rom.ROM{Bytecode: bc}.CID()), "should report CID")
+	assert.Equal(t, csp.Running, procs[0].State)
+	assert.Equal(t, uint64(pageSize), procs[0].Memory)
+	assert.NotZero(t, procs[0].Start, "should report start time")
+
+	require.NoError(t, proc.Kill(ctx), "should kill process")
+
+	ev, ok = w.Next()
+	require.True(t, ok, "should receive exit event")
+	assert.Equal(t, csp.Exit, ev.Type)
+	assert.Equal(t, csp.Exited, ev.Info.State)
+	assert.NotZero(t, ev.Info.Calls, "should report calls made")
+
+	procs, err = ex.Ps(ctx)
+	require.NoError(t, err, "should list processes")
+	assert.Empty(t, procs, "should remove exited process")
+}
@@ -0,0 +1,172 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/system"
+)
+
+// outputBuffer is the number of bytes of output that a process retains
+// for each of stdout and stderr.
+const outputBuffer = 64 * 1024
+
+// maxChunk is the maximum number of bytes sent by a single write to a
+// remote Process.Writer.
+const maxChunk = 16 * 1024
+
+// ErrStdinBound is returned by Process.stdin when the process' standard
+// input carries the system socket.
+var ErrStdinBound = errors.New("stdin is bound to the system socket")
+
+// output is a bounded buffer for a process' stdout or stderr.  Writes
+// never block the process;  if the buffer is full, the oldest output
+// is discarded.
+//
+// Reading does not consume output, so that concurrent streams each
+// receive all of it.  Each stream tracks its own offset, and starts
+// with the oldest output that is retained.  A stream that falls more
+// than outputBuffer bytes behind skips the output that was discarded.
+//
+// The zero-value output is ready to use.
+type output struct {
+	mu      sync.Mutex
+	buf     []byte // the last outputBuffer bytes of output
+	off     int64  // offset of buf[0] in the output
+	closed  bool
+	changed cond // broadcast on write and close
+}
+
+func newOutput() *output {
+	return new(output)
+}
+
+func (o *output) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	n := len(p)
+	if len(p) > outputBuffer {
+		o.off += int64(len(p) - outputBuffer)
+		p = p[len(p)-outputBuffer:]
+	}
+
+	if overflow := len(o.buf) + len(p) - outputBuffer; overflow > 0 {
+		o.buf = o.buf[overflow:]
+		o.off += int64(overflow)
+	}
+	o.buf = append(o.buf, p...)
+	o.changed.broadcast()
+
+	return n, nil
+}
+
+// Close the output, signaling EOF to readers once they have read the
+// buffer.  Close is idempotent.
+func (o *output) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !o.closed {
+		o.closed = true
+		o.changed.broadcast()
+	}
+
+	return nil
+}
+
+// next returns the chunk of output that follows offset pos, along with
+// the offset at which the chunk ends.  It blocks until output is
+// available, and returns io.EOF when the output is closed and pos is
+// at its end.
+func (o *output) next(ctx context.Context, pos int64) ([]byte, int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for {
+		// Skip output that was discarded before pos was read.
+		pos = max(pos, o.off)
+
+		if i := int(pos - o.off); i < len(o.buf) {
+			chunk := make([]byte, min(len(o.buf)-i, maxChunk))
+			copy(chunk, o.buf[i:])
+			return chunk, pos + int64(len(chunk)), nil
+		}
+
+		if o.closed {
+			return nil, pos, io.EOF
+		}
+
+		// wait for output; temporarily unlocks mu
+		if err := o.changed.wait(ctx, &o.mu); err != nil {
+			return nil, pos, err
+		}
+	}
+}
+
+// Stream the output to w until it is closed, and w has received all of
+// it.
+func (o *output) Stream(ctx context.Context, w api.Process_Writer) error {
+	var (
+		chunk []byte
+		pos   int64
+		err   error
+	)
+
+	for err == nil {
+		if chunk, pos, err = o.next(ctx, pos); err == nil {
+			err = w.Write(ctx, data(chunk))
+		}
+	}
+
+	if e := w.WaitStreaming(); e != nil {
+		return e
+	}
+
+	if err == io.EOF {
+		err = nil
+	}
+
+	return err
+}
+
+func data(b []byte) func(api.Process_Writer_write_Params) error {
+	return func(ps api.Process_Writer_write_Params) error {
+		return ps.SetData(b)
+	}
+}
+
+// input implements the Process.Writer capability that feeds a process'
+// stdin.
+type input struct{ w *io.PipeWriter }
+
+func (in input) Write(ctx context.Context, call api.Process_Writer_write) error {
+	b, err := call.Args().Data()
+	if err == nil {
+		// Blocks until the process has consumed b, or exited.
+		_, err = in.w.Write(b)
+	}
+
+	return err
+}
+
+func (in input) Close(ctx context.Context, call api.Process_Writer_close) error {
+	return in.w.Close()
+}
+
+// importsSystem reports whether the module imports the system host
+// module, in which case its stdin carries the system socket.
+func importsSystem(compiled wazero.CompiledModule) bool {
+	for _, def := range compiled.ImportedFunctions() {
+		if mod, _, _ := def.Import(); mod == system.HostModule.Name() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,126 @@
+package csp_server
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("EOF", func(t *testing.T) {
+		t.Parallel()
+
+		out := newOutput()
+		_, err := io.WriteString(out, "hello, ")
+		require.NoError(t, err)
+		_, err = io.WriteString(out, "world!")
+		require.NoError(t, err)
+		out.Close()
+
+		assert.Equal(t, "hello, world!", drain(t, out),
+			"should read buffered output before EOF")
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		t.Parallel()
+
+		out := newOutput()
+		io.WriteString(out, "lost")
+		io.WriteString(out, strings.Repeat("a", outputBuffer-1))
+		io.WriteString(out, "b")
+		out.Close()
+
+		got := drain(t, out)
+		assert.Len(t, got, outputBuffer, "should bound buffered output")
+		assert.True(t, strings.HasSuffix(got, "ab"),
+			"should discard oldest output")
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		_, _, err := newOutput().next(ctx, 0)
+		assert.ErrorIs(t, err, context.DeadlineExceeded,
+			"should block until output is available")
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		out := newOutput()
+
+		// Each reader receives all of the output, regardless of how
+		// the writes interleave with the reads.
+		const readers = 4
+		got := make(chan string, readers)
+		for i := 0; i < readers; i++ {
+			go func() { got <- drain(t, out) }()
+		}
+
+		want := strings.Repeat("a", maxChunk+1) + "b"
+		io.WriteString(out, want[:maxChunk])
+		io.WriteString(out, want[maxChunk:])
+		out.Close()
+
+		for i := 0; i < readers; i++ {
+			assert.Equal(t, want, <-got, "should deliver all output to each reader")
+		}
+	})
+
+	t.Run("Lagging", func(t *testing.T) {
+		t.Parallel()
+
+		out := newOutput()
+		io.WriteString(out, "lost")
+
+		chunk, pos, err := out.next(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, "lost", string(chunk))
+
+		// The reader falls behind, so some output is discarded before
+		// it is read.
+		io.WriteString(out, strings.Repeat("a", outputBuffer))
+		io.WriteString(out, "b")
+		out.Close()
+
+		chunk, _, err = out.next(context.Background(), pos)
+		require.NoError(t, err)
+		assert.Equal(t, "a", string(chunk[:1]),
+			"should skip discarded output")
+
+		got := drain(t, out)
+		assert.Len(t, got, outputBuffer, "should bound buffered output")
+		assert.True(t, strings.HasSuffix(got, "ab"),
+			"should retain newest output")
+	})
+}
+
+func drain(t *testing.T, out *output) string {
+	t.Helper()
+
+	var (
+		b   strings.Builder
+		pos int64
+	)
+	for {
+		chunk, next, err := out.next(context.Background(), pos)
+		if err == io.EOF {
+			return b.String()
+		}
+		require.NoError(t, err, "must read output")
+		require.LessOrEqual(t, len(chunk), maxChunk, "chunk must not exceed maxChunk")
+		b.Write(chunk)
+		pos = next
+	}
+}
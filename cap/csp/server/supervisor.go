@@ -0,0 +1,425 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multibase"
+
+	core_api "github.com/wetware/pkg/api/core"
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/util/watch"
+)
+
+// ErrStopped is returned by a supervisor that has been stopped, or
+// that has given up restarting its children.
+var ErrStopped = errors.New("supervisor stopped")
+
+// Supervise returns a supervisor whose children are spawned by the
+// runtime, as children of ppid in the process tree.
+func (r Runtime) Supervise(ctx context.Context, call core_api.Executor_supervise) error {
+	sess, err := call.Args().Session()
+	if err != nil {
+		return err
+	}
+
+	c, err := call.Args().Config()
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	s := r.supervise(auth.Session(sess).Clone(), call.Args().Ppid(),
+		csp.DecodeSupervisorConfig(c).WithDefaults())
+	return res.SetSupervisor(api.Supervisor_ServerToClient(s))
+}
+
+// supervise starts a supervisor.  It takes ownership of sess.
+func (r Runtime) supervise(sess auth.Session, ppid uint32, config csp.SupervisorConfig) *supervisor {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &supervisor{
+		r:      r,
+		sess:   sess,
+		ppid:   ppid,
+		config: config,
+		ctx:    ctx,
+		cancel: cancel,
+		exits:  make(chan childExit),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+
+	return s
+}
+
+// supervisor implements the Supervisor capability.  It restarts its
+// children as they exit, according to their restart policies.
+type supervisor struct {
+	r      Runtime
+	sess   auth.Session // cloned for each child process
+	ppid   uint32
+	config csp.SupervisorConfig
+
+	ctx    context.Context // canceled when the supervisor stops
+	cancel context.CancelFunc
+	exits  chan childExit
+	done   chan struct{} // closed when the children have been killed
+	events watch.Events[csp.SupervisorEvent]
+
+	mu       sync.Mutex
+	children []*child    // indexed by child id
+	restarts []time.Time // within the window, oldest first
+	stopped  bool
+}
+
+// child is a member of a supervisor's group.
+type child struct {
+	id   uint32
+	cid  cid.Cid
+	spec csp.ChildSpec // capabilities are owned by the supervisor
+	gen  uint64        // incremented each time the child is started or killed
+	proc *process      // nil if the child is not running
+}
+
+// childExit reports that generation gen of a child has exited.  Exits
+// of earlier generations are stale, and are ignored.
+type childExit struct {
+	id  uint32
+	gen uint64
+	err error
+}
+
+func (s *supervisor) Shutdown() {
+	s.cancel()
+}
+
+func (s *supervisor) Add(ctx context.Context, call api.Supervisor_add) error {
+	spec, err := call.Args().Spec()
+	if err != nil {
+		return err
+	}
+
+	cs, err := csp.DecodeChildSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	id, err := s.add(ctx, cs)
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err == nil {
+		res.SetId(id)
+	}
+
+	return err
+}
+
+// add a child to the group, and start it.  It takes ownership of the
+// capabilities in spec.
+func (s *supervisor) add(ctx context.Context, spec csp.ChildSpec) (uint32, error) {
+	if err := checkCapNames(spec.Caps); err != nil {
+		csp.ReleaseCaps(spec.Caps)
+		return 0, err
+	}
+
+	// Cache the bytecode, so that restarts can reuse compiled modules.
+	id, err := s.r.Cache.put(ctx, spec.Bytecode)
+	if err != nil {
+		s.r.Log.Warn("failed to store bytecode",
+			"cid", id.Encode(multibase.MustNewEncoder(multibase.Base58BTC)),
+			"error", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		csp.ReleaseCaps(spec.Caps)
+		return 0, ErrStopped
+	}
+
+	c := &child{
+		id:   uint32(len(s.children)),
+		cid:  id,
+		spec: spec,
+	}
+
+	if err = s.start(ctx, c); err != nil {
+		csp.ReleaseCaps(spec.Caps)
+		return 0, err
+	}
+	s.children = append(s.children, c)
+
+	return c.id, nil
+}
+
+func (s *supervisor) Stop(ctx context.Context, call api.Supervisor_stop) error {
+	s.cancel()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Watch streams supervision events to the handler until the call is
+// canceled, the supervisor stops, or the handler falls too far behind.
+func (s *supervisor) Watch(ctx context.Context, call api.Supervisor_watch) error {
+	events, cancel := s.events.Subscribe(watch.Buffer)
+	defer cancel()
+
+	return watch.ServeUntil(ctx, call, call.Args().Handler(), events, supervisorEvent, s.done)
+}
+
+func supervisorEvent(ev csp.SupervisorEvent) func(api.Supervisor_Handler_recv_Params) error {
+	return func(ps api.Supervisor_Handler_recv_Params) error {
+		e, err := ps.NewEvent()
+		if err == nil {
+			err = ev.Bind(e)
+		}
+
+		return err
+	}
+}
+
+// run handles child exits until the supervisor stops or gives up, and
+// then kills the children.
+func (s *supervisor) run() {
+	defer close(s.done)
+	defer s.sess.Logout()
+	defer s.stop()
+
+	for {
+		select {
+		case e := <-s.exits:
+			if !s.handle(e) {
+				return
+			}
+
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// handle the exit of a child.  It returns false if the supervisor has
+// given up, or was stopped while waiting to restart children.
+func (s *supervisor) handle(e childExit) bool {
+	s.mu.Lock()
+	c := s.children[e.id]
+	if c.gen != e.gen {
+		s.mu.Unlock()
+		return true // killed by the supervisor
+	}
+
+	code := exitCode(e.err)
+	s.events.Publish(csp.SupervisorEvent{
+		Type:     csp.ChildExit,
+		Child:    c.id,
+		Pid:      c.pid(),
+		ExitCode: code,
+	})
+	c.proc = nil
+
+	if !c.spec.Restart.ShouldRestart(code) {
+		s.mu.Unlock()
+		return true
+	}
+
+	n, ok := s.allow(time.Now())
+	if !ok {
+		s.r.Log.Warn("supervisor gave up",
+			"ppid", s.ppid,
+			"child", c.id,
+			"restarts", n)
+		s.events.Publish(csp.SupervisorEvent{
+			Type:  csp.GiveUp,
+			Child: c.id,
+		})
+		s.mu.Unlock()
+		return false
+	}
+
+	group := s.group(c)
+	backoff := s.config.Backoff(n)
+	for _, g := range group {
+		s.events.Publish(csp.SupervisorEvent{
+			Type:    csp.ChildRestart,
+			Child:   g.id,
+			Backoff: backoff,
+		})
+	}
+	s.mu.Unlock()
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-s.ctx.Done():
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, g := range group {
+		if err := s.start(s.ctx, g); err != nil {
+			s.r.Log.Warn("failed to restart child",
+				"ppid", s.ppid,
+				"child", g.id,
+				"error", err)
+			s.fail(g, err)
+		}
+	}
+
+	return true
+}
+
+// group returns the children that are restarted along with c.  Under
+// the one-for-all strategy, running children are killed.  The caller
+// MUST hold the lock.
+func (s *supervisor) group(c *child) []*child {
+	if s.config.Strategy != csp.OneForAll {
+		return []*child{c}
+	}
+
+	group := []*child{c}
+	for _, other := range s.children {
+		if other != c && other.proc != nil {
+			s.kill(other)
+			group = append(group, other)
+		}
+	}
+
+	return group
+}
+
+// allow records a restart at time t, and returns the number of restarts
+// that preceded it within the window.  It returns false if the restart
+// would exceed the maximum.  The caller MUST hold the lock.
+func (s *supervisor) allow(t time.Time) (int, bool) {
+	cutoff := t.Add(-s.config.Window)
+
+	var i int
+	for i < len(s.restarts) && !s.restarts[i].After(cutoff) {
+		i++
+	}
+	s.restarts = s.restarts[i:]
+
+	n := len(s.restarts)
+	if n >= int(s.config.MaxRestarts) {
+		return n, false
+	}
+	s.restarts = append(s.restarts, t)
+
+	return n, true
+}
+
+// start a new generation of the child.  The caller MUST hold the lock.
+func (s *supervisor) start(ctx context.Context, c *child) error {
+	// Each process owns its references to the capabilities.
+	caps := make([]csp.Cap, len(c.spec.Caps))
+	for i, x := range c.spec.Caps {
+		caps[i] = csp.Cap{
+			Name:   x.Name,
+			Client: x.Client.AddRef(),
+		}
+	}
+
+	c.gen++
+	p, err := s.r.start(ctx, procSpec{
+		cid:      c.cid,
+		bytecode: c.spec.Bytecode,
+		session:  core_api.Session(s.sess),
+		ppid:     s.ppid,
+		argv:     c.spec.Args,
+		limits:   c.spec.Limits,
+		caps:     caps,
+	})
+	if err != nil {
+		return fmt.Errorf("child %d: %w", c.id, err)
+	}
+	c.proc = p
+
+	s.events.Publish(csp.SupervisorEvent{
+		Type:  csp.ChildStart,
+		Child: c.id,
+		Pid:   p.pid,
+	})
+
+	go s.monitor(c.id, c.gen, p)
+	return nil
+}
+
+// monitor reports the exit of the process to the supervisor.
+func (s *supervisor) monitor(id uint32, gen uint64, p *process) {
+	select {
+	case <-p.exit:
+		s.report(childExit{id: id, gen: gen, err: p.err})
+	case <-s.ctx.Done():
+	}
+}
+
+// fail reports a child that could not be started as having exited with
+// an error, so that it is subject to the restart limit.  The caller MUST
+// hold the lock.
+func (s *supervisor) fail(c *child, err error) {
+	go s.report(childExit{id: c.id, gen: c.gen, err: err})
+}
+
+func (s *supervisor) report(e childExit) {
+	select {
+	case s.exits <- e:
+	case <-s.ctx.Done():
+	}
+}
+
+// kill the child's process, if it is running.  The process' exit is
+// ignored.  The caller MUST hold the lock.
+func (s *supervisor) kill(c *child) {
+	c.gen++
+	if c.proc != nil {
+		c.proc.killFunc(c.proc.pid)
+		c.proc = nil
+	}
+}
+
+// stop kills the children and releases their capabilities.
+func (s *supervisor) stop() {
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopped = true
+	for _, c := range s.children {
+		s.kill(c)
+		csp.ReleaseCaps(c.spec.Caps)
+	}
+}
+
+// pid of the child's current process, or zero if it is not running.
+// The caller MUST hold the lock.
+func (c *child) pid() uint32 {
+	if c.proc != nil {
+		return c.proc.pid
+	}
+
+	return 0
+}
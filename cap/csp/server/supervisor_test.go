@@ -0,0 +1,183 @@
+package csp_server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/csp"
+)
+
+func TestSupervisor(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	// crash is a child that fails once it runs out of time.
+	crash := csp.ChildSpec{
+		Bytecode: loopModule(false),
+		Limits:   csp.Limits{Timeout: 10 * time.Millisecond},
+		Restart:  csp.RestartOnFailure,
+	}
+
+	t.Run("OnFailure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		s, release := ex.Supervise(ctx, core.Session{}, 0, csp.SupervisorConfig{
+			MaxRestarts: 2,
+			MinBackoff:  time.Millisecond,
+		})
+		defer release()
+
+		w, release := s.Watch(ctx)
+		defer release()
+
+		id, err := s.Add(ctx, crash)
+		require.NoError(t, err, "should add child")
+
+		var pids []uint32
+		for i := 0; i < 3; i++ {
+			ev := next(t, w, csp.ChildStart)
+			assert.Equal(t, id, ev.Child)
+			pids = append(pids, ev.Pid)
+
+			ev = next(t, w, csp.ChildExit)
+			assert.NotZero(t, ev.ExitCode, "should report failure")
+
+			if i < 2 {
+				ev = next(t, w, csp.ChildRestart)
+				assert.Equal(t, time.Millisecond<<i, ev.Backoff, "should back off exponentially")
+			}
+		}
+		assert.NotEqual(t, pids[0], pids[1], "should restart with new pid")
+
+		next(t, w, csp.GiveUp)
+
+		_, ok := w.Next()
+		assert.False(t, ok, "should stop when supervisor gives up")
+		assert.NoError(t, w.Err())
+
+		_, err = s.Add(ctx, crash)
+		assert.ErrorContains(t, err, ErrStopped.Error())
+	})
+
+	t.Run("Never", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		s, release := ex.Supervise(ctx, core.Session{}, 0, csp.SupervisorConfig{})
+		defer release()
+
+		w, release := s.Watch(ctx)
+		defer release()
+
+		spec := crash
+		spec.Restart = csp.RestartNever
+		_, err := s.Add(ctx, spec)
+		require.NoError(t, err, "should add child")
+
+		next(t, w, csp.ChildStart)
+		next(t, w, csp.ChildExit)
+
+		require.NoError(t, s.Stop(ctx), "should stop supervisor")
+
+		_, ok := w.Next()
+		assert.False(t, ok, "should not restart child")
+	})
+
+	t.Run("OneForAll", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, ex := testExecutor(t)
+
+		s, release := ex.Supervise(ctx, core.Session{}, 0, csp.SupervisorConfig{
+			Strategy:   csp.OneForAll,
+			MinBackoff: time.Millisecond,
+		})
+		defer release()
+
+		w, release := s.Watch(ctx)
+		defer release()
+
+		loop, err := s.Add(ctx, csp.ChildSpec{
+			Bytecode: loopModule(false),
+			Restart:  csp.RestartAlways,
+		})
+		require.NoError(t, err, "should add child")
+		first := next(t, w, csp.ChildStart).Pid
+
+		_, err = s.Add(ctx, crash)
+		require.NoError(t, err, "should add child")
+		next(t, w, csp.ChildStart)
+		next(t, w, csp.ChildExit)
+
+		restarted := map[uint32]bool{}
+		for i := 0; i < 2; i++ {
+			restarted[next(t, w, csp.ChildRestart).Child] = true
+		}
+		assert.True(t, restarted[loop], "should restart the whole group")
+
+		procs, err := ex.Ps(ctx)
+		require.NoError(t, err)
+		for _, p := range procs {
+			assert.NotEqual(t, first, p.Pid, "should kill running children")
+		}
+
+		require.NoError(t, s.Stop(ctx), "should stop supervisor")
+
+		procs, err = ex.Ps(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, procs, "should kill children when stopped")
+	})
+}
+
+func TestSupervisorConfig_Backoff(t *testing.T) {
+	t.Parallel()
+
+	c := csp.SupervisorConfig{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+	assert.Equal(t, time.Millisecond, c.Backoff(0))
+	assert.Equal(t, 4*time.Millisecond, c.Backoff(2))
+	assert.Equal(t, 5*time.Millisecond, c.Backoff(3), "should cap backoff")
+}
+
+func testExecutor(t *testing.T) (context.Context, csp.Executor) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true))
+	t.Cleanup(func() { r.Close(context.Background()) })
+
+	ex := Runtime{
+		Runtime: r,
+		Cache:   new(BytecodeCache),
+		Tree:    NewProcTree(ctx),
+		Log:     slog.Default(),
+	}.Executor()
+	t.Cleanup(ex.Release)
+
+	return ctx, ex
+}
+
+// next returns the next supervisor event, which must be of type want.
+func next(t *testing.T, w csp.SupervisorWatcher, want csp.SupervisorEventType) csp.SupervisorEvent {
+	t.Helper()
+
+	ev, ok := w.Next()
+	require.True(t, ok, "should receive %s event", want)
+	require.Equal(t, want, ev.Type)
+	return ev
+}
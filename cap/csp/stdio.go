@@ -0,0 +1,109 @@
+package csp
+
+import (
+	"context"
+	"io"
+
+	"capnproto.org/go/capnp/v3"
+
+	api "github.com/wetware/pkg/api/process"
+)
+
+// Stdout returns a reader that yields the process' standard output.
+// The reader returns io.EOF once the process has exited, and all of
+// its output has been read.  Callers MUST call the ReleaseFunc when
+// finished with the reader.
+func (p Proc) Stdout(ctx context.Context) (io.Reader, capnp.ReleaseFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	r, w := io.Pipe()
+
+	f, release := api.Process(p).Stdout(ctx, func(ps api.Process_stdout_Params) error {
+		return ps.SetWriter(api.Process_Writer_ServerToClient(sink{w}))
+	})
+	go closeOnReturn(f.Future, w)
+
+	return r, func() {
+		r.Close()
+		cancel()
+		release()
+	}
+}
+
+// Stderr is the same as Stdout, for the process' standard error.
+func (p Proc) Stderr(ctx context.Context) (io.Reader, capnp.ReleaseFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	r, w := io.Pipe()
+
+	f, release := api.Process(p).Stderr(ctx, func(ps api.Process_stderr_Params) error {
+		return ps.SetWriter(api.Process_Writer_ServerToClient(sink{w}))
+	})
+	go closeOnReturn(f.Future, w)
+
+	return r, func() {
+		r.Close()
+		cancel()
+		release()
+	}
+}
+
+// Stdin returns a writer that feeds the process' standard input.  The
+// process receives EOF when the writer is closed.  Writes are streamed,
+// so an error may be reported by a later call to Write or Close.  Callers
+// MUST call the ReleaseFunc when finished with the writer.
+func (p Proc) Stdin(ctx context.Context) (io.WriteCloser, capnp.ReleaseFunc) {
+	f, release := api.Process(p).Stdin(ctx, nil)
+	return stdin{ctx: ctx, w: f.Writer()}, release
+}
+
+// closeOnReturn closes w when the call returns, reporting any error
+// to the reader.
+func closeOnReturn(f *capnp.Future, w *io.PipeWriter) {
+	<-f.Done()
+	_, err := f.Struct()
+	w.CloseWithError(err) // io.EOF if err == nil
+}
+
+// sink implements the Process.Writer capability, copying the data it
+// receives to a pipe.
+type sink struct{ w *io.PipeWriter }
+
+func (s sink) Write(ctx context.Context, call api.Process_Writer_write) error {
+	b, err := call.Args().Data()
+	if err == nil {
+		_, err = s.w.Write(b) // blocks until b has been read
+	}
+
+	return err
+}
+
+func (s sink) Close(ctx context.Context, call api.Process_Writer_close) error {
+	return s.w.Close()
+}
+
+type stdin struct {
+	ctx context.Context
+	w   api.Process_Writer
+}
+
+func (in stdin) Write(p []byte) (int, error) {
+	err := in.w.Write(in.ctx, func(ps api.Process_Writer_write_Params) error {
+		return ps.SetData(p)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (in stdin) Close() error {
+	if err := in.w.WaitStreaming(); err != nil {
+		return err
+	}
+
+	f, release := in.w.Close(in.ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
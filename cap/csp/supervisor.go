@@ -0,0 +1,439 @@
+package csp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	capnp "capnproto.org/go/capnp/v3"
+
+	core_api "github.com/wetware/pkg/api/core"
+	api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/util/casm"
+	"github.com/wetware/pkg/util/watch"
+)
+
+// Default supervisor parameters.  They apply to zero-valued fields of
+// a SupervisorConfig.
+const (
+	DefaultMaxRestarts   = 3
+	DefaultRestartWindow = 5 * time.Second
+	DefaultMinBackoff    = 100 * time.Millisecond
+	DefaultMaxBackoff    = 10 * time.Second
+)
+
+// Strategy determines which children a supervisor restarts when one
+// of them exits.
+type Strategy uint8
+
+const (
+	OneForOne Strategy = iota // restart the child that exited
+	OneForAll                 // restart every child in the group
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case OneForOne:
+		return "one-for-one"
+	case OneForAll:
+		return "one-for-all"
+	}
+
+	return fmt.Sprintf("<unknown strategy %d>", s)
+}
+
+// RestartPolicy determines whether a child is restarted when it exits.
+type RestartPolicy uint8
+
+const (
+	RestartNever     RestartPolicy = iota // never restart the child
+	RestartOnFailure                      // restart on a non-zero exit code
+	RestartAlways                         // always restart the child
+)
+
+func (p RestartPolicy) String() string {
+	switch p {
+	case RestartNever:
+		return "never"
+	case RestartOnFailure:
+		return "on-failure"
+	case RestartAlways:
+		return "always"
+	}
+
+	return fmt.Sprintf("<unknown restart policy %d>", p)
+}
+
+// ShouldRestart reports whether a child that exited with the given
+// code is restarted under the policy.
+func (p RestartPolicy) ShouldRestart(code uint32) bool {
+	switch p {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return code != 0
+	}
+
+	return false
+}
+
+// SupervisorConfig parameterizes a supervisor.  Zero-valued fields are
+// replaced by the corresponding defaults.
+type SupervisorConfig struct {
+	Strategy Strategy
+
+	// MaxRestarts is the number of restarts allowed within Window.
+	// The supervisor gives up and kills its children when the limit
+	// is exceeded.
+	MaxRestarts uint32
+	Window      time.Duration
+
+	// Restarts are delayed by MinBackoff, doubling for each restart
+	// within Window, up to MaxBackoff.
+	MinBackoff, MaxBackoff time.Duration
+}
+
+// DecodeSupervisorConfig reads the configuration from the capnp struct.
+// It returns the zero-value configuration if c is null.
+func DecodeSupervisorConfig(c api.Supervisor_Config) SupervisorConfig {
+	return SupervisorConfig{
+		Strategy:    Strategy(c.Strategy()),
+		MaxRestarts: c.MaxRestarts(),
+		Window:      time.Duration(c.Window()) * time.Millisecond,
+		MinBackoff:  time.Duration(c.MinBackoff()) * time.Millisecond,
+		MaxBackoff:  time.Duration(c.MaxBackoff()) * time.Millisecond,
+	}
+}
+
+// Bind the configuration to the capnp struct.
+func (c SupervisorConfig) Bind(target api.Supervisor_Config) {
+	target.SetStrategy(api.Supervisor_Strategy(c.Strategy))
+	target.SetMaxRestarts(c.MaxRestarts)
+	target.SetWindow(uint64(c.Window / time.Millisecond))
+	target.SetMinBackoff(uint64(c.MinBackoff / time.Millisecond))
+	target.SetMaxBackoff(uint64(c.MaxBackoff / time.Millisecond))
+}
+
+// WithDefaults returns a copy of c, with zero-valued fields set to
+// their defaults.
+func (c SupervisorConfig) WithDefaults() SupervisorConfig {
+	if c.MaxRestarts == 0 {
+		c.MaxRestarts = DefaultMaxRestarts
+	}
+
+	if c.Window == 0 {
+		c.Window = DefaultRestartWindow
+	}
+
+	if c.MinBackoff == 0 {
+		c.MinBackoff = DefaultMinBackoff
+	}
+
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = DefaultMaxBackoff
+	}
+
+	return c
+}
+
+// Backoff returns the delay before a restart, given the number of
+// restarts that preceded it within the window.
+func (c SupervisorConfig) Backoff(restarts int) time.Duration {
+	d := c.MinBackoff
+	for i := 0; i < restarts && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, c.MaxBackoff)
+}
+
+// ChildSpec describes a child of a supervisor.
+type ChildSpec struct {
+	Bytecode []byte
+	Args     []string
+	Limits   Limits
+	Caps     []Cap
+	Restart  RestartPolicy
+}
+
+// DecodeChildSpec reads the child specification from the capnp struct.
+// The caller MUST release the returned capabilities.
+func DecodeChildSpec(s api.Supervisor_Spec) (ChildSpec, error) {
+	bc, err := s.Bytecode()
+	if err != nil {
+		return ChildSpec{}, err
+	}
+
+	argl, err := s.Args()
+	if err != nil {
+		return ChildSpec{}, err
+	}
+	args, err := DecodeTextList(argl)
+	if err != nil {
+		return ChildSpec{}, err
+	}
+
+	l, err := s.Limits()
+	if err != nil {
+		return ChildSpec{}, err
+	}
+
+	capl, err := s.Caps()
+	if err != nil {
+		return ChildSpec{}, err
+	}
+	caps, err := DecodeCaps(capl)
+	if err != nil {
+		return ChildSpec{}, err
+	}
+
+	return ChildSpec{
+		Bytecode: bc,
+		Args:     args,
+		Limits:   DecodeLimits(l),
+		Caps:     caps,
+		Restart:  RestartPolicy(s.Restart()),
+	}, nil
+}
+
+// Bind the child specification to the capnp struct.
+func (s ChildSpec) Bind(target api.Supervisor_Spec) error {
+	target.SetRestart(api.Supervisor_Restart(s.Restart))
+
+	if err := target.SetBytecode(s.Bytecode); err != nil {
+		return err
+	}
+
+	args, err := EncodeTextList(s.Args)
+	if err != nil {
+		return err
+	}
+	if err = target.SetArgs(args); err != nil {
+		return err
+	}
+
+	l, err := target.NewLimits()
+	if err != nil {
+		return err
+	}
+	s.Limits.Bind(l)
+
+	if len(s.Caps) > 0 {
+		cl, err := target.NewCaps(int32(len(s.Caps)))
+		if err != nil {
+			return err
+		}
+
+		return BindCaps(cl, s.Caps)
+	}
+
+	return nil
+}
+
+// SupervisorEventType designates a change in the state of a supervised
+// child.
+type SupervisorEventType uint8
+
+const (
+	ChildStart   SupervisorEventType = iota // child was started
+	ChildExit                               // child exited
+	ChildRestart                            // child will be restarted
+	GiveUp                                  // restart limit exceeded
+)
+
+func (t SupervisorEventType) String() string {
+	switch t {
+	case ChildStart:
+		return "start"
+	case ChildExit:
+		return "exit"
+	case ChildRestart:
+		return "restart"
+	case GiveUp:
+		return "give-up"
+	}
+
+	return fmt.Sprintf("<unknown event type %d>", t)
+}
+
+// SupervisorEvent is emitted by a supervisor.  Child identifies the
+// child across restarts, and Pid is the pid of its current process.
+// ExitCode is only meaningful for ChildExit events, and Backoff for
+// ChildRestart events.
+type SupervisorEvent struct {
+	Type     SupervisorEventType
+	Child    uint32
+	Pid      uint32
+	ExitCode uint32
+	Backoff  time.Duration
+}
+
+// DecodeSupervisorEvent reads a supervisor event from the capnp struct.
+func DecodeSupervisorEvent(e api.Supervisor_Event) (ev SupervisorEvent, err error) {
+	switch e.Which() {
+	case api.Supervisor_Event_Which_start:
+		ev.Type = ChildStart
+	case api.Supervisor_Event_Which_exit:
+		ev.Type, ev.ExitCode = ChildExit, e.Exit()
+	case api.Supervisor_Event_Which_restart:
+		ev.Type = ChildRestart
+		ev.Backoff = time.Duration(e.Restart()) * time.Millisecond
+	case api.Supervisor_Event_Which_giveUp:
+		ev.Type = GiveUp
+	default:
+		return ev, fmt.Errorf("invalid event: %s", e.Which())
+	}
+
+	ev.Child, ev.Pid = e.Child(), e.Pid()
+	return
+}
+
+// Bind the event to the capnp struct.
+func (ev SupervisorEvent) Bind(target api.Supervisor_Event) error {
+	switch ev.Type {
+	case ChildStart:
+		target.SetStart()
+	case ChildExit:
+		target.SetExit(ev.ExitCode)
+	case ChildRestart:
+		target.SetRestart(uint64(ev.Backoff / time.Millisecond))
+	case GiveUp:
+		target.SetGiveUp()
+	default:
+		return fmt.Errorf("invalid event type: %s", ev.Type)
+	}
+
+	target.SetChild(ev.Child)
+	target.SetPid(ev.Pid)
+	return nil
+}
+
+// Supervise returns a supervisor whose children are spawned by the
+// executor as children of ppid.  Callers MUST call the ReleaseFunc
+// when finished with the supervisor.  Releasing the last reference
+// to the supervisor kills its children.
+func (ex Executor) Supervise(
+	ctx context.Context,
+	sess core_api.Session,
+	ppid uint32,
+	config SupervisorConfig,
+) (Supervisor, capnp.ReleaseFunc) {
+	f, release := core_api.Executor(ex).Supervise(ctx,
+		func(ps core_api.Executor_supervise_Params) error {
+			c, err := ps.NewConfig()
+			if err != nil {
+				return err
+			}
+			config.Bind(c)
+
+			ps.SetPpid(ppid)
+			return ps.SetSession(sess)
+		})
+	return Supervisor(f.Supervisor()), release
+}
+
+// Supervisor runs a group of child processes, and restarts them
+// according to their restart policies.
+type Supervisor api.Supervisor
+
+func (s Supervisor) AddRef() Supervisor {
+	return Supervisor(api.Supervisor(s).AddRef())
+}
+
+func (s Supervisor) Release() {
+	capnp.Client(s).Release()
+}
+
+// Add a child to the group and start it.  The returned id identifies
+// the child in supervisor events.
+func (s Supervisor) Add(ctx context.Context, spec ChildSpec) (uint32, error) {
+	f, release := api.Supervisor(s).Add(ctx, func(ps api.Supervisor_add_Params) error {
+		target, err := ps.NewSpec()
+		if err == nil {
+			err = spec.Bind(target)
+		}
+
+		return err
+	})
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Id(), nil
+}
+
+// Stop kills the children and stops restarting them.
+func (s Supervisor) Stop(ctx context.Context) error {
+	f, release := api.Supervisor(s).Stop(ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Watch returns a watcher that receives supervision events as they
+// occur.  Callers MUST call the provided ReleaseFunc when finished
+// with the watcher, or a resource leak will occur.
+func (s Supervisor) Watch(ctx context.Context) (SupervisorWatcher, capnp.ReleaseFunc) {
+	// See Executor.Watch.
+	if !api.Supervisor(s).IsValid() {
+		f, release := api.Supervisor(s).Watch(ctx, nil)
+		return SupervisorWatcher{Future: casm.Future(f)}, release
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		h          = supervisorHandler{make(watch.Handler[SupervisorEvent], 16)}
+		f, release = api.Supervisor(s).Watch(ctx, h.Params)
+	)
+
+	return SupervisorWatcher{
+		Future: casm.Future(f),
+		Seq:    h,
+	}, func() {
+		cancel()
+		release()
+	}
+}
+
+// SupervisorWatcher is a stateful iterator over a stream of supervisor
+// events.  See Supervisor.Watch.
+type SupervisorWatcher casm.Iterator[SupervisorEvent]
+
+// Next blocks until the next event is received, and returns it.  The
+// boolean is false when the watcher has been exhausted, in which case
+// callers SHOULD check Err().
+func (w SupervisorWatcher) Next() (SupervisorEvent, bool) {
+	return casm.Iterator[SupervisorEvent](w).Next()
+}
+
+// Err returns the first non-nil error encountered by the watcher.
+// If there is no error, Err() returns nil.
+func (w SupervisorWatcher) Err() error {
+	return casm.Iterator[SupervisorEvent](w).Err()
+}
+
+// supervisorHandler receives events from the supervisor.
+type supervisorHandler struct{ watch.Handler[SupervisorEvent] }
+
+func (h supervisorHandler) Params(ps api.Supervisor_watch_Params) error {
+	return ps.SetHandler(api.Supervisor_Handler_ServerToClient(h))
+}
+
+func (h supervisorHandler) Recv(ctx context.Context, call api.Supervisor_Handler_recv) error {
+	e, err := call.Args().Event()
+	if err != nil {
+		return err
+	}
+
+	ev, err := DecodeSupervisorEvent(e)
+	if err != nil {
+		return err
+	}
+
+	return h.Send(ctx, ev)
+}
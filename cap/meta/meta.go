@@ -0,0 +1,141 @@
+// Package meta provides a capability for updating the metadata that
+// a host advertises in its cluster heartbeats.
+package meta
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+
+	api "github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+type Meta api.Meta
+
+func (m Meta) AddRef() Meta {
+	return Meta(api.Meta(m).AddRef())
+}
+
+func (m Meta) Release() {
+	api.Meta(m).Release()
+}
+
+// Get the metadata fields that the host currently advertises.
+func (m Meta) Get(ctx context.Context) ([]routing.MetaField, error) {
+	f, release := api.Meta(m).Get(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := res.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	return routing.Meta(fields).Fields()
+}
+
+// Update atomically sets and unsets metadata fields, and triggers an
+// immediate heartbeat.  If a key is both set and unset, it is set.
+func (m Meta) Update(ctx context.Context, set []routing.MetaField, unset ...string) error {
+	f, release := api.Meta(m).Update(ctx, func(ps api.Meta_update_Params) error {
+		fields, err := ps.NewSet(int32(len(set)))
+		if err != nil {
+			return err
+		}
+
+		for i, field := range set {
+			if err = fields.Set(i, field.String()); err != nil {
+				return err
+			}
+		}
+
+		keys, err := ps.NewUnset(int32(len(unset)))
+		if err != nil {
+			return err
+		}
+
+		for i, key := range unset {
+			if err = keys.Set(i, key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Server exposes a Router's metadata as a capability.
+type Server struct {
+	Router interface {
+		UpdateMeta(context.Context, []routing.MetaField, []string) error
+		MetaFields() ([]routing.MetaField, error)
+	}
+}
+
+func (s Server) Client() capnp.Client {
+	return capnp.Client(s.Meta())
+}
+
+func (s Server) Meta() Meta {
+	return Meta(api.Meta_ServerToClient(s))
+}
+
+func (s Server) Get(ctx context.Context, call api.Meta_get) error {
+	fields, err := s.Router.MetaFields()
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	list, err := res.NewFields(int32(len(fields)))
+	if err != nil {
+		return err
+	}
+
+	for i, f := range fields {
+		if err = list.Set(i, f.String()); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func (s Server) Update(ctx context.Context, call api.Meta_update) error {
+	set, err := call.Args().Set()
+	if err != nil {
+		return err
+	}
+
+	fields, err := routing.Meta(set).Fields()
+	if err != nil {
+		return err
+	}
+
+	unset, err := call.Args().Unset()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, unset.Len())
+	for i := range keys {
+		if keys[i], err = unset.At(i); err != nil {
+			return err
+		}
+	}
+
+	return s.Router.UpdateMeta(ctx, fields, keys)
+}
@@ -0,0 +1,54 @@
+package meta_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cap/meta"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+func TestMeta(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	router := &router{fields: []routing.MetaField{{Key: "region", Value: "us-east"}}}
+	m := meta.Server{Router: router}.Meta()
+	defer m.Release()
+
+	fields, err := m.Get(ctx)
+	require.NoError(t, err, "should get fields")
+	assert.Equal(t, router.fields, fields)
+
+	err = m.Update(ctx,
+		[]routing.MetaField{{Key: "draining", Value: "true"}},
+		"region")
+	require.NoError(t, err, "should update fields")
+	assert.Equal(t, []routing.MetaField{{Key: "draining", Value: "true"}}, router.set)
+	assert.Equal(t, []string{"region"}, router.unset)
+
+	router.err = errors.New("test")
+	err = m.Update(ctx, nil)
+	assert.ErrorContains(t, err, "test", "should report router error")
+}
+
+type router struct {
+	fields []routing.MetaField
+	set    []routing.MetaField
+	unset  []string
+	err    error
+}
+
+func (r *router) UpdateMeta(_ context.Context, set []routing.MetaField, unset []string) error {
+	r.set, r.unset = set, unset
+	return r.err
+}
+
+func (r *router) MetaFields() ([]routing.MetaField, error) {
+	return r.fields, nil
+}
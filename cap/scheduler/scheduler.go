@@ -0,0 +1,123 @@
+// Package scheduler provides a capability that places processes on
+// the nodes of a cluster.
+package scheduler
+
+import (
+	"context"
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/ipfs/go-cid"
+
+	api "github.com/wetware/pkg/api/core"
+	proc_api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/view"
+)
+
+// ErrNoNode is returned when no node satisfies the placement, or when
+// every candidate left the cluster before the process could be placed.
+var ErrNoNode = errors.New("no node satisfies placement")
+
+// Scheduler places processes on cluster nodes.
+type Scheduler api.Scheduler
+
+func (s Scheduler) AddRef() Scheduler {
+	return Scheduler(api.Scheduler(s).AddRef())
+}
+
+func (s Scheduler) Release() {
+	capnp.Client(s).Release()
+}
+
+// Exec runs the bytecode on a node that satisfies the placement query.
+// A nil query selects every node.  See csp.Executor.Exec.
+func (s Scheduler) Exec(
+	ctx context.Context,
+	sess api.Session,
+	bc []byte,
+	placement view.Query,
+	limits csp.Limits,
+	caps []csp.Cap,
+	argv ...string,
+) (csp.Proc, capnp.ReleaseFunc) {
+	f, release := api.Scheduler(s).Exec(ctx,
+		func(ps api.Scheduler_exec_Params) error {
+			if err := ps.SetBytecode(bc); err != nil {
+				return err
+			}
+
+			return bind(ps, sess, placement, limits, caps, argv)
+		})
+	return csp.Proc(f.Process()), release
+}
+
+// ExecCached is the same as Exec, for bytecode that is identified by
+// its CID.  See csp.Executor.ExecCached.
+func (s Scheduler) ExecCached(
+	ctx context.Context,
+	sess api.Session,
+	id cid.Cid,
+	placement view.Query,
+	limits csp.Limits,
+	caps []csp.Cap,
+	argv ...string,
+) (csp.Proc, capnp.ReleaseFunc) {
+	f, release := api.Scheduler(s).ExecCached(ctx,
+		func(ps api.Scheduler_execCached_Params) error {
+			if err := ps.SetCid(id.Bytes()); err != nil {
+				return err
+			}
+
+			return bind(ps, sess, placement, limits, caps, argv)
+		})
+	return csp.Proc(f.Process()), release
+}
+
+type params interface {
+	SetSession(api.Session) error
+	SetArgs(capnp.TextList) error
+	NewLimits() (proc_api.Limits, error)
+	NewCaps(int32) (proc_api.Cap_List, error)
+	NewPlacement() (api.Scheduler_Placement, error)
+}
+
+func bind(ps params, sess api.Session, placement view.Query, limits csp.Limits, caps []csp.Cap, argv []string) error {
+	args, err := csp.EncodeTextList(argv)
+	if err != nil {
+		return err
+	}
+	if err = ps.SetArgs(args); err != nil {
+		return err
+	}
+
+	l, err := ps.NewLimits()
+	if err != nil {
+		return err
+	}
+	limits.Bind(l)
+
+	if len(caps) > 0 {
+		cl, err := ps.NewCaps(int32(len(caps)))
+		if err != nil {
+			return err
+		}
+
+		if err = csp.BindCaps(cl, caps); err != nil {
+			return err
+		}
+	}
+
+	if placement != nil {
+		p, err := ps.NewPlacement()
+		if err != nil {
+			return err
+		}
+
+		if err = placement(p); err != nil {
+			return err
+		}
+	}
+
+	return ps.SetSession(sess)
+}
@@ -0,0 +1,369 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/scheduler"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+var bytecode = []byte("bytecode")
+
+func TestScheduler_Exec(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("LeastLoaded", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		c := newCluster(time.Now())
+		busy := c.add(&record{load: routing.Load{Procs: 2}})
+		idle := c.add(&record{load: routing.Load{Procs: 0}})
+		c.add(&record{load: routing.Load{Procs: 1}})
+
+		s := c.scheduler()
+		defer s.Release()
+
+		proc, release := s.Exec(ctx, core_api.Session{}, bytecode,
+			view.NewQuery(view.From(loadIndex{})),
+			csp.Limits{}, nil, "foo")
+		defer release()
+
+		require.NoError(t, capnp.Client(proc).Resolve(ctx), "should place process")
+		assert.Equal(t, []string{"foo"}, idle.args(), "should run on least-loaded node")
+		assert.Zero(t, busy.calls(), "should not run on busy node")
+	})
+
+	t.Run("Departed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		t0 := time.Now()
+		c := newCluster(t0)
+		gone := &record{load: routing.Load{Procs: 0}, ttl: time.Millisecond}
+		c.table.Upsert(gone)
+		next := c.add(&record{load: routing.Load{Procs: 1}})
+
+		// The departed node cannot be dialed, and expires from the
+		// routing table when the dial fails.
+		c.onDialFailure = func() {
+			c.table.Advance(t0.Add(time.Millisecond * 10))
+		}
+
+		s := c.scheduler()
+		defer s.Release()
+
+		proc, release := s.Exec(ctx, core_api.Session{}, bytecode,
+			view.NewQuery(view.From(loadIndex{})),
+			csp.Limits{}, nil)
+		defer release()
+
+		require.NoError(t, capnp.Client(proc).Resolve(ctx), "should place process")
+		assert.Equal(t, 1, next.calls(), "should retry on next candidate")
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		// The node is still in the routing table, but cannot be dialed.
+		c := newCluster(time.Now())
+		c.table.Upsert(&record{load: routing.Load{Procs: 0}})
+		next := c.add(&record{load: routing.Load{Procs: 1}})
+
+		s := c.scheduler()
+		defer s.Release()
+
+		proc, release := s.Exec(ctx, core_api.Session{}, bytecode,
+			view.NewQuery(view.From(loadIndex{})),
+			csp.Limits{}, nil)
+		defer release()
+
+		require.NoError(t, capnp.Client(proc).Resolve(ctx), "should place process")
+		assert.Equal(t, 1, next.calls(), "should retry on next candidate")
+	})
+
+	t.Run("Disconnected", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		c := newCluster(time.Now())
+		lost := c.add(&record{load: routing.Load{Procs: 0}})
+		lost.err = capnp.Disconnected("connection lost")
+		next := c.add(&record{load: routing.Load{Procs: 1}})
+
+		s := c.scheduler()
+		defer s.Release()
+
+		proc, release := s.Exec(ctx, core_api.Session{}, bytecode,
+			view.NewQuery(view.From(loadIndex{})),
+			csp.Limits{}, nil)
+		defer release()
+
+		require.NoError(t, capnp.Client(proc).Resolve(ctx), "should place process")
+		assert.Equal(t, 1, next.calls(), "should retry on next candidate")
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		c := newCluster(time.Now())
+		failing := c.add(&record{load: routing.Load{Procs: 0}})
+		failing.err = errors.New("test")
+		next := c.add(&record{load: routing.Load{Procs: 1}})
+
+		s := c.scheduler()
+		defer s.Release()
+
+		proc, release := s.Exec(ctx, core_api.Session{}, bytecode,
+			view.NewQuery(view.From(loadIndex{})),
+			csp.Limits{}, nil)
+		defer release()
+
+		err := proc.Wait(ctx)
+		assert.ErrorContains(t, err, "test", "should report failure")
+		assert.Zero(t, next.calls(), "should not retry while node is present")
+	})
+
+	t.Run("NoNode", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		s := newCluster(time.Now()).scheduler()
+		defer s.Release()
+
+		proc, release := s.Exec(ctx, core_api.Session{}, bytecode, nil,
+			csp.Limits{}, nil)
+		defer release()
+
+		err := proc.Wait(ctx)
+		assert.ErrorContains(t, err, scheduler.ErrNoNode.Error())
+	})
+}
+
+// cluster is a routing table whose nodes are served by fake executors.
+type cluster struct {
+	table         routing.Table
+	onDialFailure func()
+
+	mu    sync.Mutex
+	nodes map[peer.ID]*executor
+}
+
+func newCluster(t0 time.Time) *cluster {
+	return &cluster{
+		table: routing.New(t0),
+		nodes: make(map[peer.ID]*executor),
+	}
+}
+
+func (c *cluster) add(r *record) *executor {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.table.Upsert(r)
+
+	ex := new(executor)
+	c.nodes[r.Peer()] = ex
+	return ex
+}
+
+func (c *cluster) scheduler() scheduler.Scheduler {
+	return scheduler.Server{
+		RoutingTable: c.table,
+		Dialer:       c,
+		Log:          slog.Default(),
+	}.Scheduler()
+}
+
+func (c *cluster) DialExecutor(ctx context.Context, id peer.ID) (csp.Executor, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ex, ok := c.nodes[id]
+	if !ok {
+		if c.onDialFailure != nil {
+			c.onDialFailure()
+		}
+
+		return csp.Executor{}, errors.New("connection refused")
+	}
+
+	return csp.Executor(core_api.Executor_ServerToClient(ex)), nil
+}
+
+// executor records the execs that it receives.
+type executor struct {
+	err error
+
+	mu   sync.Mutex
+	argv [][]string
+}
+
+func (ex *executor) calls() int {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	return len(ex.argv)
+}
+
+func (ex *executor) args() []string {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	if len(ex.argv) == 0 {
+		return nil
+	}
+
+	return ex.argv[len(ex.argv)-1]
+}
+
+func (ex *executor) Exec(ctx context.Context, call core_api.Executor_exec) error {
+	args, err := call.Args().Args()
+	if err != nil {
+		return err
+	}
+
+	argv, err := csp.DecodeTextList(args)
+	if err != nil {
+		return err
+	}
+
+	ex.mu.Lock()
+	ex.argv = append(ex.argv, argv)
+	ex.mu.Unlock()
+
+	return ex.err
+}
+
+func (ex *executor) ExecCached(context.Context, core_api.Executor_execCached) error {
+	return errors.New("not implemented")
+}
+
+func (ex *executor) Ps(context.Context, core_api.Executor_ps) error {
+	return errors.New("not implemented")
+}
+
+func (ex *executor) Watch(context.Context, core_api.Executor_watch) error {
+	return errors.New("not implemented")
+}
+
+func (ex *executor) Supervise(context.Context, core_api.Executor_supervise) error {
+	return errors.New("not implemented")
+}
+
+// loadIndex selects records from the lowest process count.
+type loadIndex struct{}
+
+func (loadIndex) String() string     { return "procs" }
+func (loadIndex) Prefix() bool       { return false }
+func (loadIndex) Load() routing.Load { return routing.Load{} }
+
+type record struct {
+	once sync.Once
+	id   peer.ID
+	seq  uint64
+	ins  uint64
+	host string
+	meta routing.Meta
+	load routing.Load
+	ttl  time.Duration
+}
+
+func (r *record) init() {
+	r.once.Do(func() {
+		if r.id == "" {
+			r.id = newPeerID()
+		}
+
+		if r.host == "" {
+			r.host = newPeerID().String()[:16]
+		}
+
+		if r.ins == 0 {
+			r.ins = rand.Uint64()
+		}
+	})
+}
+
+func (r *record) Peer() peer.ID {
+	r.init()
+	return r.id
+}
+
+func (r *record) Server() routing.ID {
+	r.init()
+	return routing.ID(r.ins)
+}
+
+func (r *record) Seq() uint64 { return r.seq }
+
+func (r *record) Host() (string, error) {
+	r.init()
+	return r.host, nil
+}
+
+func (r *record) TTL() time.Duration {
+	if r.init(); r.ttl == 0 {
+		return time.Second
+	}
+
+	return r.ttl
+}
+
+func (r *record) Meta() (routing.Meta, error) { return r.meta, nil }
+func (r *record) Load() routing.Load          { return r.load }
+
+func (r *record) PeerBytes() ([]byte, error) {
+	r.init()
+	return []byte(r.id), nil
+}
+
+func (r *record) HostBytes() ([]byte, error) {
+	r.init()
+	return []byte(r.host), nil
+}
+
+func newPeerID() peer.ID {
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sk, _, err := crypto.GenerateEd25519Key(rnd)
+	if err != nil {
+		panic(err)
+	}
+
+	id, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
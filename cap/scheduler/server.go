@@ -0,0 +1,281 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	api "github.com/wetware/pkg/api/core"
+	proc_api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/query"
+	"github.com/wetware/pkg/util/log"
+)
+
+// DefaultMaxAttempts is the number of candidate nodes on which the
+// scheduler tries to place a process, if Server.MaxAttempts is zero.
+const DefaultMaxAttempts = 3
+
+// Dialer provides the executors of cluster nodes.
+type Dialer interface {
+	// DialExecutor returns the executor of the node with the supplied
+	// peer ID.  The caller MUST release the executor.
+	DialExecutor(context.Context, peer.ID) (csp.Executor, error)
+}
+
+type Server struct {
+	RoutingTable view.RoutingTable
+	Dialer       Dialer
+	MaxAttempts  int
+	Log          log.Logger
+}
+
+func (s Server) Client() capnp.Client {
+	return capnp.Client(s.Scheduler())
+}
+
+func (s Server) Scheduler() Scheduler {
+	return Scheduler(api.Scheduler_ServerToClient(s))
+}
+
+func (s Server) Exec(ctx context.Context, call api.Scheduler_exec) error {
+	bc, err := call.Args().Bytecode()
+	if err != nil {
+		return err
+	}
+
+	p, err := call.Args().Placement()
+	if err != nil {
+		return err
+	}
+
+	// Placement may wait on remote nodes; don't block other calls.
+	call.Go()
+
+	proc, err := s.place(ctx, p, func(ex csp.Executor) (proc_api.Process, error) {
+		f, release := api.Executor(ex).Exec(ctx, func(ps api.Executor_exec_Params) error {
+			if err := ps.SetBytecode(bc); err != nil {
+				return err
+			}
+
+			return forward(ps, call.Args())
+		})
+		defer release()
+
+		res, err := f.Struct()
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+
+		return res.Process().AddRef(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		proc.Release()
+		return err
+	}
+
+	return res.SetProcess(proc)
+}
+
+func (s Server) ExecCached(ctx context.Context, call api.Scheduler_execCached) error {
+	id, err := call.Args().Cid()
+	if err != nil {
+		return err
+	}
+
+	p, err := call.Args().Placement()
+	if err != nil {
+		return err
+	}
+
+	call.Go()
+
+	proc, err := s.place(ctx, p, func(ex csp.Executor) (proc_api.Process, error) {
+		f, release := api.Executor(ex).ExecCached(ctx, func(ps api.Executor_execCached_Params) error {
+			if err := ps.SetCid(id); err != nil {
+				return err
+			}
+
+			return forward(ps, call.Args())
+		})
+		defer release()
+
+		res, err := f.Struct()
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+
+		return res.Process().AddRef(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		proc.Release()
+		return err
+	}
+
+	return res.SetProcess(proc)
+}
+
+// place the process on the first candidate node that spawns it.  If a
+// candidate fails, the next one is tried only if the failed candidate
+// could not be reached.  Otherwise, its error is returned.
+func (s Server) place(ctx context.Context, p api.Scheduler_Placement, exec func(csp.Executor) (proc_api.Process, error)) (proc_api.Process, error) {
+	sel, err := p.Selector()
+	if err != nil {
+		return proc_api.Process{}, err
+	}
+
+	cs, err := p.Constraints()
+	if err != nil {
+		return proc_api.Process{}, err
+	}
+
+	candidates, err := s.candidates(view.Select(sel, cs))
+	if err != nil {
+		return proc_api.Process{}, err
+	}
+
+	err = ErrNoNode
+	for _, id := range candidates {
+		var proc proc_api.Process
+		if proc, err = s.exec(ctx, id, exec); err == nil {
+			return proc, nil
+		}
+
+		if ctx.Err() != nil || !s.unreachable(id, err) {
+			return proc_api.Process{}, err
+		}
+
+		s.Log.Warn("candidate unreachable",
+			"peer", id,
+			"error", err)
+		err = fmt.Errorf("%w: %w", ErrNoNode, err)
+	}
+
+	return proc_api.Process{}, err
+}
+
+func (s Server) exec(ctx context.Context, id peer.ID, exec func(csp.Executor) (proc_api.Process, error)) (proc_api.Process, error) {
+	ex, err := s.Dialer.DialExecutor(ctx, id)
+	if err != nil {
+		return proc_api.Process{}, dialError{err}
+	}
+	defer ex.Release()
+
+	return exec(ex)
+}
+
+// candidates returns the peer IDs of the first MaxAttempts nodes in the
+// selection.
+func (s Server) candidates(sel query.Selector) ([]peer.ID, error) {
+	it, err := sel(s.RoutingTable.Snapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []peer.ID
+	for r := it.Next(); r != nil && len(ids) < s.maxAttempts(); r = it.Next() {
+		ids = append(ids, r.Peer())
+	}
+
+	return ids, nil
+}
+
+// unreachable reports whether the candidate failed because it could
+// not be reached, i.e. it could not be dialed, its connection was lost,
+// or it has left the routing table.
+func (s Server) unreachable(id peer.ID, err error) bool {
+	return errors.As(err, new(dialError)) ||
+		capnp.IsDisconnected(err) ||
+		!s.present(id)
+}
+
+// present reports whether the node is in the routing table.
+func (s Server) present(id peer.ID) bool {
+	it, err := query.All()(s.RoutingTable.Snapshot())
+	if err != nil {
+		return false
+	}
+
+	for r := it.Next(); r != nil; r = it.Next() {
+		if r.Peer() == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s Server) maxAttempts() int {
+	if s.MaxAttempts > 0 {
+		return s.MaxAttempts
+	}
+
+	return DefaultMaxAttempts
+}
+
+type execArgs interface {
+	Session() (api.Session, error)
+	Args() (capnp.TextList, error)
+	Limits() (proc_api.Limits, error)
+	Caps() (proc_api.Cap_List, error)
+}
+
+type execParams interface {
+	SetSession(api.Session) error
+	SetArgs(capnp.TextList) error
+	SetLimits(proc_api.Limits) error
+	SetCaps(proc_api.Cap_List) error
+}
+
+// forward the arguments of a scheduler call to an executor call.
+func forward(dst execParams, src execArgs) error {
+	sess, err := src.Session()
+	if err != nil {
+		return err
+	}
+	if err = dst.SetSession(sess); err != nil {
+		return err
+	}
+
+	args, err := src.Args()
+	if err != nil {
+		return err
+	}
+	if err = dst.SetArgs(args); err != nil {
+		return err
+	}
+
+	limits, err := src.Limits()
+	if err != nil {
+		return err
+	}
+	if err = dst.SetLimits(limits); err != nil {
+		return err
+	}
+
+	caps, err := src.Caps()
+	if err != nil {
+		return err
+	}
+
+	return dst.SetCaps(caps)
+}
+
+// dialError is returned by exec when the candidate could not be dialed.
+type dialError struct{ error }
+
+func (err dialError) Unwrap() error { return err.error }
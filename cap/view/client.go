@@ -12,6 +12,7 @@ import (
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/routing"
 	"github.com/wetware/pkg/util/casm"
+	"github.com/wetware/pkg/util/watch"
 )
 
 type View api.View
@@ -55,12 +56,59 @@ func (v View) Iter(ctx context.Context, query Query) (Iterator, capnp.ReleaseFun
 	)
 
 	return Iterator{
-			Future: casm.Future(f),
-			Seq:    h,
-		}, func() {
-			cancel()
-			release()
-		}
+		Future: casm.Future(f),
+		Seq:    h,
+	}, func() {
+		cancel()
+		release()
+	}
+}
+
+// Reverse returns a view that traverses the routing table in reverse
+// lexicographical order, e.g. to find the highest routing IDs.  Note
+// that range queries constructed with From and To must therefore be
+// supplied in descending order.  Callers MUST call the ReleaseFunc
+// when finished with the view.
+func (v View) Reverse(ctx context.Context) (View, capnp.ReleaseFunc) {
+	// If the view is an unresolved promise (e.g. returned by Reverse),
+	// calls pipelined on the reversed view are misdirected to the
+	// promise's own answer.   Wait for resolution to avoid this.  Any
+	// error will be reported by calls to the reversed view.
+	_ = capnp.Client(v).Resolve(ctx)
+
+	f, release := api.View(v).Reverse(ctx, nil)
+	return View(f.View()), release
+}
+
+// Watch returns a watcher that receives Join, Update and Leave events
+// as they occur in the routing table.  Callers MUST call the provided
+// ReleaseFunc when finished with the watcher, or a resource leak will
+// occur.
+func (v View) Watch(ctx context.Context) (Watcher, capnp.ReleaseFunc) {
+	// Aborting early simplifies the lifecycle logic for the handler.
+	// We still invoke api.View.Watch() in order to report the null
+	// capability error to the caller.
+	if !api.View(v).IsValid() {
+		f, release := api.View(v).Watch(ctx, nil)
+		return Watcher{Future: casm.Future(f)}, release
+	}
+
+	// The user needs to be able to abort the call, so we derive a
+	// context and wrap its CancelFunc in the release function.
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		h          = eventHandler{make(watch.Handler[routing.Event], 16)}
+		f, release = api.View(v).Watch(ctx, h.Params)
+	)
+
+	return Watcher{
+		Future: casm.Future(f),
+		Seq:    h,
+	}, func() {
+		cancel()
+		release()
+	}
 }
 
 type exhausted struct{}
@@ -247,6 +295,10 @@ func (r clientRecord) Meta() (routing.Meta, error) {
 	return r.heartbeat().Meta()
 }
 
+func (r clientRecord) Load() routing.Load {
+	return r.heartbeat().Load()
+}
+
 func (r clientRecord) heartbeat() pulse.Heartbeat {
 	hb, _ := api.View_Record(r).Heartbeat()
 	return pulse.Heartbeat{Heartbeat: hb}
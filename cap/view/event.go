@@ -0,0 +1,84 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"capnproto.org/go/capnp/v3"
+
+	api "github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/casm"
+	"github.com/wetware/pkg/util/watch"
+)
+
+// Watcher is a stateful iterator over a stream of membership events.
+// See View.Watch.
+type Watcher casm.Iterator[routing.Event]
+
+// Next blocks until the next event is received, and returns it.  The
+// boolean is false when the watcher has been exhausted, in which case
+// callers SHOULD check Err().  Unlike records returned by Iterator,
+// event records remain valid after subsequent calls to Next.
+func (w Watcher) Next() (routing.Event, bool) {
+	return casm.Iterator[routing.Event](w).Next()
+}
+
+// Err returns the first non-nil error encountered by the watcher.
+// If there is no error, Err() returns nil.
+func (w Watcher) Err() error {
+	return casm.Iterator[routing.Event](w).Err()
+}
+
+// eventHandler receives membership events from the server.
+type eventHandler struct{ watch.Handler[routing.Event] }
+
+func (h eventHandler) Params(ps api.View_watch_Params) error {
+	return ps.SetHandler(api.View_EventHandler_ServerToClient(h))
+}
+
+func (h eventHandler) Recv(ctx context.Context, call api.View_EventHandler_recv) error {
+	e, err := call.Args().Event()
+	if err != nil {
+		return err
+	}
+
+	ev, err := newEvent(e)
+	if err != nil {
+		return err
+	}
+
+	return h.Send(ctx, ev)
+}
+
+func newEvent(e api.View_Event) (ev routing.Event, err error) {
+	switch e.Which() {
+	case api.View_Event_Which_join:
+		ev.Type = routing.Join
+	case api.View_Event_Which_update:
+		ev.Type = routing.Update
+	case api.View_Event_Which_leave:
+		ev.Type = routing.Leave
+	default:
+		return ev, fmt.Errorf("invalid event: %s", e.Which())
+	}
+
+	rec, err := e.Record()
+	if err != nil {
+		return ev, err
+	}
+
+	// The record's segment is released when Recv returns, so we copy
+	// it into a message owned by the event.
+	msg, _ := capnp.NewSingleSegmentMessage(nil)
+	if err = msg.SetRoot(rec.ToPtr()); err != nil {
+		return ev, err
+	}
+
+	if rec, err = api.ReadRootView_Record(msg); err != nil {
+		return ev, err
+	}
+
+	ev.Record, err = newRecord(rec)
+	return ev, err
+}
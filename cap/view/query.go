@@ -3,10 +3,12 @@ package view
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	pool "github.com/libp2p/go-buffer-pool"
 	"github.com/libp2p/go-libp2p/core/peer"
 	api "github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/cluster/query"
 	"github.com/wetware/pkg/cluster/routing"
 )
 
@@ -33,10 +35,90 @@ func From(index routing.Index) Selector {
 	}
 }
 
+/*
+	Constraints
+*/
+
+// Limit restricts the selection to n records.
+func Limit(n int) Constraint {
+	return func(c ConstraintStruct) error {
+		if n <= 0 {
+			return fmt.Errorf("expected limit > 0 (got %d)", n)
+		}
+
+		c.SetLimit(uint64(n))
+		return nil
+	}
+}
+
+// To restricts the selection to records less-than-or-equal-to the
+// index.  Use with From to implement range queries.
+func To(index routing.Index) Constraint {
+	return func(c ConstraintStruct) error {
+		return bindIndex(c.NewTo, index)
+	}
+}
+
+// Where restricts the selection to records whose metadata satisfy
+// the predicate.  Filtering is performed by the server.
+func Where(p query.Predicate) Constraint {
+	return func(c ConstraintStruct) error {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		pred, err := c.NewWhere()
+		if err != nil {
+			return err
+		}
+
+		return bindPredicate(pred, p)
+	}
+}
+
 /*
 	Helpers
 */
 
+func bindPredicate(target api.View_Predicate, p query.Predicate) error {
+	if err := target.SetKey(p.Key); err != nil {
+		return err
+	}
+
+	switch p.Op {
+	case query.Exists:
+		target.SetExists()
+		return nil
+
+	case query.Eq:
+		return target.SetEq(p.Value)
+
+	case query.Prefix:
+		return target.SetPrefix(p.Value)
+	}
+
+	// p.Validate() ensures the value is numeric
+	x, err := strconv.ParseFloat(p.Value, 64)
+	if err != nil {
+		return err
+	}
+
+	switch p.Op {
+	case query.Lt:
+		target.SetLt(x)
+	case query.Lte:
+		target.SetLte(x)
+	case query.Gt:
+		target.SetGt(x)
+	case query.Gte:
+		target.SetGte(x)
+	default:
+		return fmt.Errorf("invalid op: %s", p.Op)
+	}
+
+	return nil
+}
+
 func bindIndex(fn func() (api.View_Index, error), index routing.Index) error {
 	target, err := fn()
 	if err != nil {
@@ -57,6 +139,9 @@ func bindIndex(fn func() (api.View_Index, error), index routing.Index) error {
 
 	case "meta":
 		return bindMeta(target, index)
+
+	case "procs", "memory", "cpu", "slots":
+		return bindLoad(target, index)
 	}
 
 	return fmt.Errorf("invalid index: %s", index)
@@ -140,3 +225,24 @@ func bindMeta(target api.View_Index, index routing.Index) error {
 
 	return errors.New("not a metadata index")
 }
+
+func bindLoad(target api.View_Index, index routing.Index) error {
+	ix, ok := index.(routing.LoadIndex)
+	if !ok {
+		return errors.New("not a load index")
+	}
+
+	load := ix.Load()
+	switch index.String() {
+	case "procs":
+		target.SetProcs(load.Procs)
+	case "memory":
+		target.SetMemory(load.Memory)
+	case "cpu":
+		target.SetCpu(load.CPU)
+	case "slots":
+		target.SetSlots(load.Slots)
+	}
+
+	return nil
+}
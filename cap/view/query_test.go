@@ -10,6 +10,8 @@ import (
 
 	api "github.com/wetware/pkg/api/cluster"
 	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/query"
+	"github.com/wetware/pkg/cluster/routing"
 )
 
 func TestSelector(t *testing.T) {
@@ -39,6 +41,68 @@ func TestSelector(t *testing.T) {
 			assert.Equal(t, tt.which, s.Which(), "should be %s", tt.which)
 		})
 	}
+
+	t.Run("Load", func(t *testing.T) {
+		s := selector()
+		err := view.From(slotsIndex(4))(s)
+		require.NoError(t, err, "should succeed")
+
+		from, err := s.From()
+		require.NoError(t, err, "should have index")
+		assert.Equal(t, api.View_Index_Which_slots, from.Which())
+		assert.Equal(t, uint32(4), from.Slots())
+	})
+}
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	for _, tt := range []struct {
+		name       string
+		constraint view.Constraint
+		which      api.View_Constraint_Which
+	}{
+		{
+			name:       "Limit",
+			constraint: view.Limit(1),
+			which:      api.View_Constraint_Which_limit,
+		},
+		{
+			name:       "To",
+			constraint: view.To(hostIndex("foo")),
+			which:      api.View_Constraint_Which_to,
+		},
+		{
+			name:       "Where",
+			constraint: view.Where(query.Predicate{Key: "cpu", Op: query.Gte, Value: "4"}),
+			which:      api.View_Constraint_Which_where,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := constraint()
+			err := tt.constraint(c)
+			require.NoError(t, err, "should succeed")
+			assert.Equal(t, tt.which, c.Which(), "should be %s", tt.which)
+		})
+	}
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		err := view.Limit(0)(constraint())
+		assert.Error(t, err, "should reject non-positive limit")
+	})
+
+	t.Run("InvalidPredicate", func(t *testing.T) {
+		p := query.Predicate{Key: "cpu", Op: query.Gt, Value: "four"}
+		err := view.Where(p)(constraint())
+		assert.Error(t, err, "should reject non-numeric comparison")
+	})
+}
+
+func constraint() api.View_Constraint {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	c, _ := api.NewRootView_Constraint(seg)
+	return c
 }
 
 func selector() api.View_Selector {
@@ -52,3 +116,9 @@ type hostIndex string
 func (hostIndex) String() string                { return "host" }
 func (hostIndex) Prefix() bool                  { return false }
 func (ix hostIndex) HostBytes() ([]byte, error) { return []byte(ix), nil }
+
+type slotsIndex uint32
+
+func (slotsIndex) String() string        { return "slots" }
+func (slotsIndex) Prefix() bool          { return false }
+func (ix slotsIndex) Load() routing.Load { return routing.Load{Slots: uint32(ix)} }
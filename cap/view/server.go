@@ -3,6 +3,7 @@ package view
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"capnproto.org/go/capnp/v3"
 
@@ -10,16 +11,22 @@ import (
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/query"
 	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/watch"
 )
 
 type RecordBinder interface {
 	BindRecord(api.View_Record) error
 }
 
+// RoutingTable provides snapshots of the routing table, and a stream
+// of membership events.
+type RoutingTable interface {
+	Snapshot() routing.Snapshot
+	Subscribe(buf int) (<-chan routing.Event, func())
+}
+
 type Server struct {
-	RoutingTable interface {
-		Snapshot() routing.Snapshot
-	}
+	RoutingTable RoutingTable
 }
 
 func (s Server) Client() capnp.Client {
@@ -32,11 +39,17 @@ func (s Server) View() View {
 
 func (s Server) Lookup(ctx context.Context, call api.View_lookup) error {
 	sel, err := call.Args().Selector()
-	if err == nil {
-		err = s.bind(maybeRecord(call), selector(sel).Bind(query.First()))
+	if err != nil {
+		return err
 	}
 
-	return err
+	cs, err := call.Args().Constraints()
+	if err != nil {
+		return err
+	}
+
+	q := constrain(selector(sel), cs).Bind(query.First())
+	return s.bind(maybeRecord(call), q)
 }
 
 func (s Server) Iter(ctx context.Context, call api.View_iter) error {
@@ -45,6 +58,11 @@ func (s Server) Iter(ctx context.Context, call api.View_iter) error {
 		return err
 	}
 
+	cs, err := call.Args().Constraints()
+	if err != nil {
+		return err
+	}
+
 	var (
 		handler = call.Args().Handler()
 		// TODO(soon): use BBR once scheduler bug is fixed
@@ -52,7 +70,7 @@ func (s Server) Iter(ctx context.Context, call api.View_iter) error {
 		iter = iterator(ctx, handler)
 	)
 
-	if err = s.bind(iter, selector(sel)); err == nil {
+	if err = s.bind(iter, constrain(selector(sel), cs)); err == nil {
 		call.Go()
 		err = handler.WaitStreaming()
 	}
@@ -60,8 +78,39 @@ func (s Server) Iter(ctx context.Context, call api.View_iter) error {
 	return err
 }
 
+// Reverse returns a view that traverses the routing table in reverse
+// lexicographical order.  Reversing a reversed view undoes the reversal.
 func (s Server) Reverse(ctx context.Context, call api.View_reverse) error {
-	return fmt.Errorf("NOT IMPLEMENTED") // TODO(soon):  implement Reverse()
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetView(api.View(Server{
+			RoutingTable: reversed{s.RoutingTable},
+		}.View()))
+	}
+
+	return err
+}
+
+// Watch streams membership events to the handler until the call is
+// canceled, or until the handler falls too far behind.
+func (s Server) Watch(ctx context.Context, call api.View_watch) error {
+	events, cancel := s.RoutingTable.Subscribe(watch.Buffer)
+	defer cancel()
+
+	return watch.Serve(ctx, call, call.Args().Handler(), events, event)
+}
+
+// reversed routing table
+type reversed struct{ RoutingTable }
+
+func (r reversed) Snapshot() routing.Snapshot {
+	return query.Query{Snapshot: r.RoutingTable.Snapshot()}.Reverse().Snapshot
+}
+
+// Select returns the query described by the selector and constraints.
+// It selects the same records, in the same order, as View.iter.
+func Select(sel api.View_Selector, cs api.View_Constraint_List) query.Selector {
+	return constrain(selector(sel), cs)
 }
 
 func selector(s api.View_Selector) query.Selector {
@@ -89,6 +138,94 @@ func selector(s api.View_Selector) query.Selector {
 	return query.Failuref("invalid selector: %s", s.Which())
 }
 
+func constrain(sel query.Selector, cs api.View_Constraint_List) query.Selector {
+	for i := 0; i < cs.Len(); i++ {
+		sel = sel.Bind(constraint(cs.At(i)))
+	}
+
+	return sel
+}
+
+func constraint(c api.View_Constraint) query.Constraint {
+	switch c.Which() {
+	case api.View_Constraint_Which_limit:
+		return query.Limit(int(c.Limit()))
+
+	case api.View_Constraint_Which_to:
+		to, err := c.To()
+		if err != nil {
+			return constraintFailure(err)
+		}
+
+		return query.To(index{to})
+
+	case api.View_Constraint_Which_where:
+		where, err := c.Where()
+		if err != nil {
+			return constraintFailure(err)
+		}
+
+		p, err := predicate(where)
+		if err != nil {
+			return constraintFailure(err)
+		}
+
+		return query.Where(p)
+	}
+
+	return constraintFailure(fmt.Errorf("invalid constraint: %s", c.Which()))
+}
+
+func predicate(p api.View_Predicate) (pred query.Predicate, err error) {
+	if pred.Key, err = p.Key(); err != nil {
+		return
+	}
+
+	switch p.Which() {
+	case api.View_Predicate_Which_exists:
+		pred.Op = query.Exists
+
+	case api.View_Predicate_Which_eq:
+		pred.Op = query.Eq
+		pred.Value, err = p.Eq()
+
+	case api.View_Predicate_Which_prefix:
+		pred.Op = query.Prefix
+		pred.Value, err = p.Prefix()
+
+	case api.View_Predicate_Which_lt:
+		pred.Op, pred.Value = query.Lt, formatFloat(p.Lt())
+
+	case api.View_Predicate_Which_lte:
+		pred.Op, pred.Value = query.Lte, formatFloat(p.Lte())
+
+	case api.View_Predicate_Which_gt:
+		pred.Op, pred.Value = query.Gt, formatFloat(p.Gt())
+
+	case api.View_Predicate_Which_gte:
+		pred.Op, pred.Value = query.Gte, formatFloat(p.Gte())
+
+	default:
+		err = fmt.Errorf("invalid predicate: %s", p.Which())
+	}
+
+	if err == nil {
+		err = pred.Validate()
+	}
+
+	return
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func constraintFailure(err error) query.Constraint {
+	return func(routing.Iterator) query.Selector {
+		return query.Failure(err)
+	}
+}
+
 // binds a record
 type bindFunc func(routing.Record) error
 
@@ -125,6 +262,33 @@ func iterator(ctx context.Context, h api.View_Handler) bindFunc {
 	}
 }
 
+func event(ev routing.Event) func(api.View_EventHandler_recv_Params) error {
+	return func(ps api.View_EventHandler_recv_Params) error {
+		e, err := ps.NewEvent()
+		if err != nil {
+			return err
+		}
+
+		switch ev.Type {
+		case routing.Join:
+			e.SetJoin()
+		case routing.Update:
+			e.SetUpdate()
+		case routing.Leave:
+			e.SetLeave()
+		default:
+			return fmt.Errorf("invalid event type: %s", ev.Type)
+		}
+
+		rec, err := e.NewRecord()
+		if err != nil {
+			return err
+		}
+
+		return copyRecord(rec, ev.Record)
+	}
+}
+
 func record(r routing.Record) func(api.View_Handler_recv_Params) error {
 	return func(ps api.View_Handler_recv_Params) error {
 		rec, err := ps.NewRecord()
@@ -177,7 +341,11 @@ func copyRecord(rec api.View_Record, r routing.Record) error {
 		return err
 	}
 
-	return copyMeta(hb, r)
+	if err := copyMeta(hb, r); err != nil {
+		return err
+	}
+
+	return hb.SetLoad(r.Load())
 }
 
 func copyHost(rec pulse.Heartbeat, r routing.Record) error {
@@ -211,3 +379,20 @@ func (ix index) String() string {
 func (ix index) ServerBytes() ([]byte, error) {
 	return ix.View_Index.Server()
 }
+
+// Load returns a Load whose field corresponding to the index is set.
+// Other fields are zero.
+func (ix index) Load() (load routing.Load) {
+	switch ix.Which() {
+	case api.View_Index_Which_procs:
+		load.Procs = ix.Procs()
+	case api.View_Index_Which_memory:
+		load.Memory = ix.Memory()
+	case api.View_Index_Which_cpu:
+		load.CPU = ix.Cpu()
+	case api.View_Index_Which_slots:
+		load.Slots = ix.Slots()
+	}
+
+	return
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/query"
 	"github.com/wetware/pkg/cluster/routing"
 	test_routing "github.com/wetware/pkg/cluster/routing/test"
 	test_cluster "github.com/wetware/pkg/cluster/test"
+	"github.com/wetware/pkg/util/watch"
 )
 
 var recs = []*record{
@@ -130,6 +132,92 @@ func TestView_Iter(t *testing.T) {
 	require.NoError(t, it.Err(), "iterator should not encounter error")
 }
 
+func TestView_Lookup_where(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recs := []*record{
+		{meta: newMeta("cpu=2")},
+		{meta: newMeta("cpu=8")},
+	}
+
+	iter := test_routing.NewMockIterator(ctrl)
+	iter.EXPECT().Next().Return(recs[0]).Times(1) // <- filtered by server
+	iter.EXPECT().Next().Return(recs[1]).Times(1)
+	iter.EXPECT().Next().Return(nil).Times(1) // <- called, but skipped due to query.First()
+
+	snap := test_routing.NewMockSnapshot(ctrl)
+	snap.EXPECT().
+		Get(gomock.Any()).
+		Return(iter, nil).
+		Times(1)
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Snapshot().
+		Return(snap).
+		Times(1)
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	cpu := query.Predicate{Key: "cpu", Op: query.Gt, Value: "4"}
+	f, release := client.Lookup(ctx, view.NewQuery(view.All(), view.Where(cpu)))
+	defer release()
+
+	r, err := f.Record()
+	require.NoError(t, err)
+	require.NotNil(t, r, "should match record")
+	require.Equal(t, recs[1].Peer(), r.Peer())
+}
+
+func TestView_Iter_limit(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	iter := test_routing.NewMockIterator(ctrl)
+	iter.EXPECT().Next().Return(recs[0]).Times(1)
+	iter.EXPECT().Next().Return(recs[1]).Times(1)
+	iter.EXPECT().Next().Return(recs[2]).Times(1) // <- skipped due to limit
+
+	snap := test_routing.NewMockSnapshot(ctrl)
+	snap.EXPECT().
+		Get(gomock.Any()).
+		Return(iter, nil).
+		Times(1)
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Snapshot().
+		Return(snap).
+		Times(1)
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	it, release := client.Iter(ctx, view.NewQuery(view.All(), view.Limit(2)))
+	defer release()
+
+	var got []peer.ID
+	for r := it.Next(); r != nil; r = it.Next() {
+		got = append(got, r.Peer())
+	}
+	require.NoError(t, it.Err(), "iterator should not encounter error")
+	assert.Equal(t, []peer.ID{recs[0].Peer(), recs[1].Peer()}, got)
+}
+
 func TestView_Iter_paramErr(t *testing.T) {
 	t.Parallel()
 
@@ -161,6 +249,196 @@ func TestView_Iter_paramErr(t *testing.T) {
 	assert.Error(t, it.Err(), "should fail with param error")
 }
 
+func TestView_Reverse(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	iter := test_routing.NewMockIterator(ctrl)
+	for i := len(recs) - 1; i >= 0; i-- {
+		iter.EXPECT().Next().Return(recs[i]).Times(1)
+	}
+	iter.EXPECT().Next().Return(nil).Times(1)
+
+	snap := test_routing.NewMockSnapshot(ctrl)
+	snap.EXPECT().
+		GetReverse(gomock.Any()).
+		Return(iter, nil).
+		Times(1)
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Snapshot().
+		Return(snap).
+		Times(1)
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	reversed, release := client.Reverse(ctx)
+	defer release()
+
+	it, release := reversed.Iter(ctx, all())
+	require.NotZero(t, it)
+	require.NotNil(t, release)
+	defer release()
+
+	var got []peer.ID
+	for r := it.Next(); r != nil; r = it.Next() {
+		got = append(got, r.Peer())
+	}
+	require.NoError(t, it.Err(), "iterator should not encounter error")
+	require.Len(t, got, len(recs))
+
+	for i, rec := range recs {
+		assert.Equal(t, rec.Peer(), got[len(got)-1-i],
+			"should match record %d in reverse order", i)
+	}
+}
+
+func TestView_Reverse_twice(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	iter := test_routing.NewMockIterator(ctrl)
+	iter.EXPECT().Next().Return(recs[0]).Times(1)
+	iter.EXPECT().Next().Return(recs[1]).Times(1) // skipped due to query.First()
+
+	/*
+		Reversing a reversed view should restore the original
+		traversal order.
+	*/
+	snap := test_routing.NewMockSnapshot(ctrl)
+	snap.EXPECT().
+		Get(gomock.Any()).
+		Return(iter, nil).
+		Times(1)
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Snapshot().
+		Return(snap).
+		Times(1)
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	reversed, release := client.Reverse(ctx)
+	defer release()
+
+	original, release := reversed.Reverse(ctx)
+	defer release()
+
+	f, release := original.Lookup(ctx, all())
+	defer release()
+
+	r, err := f.Record()
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	require.Equal(t, recs[0].Peer(), r.Peer())
+}
+
+func TestView_Watch(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	events := make(chan routing.Event, len(recs))
+	canceled := make(chan struct{})
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Subscribe(gomock.Any()).
+		Return((<-chan routing.Event)(events), func() { close(canceled) }).
+		Times(1)
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	w, release := client.Watch(ctx)
+
+	want := []routing.Event{
+		{Type: routing.Join, Record: recs[0]},
+		{Type: routing.Update, Record: recs[0]},
+		{Type: routing.Leave, Record: recs[1]},
+	}
+	for _, ev := range want {
+		events <- ev
+	}
+
+	var got []routing.Event
+	for len(got) < len(want) {
+		ev, ok := w.Next()
+		require.True(t, ok, "should receive event")
+		got = append(got, ev)
+	}
+
+	for i, ev := range want {
+		assert.Equal(t, ev.Type, got[i].Type, "should match event %d", i)
+		assert.Equal(t, ev.Record.Peer(), got[i].Record.Peer(),
+			"should match record %d", i)
+	}
+
+	release()
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second * 5):
+		t.Error("should cancel subscription when released")
+	}
+}
+
+func TestView_Watch_overflow(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	/*
+		The routing table drops subscribers that fall behind by
+		closing their channel.  The watch should fail.
+	*/
+	events := make(chan routing.Event)
+	close(events)
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Subscribe(gomock.Any()).
+		Return((<-chan routing.Event)(events), func() {}).
+		Times(1)
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	w, release := client.Watch(ctx)
+	defer release()
+
+	_, ok := w.Next()
+	assert.False(t, ok, "should be exhausted")
+	assert.ErrorContains(t, w.Err(), watch.ErrOverflow.Error(),
+		"should report overflow")
+}
+
 func failure(message string) view.Query {
 	return func(view.QueryParams) error {
 		return errors.New(message)
@@ -224,6 +502,7 @@ type record struct {
 	ins  uint64
 	host string
 	meta routing.Meta
+	load routing.Load
 	ttl  time.Duration
 }
 
@@ -269,6 +548,16 @@ func (r *record) TTL() time.Duration {
 }
 
 func (r *record) Meta() (routing.Meta, error) { return r.meta, nil }
+func (r *record) Load() routing.Load          { return r.load }
+
+func newMeta(ss ...string) routing.Meta {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	meta, _ := capnp.NewTextList(seg, int32(len(ss)))
+	for i, s := range ss {
+		meta.Set(i, s)
+	}
+	return routing.Meta(meta)
+}
 
 func newPeerID() peer.ID {
 	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
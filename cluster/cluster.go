@@ -40,6 +40,7 @@ type RoutingTable interface {
 	Advance(time.Time)
 	Upsert(routing.Record) (created bool)
 	Snapshot() routing.Snapshot
+	Subscribe(buf int) (<-chan routing.Event, func())
 }
 
 // Router is a peer participating in the cluster membership protocol.
@@ -51,6 +52,7 @@ type Router struct {
 	Log          log.Logger
 	TTL          time.Duration
 	Meta         pulse.Preparer
+	Load         pulse.Sampler
 	Clock        Clock
 	RoutingTable RoutingTable
 
@@ -59,6 +61,7 @@ type Router struct {
 	id             uint64 // instance ID
 	announce       chan []pubsub.PubOpt
 	wc             *capnp.WeakClient
+	meta           dynamicMeta
 }
 
 func (r *Router) Close() error {
@@ -97,17 +100,25 @@ func (r *Router) View() view.View {
 
 func (r *Router) Bootstrap(ctx context.Context, opt ...pubsub.PubOpt) (err error) {
 	if err = r.relay(); err == nil {
-		if err = ErrClosing; r.Clock.Context().Err() == nil {
-			select {
-			case r.announce <- opt:
-				err = nil
+		err = r.announceNow(ctx, opt...)
+	}
 
-			case <-r.Clock.Context().Done():
-				err = ErrClosing
+	return
+}
 
-			case <-ctx.Done():
-				err = ctx.Err()
-			}
+// announceNow triggers an immediate heartbeat.  The router MUST be
+// relaying messages.
+func (r *Router) announceNow(ctx context.Context, opt ...pubsub.PubOpt) (err error) {
+	if err = ErrClosing; r.Clock.Context().Err() == nil {
+		select {
+		case r.announce <- opt:
+			err = nil
+
+		case <-r.Clock.Context().Done():
+			err = ErrClosing
+
+		case <-ctx.Done():
+			err = ctx.Err()
 		}
 	}
 
@@ -155,6 +166,10 @@ func (r *Router) setup() {
 			r.Meta = nopPreparer{}
 		}
 
+		if r.Load == nil {
+			r.Load = nopSampler{}
+		}
+
 		if r.TTL <= 0 {
 			r.TTL = pulse.DefaultTTL
 		}
@@ -244,7 +259,11 @@ func (r *Router) heartbeat() {
 }
 
 func (r *Router) emit(ctx context.Context, hb pulse.Heartbeat, opt []pubsub.PubOpt) error {
-	if err := r.Meta.Prepare(hb); err != nil {
+	if err := r.prepare(hb); err != nil {
+		return err
+	}
+
+	if err := hb.SetLoad(r.Load.Sample()); err != nil {
 		return err
 	}
 
@@ -259,3 +278,7 @@ func (r *Router) emit(ctx context.Context, hb pulse.Heartbeat, opt []pubsub.PubO
 type nopPreparer struct{}
 
 func (nopPreparer) Prepare(pulse.Heartbeat) error { return nil }
+
+type nopSampler struct{}
+
+func (nopSampler) Sample() routing.Load { return routing.Load{} }
@@ -0,0 +1,138 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+// UpdateMeta atomically sets and unsets metadata fields, and triggers
+// an immediate heartbeat so that peers observe the change promptly.
+// Fields set by UpdateMeta take precedence over those provided by
+// r.Meta, and unset fields are removed from heartbeats even if they
+// are provided by r.Meta.  If a key is both set and unset, it is set.
+//
+// If the router is not yet relaying, the fields are advertised by the
+// first heartbeat.  See Bootstrap.
+func (r *Router) UpdateMeta(ctx context.Context, set []routing.MetaField, unset []string) error {
+	r.setup()
+	r.meta.Update(set, unset)
+
+	if !r.relaying.Load() {
+		return nil
+	}
+
+	return r.announceNow(ctx)
+}
+
+// MetaFields returns the metadata fields that are currently advertised
+// by the router's heartbeats.
+func (r *Router) MetaFields() ([]routing.MetaField, error) {
+	r.setup()
+
+	hb := pulse.NewHeartbeat()
+	if err := r.prepare(hb); err != nil {
+		return nil, err
+	}
+
+	meta, err := hb.Meta()
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.Fields()
+}
+
+// prepare the heartbeat's metadata fields, applying dynamic updates
+// to the fields provided by r.Meta.
+func (r *Router) prepare(hb pulse.Heartbeat) error {
+	if err := r.Meta.Prepare(hb); err != nil {
+		return err
+	}
+
+	return r.meta.Prepare(hb)
+}
+
+// dynamicMeta holds metadata updates applied at runtime.  The zero
+// value is ready to use.
+type dynamicMeta struct {
+	mu    sync.Mutex
+	set   map[string]string
+	unset map[string]struct{}
+}
+
+func (m *dynamicMeta) Update(set []routing.MetaField, unset []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.set == nil {
+		m.set = make(map[string]string)
+		m.unset = make(map[string]struct{})
+	}
+
+	for _, key := range unset {
+		delete(m.set, key)
+		m.unset[key] = struct{}{}
+	}
+
+	for _, f := range set {
+		delete(m.unset, f.Key)
+		m.set[f.Key] = f.Value
+	}
+}
+
+// Prepare rewrites the heartbeat's metadata fields.  It is a no-op if
+// no updates were applied.
+func (m *dynamicMeta) Prepare(hb pulse.Heartbeat) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.set) == 0 && len(m.unset) == 0 {
+		return nil
+	}
+
+	meta, err := hb.Meta()
+	if err != nil {
+		return err
+	}
+
+	// Keep the fields that were neither set nor unset, preserving
+	// their order.
+	fields := make([]routing.MetaField, 0, meta.Len()+len(m.set))
+	for i := 0; i < meta.Len(); i++ {
+		f, err := meta.At(i)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := m.unset[f.Key]; ok {
+			continue
+		}
+
+		if _, ok := m.set[f.Key]; ok {
+			continue
+		}
+
+		fields = append(fields, f)
+	}
+
+	// Append the fields that were set, sorted by key so that
+	// heartbeats are deterministic.
+	keys := make([]string, 0, len(m.set))
+	for key := range m.set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fields = append(fields, routing.MetaField{
+			Key:   key,
+			Value: m.set[key],
+		})
+	}
+
+	return hb.SetMeta(fields)
+}
@@ -0,0 +1,118 @@
+package cluster_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/golang/mock/gomock"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cluster"
+	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
+	test_cluster "github.com/wetware/pkg/cluster/test"
+)
+
+func TestRouter_UpdateMeta(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Advance(gomock.AssignableToTypeOf(time.Time{})).
+		AnyTimes()
+
+	heartbeats := make(chan routing.Meta, 8)
+	topic := test_cluster.NewMockTopic(ctrl)
+	topic.EXPECT().
+		String().
+		Return("casm").
+		AnyTimes()
+	topic.EXPECT().
+		Relay().
+		Return(func() {}, nil).
+		Times(1)
+	topic.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, b []byte, _ ...pubsub.PubOpt) error {
+			heartbeats <- meta(t, b)
+			return nil
+		}).
+		AnyTimes()
+
+	router := cluster.Router{
+		Topic:        topic,
+		RoutingTable: table,
+		Meta:         static{{Key: "region", Value: "us-east"}, {Key: "draining", Value: "false"}},
+		Clock:        cluster.NewClock(time.Hour), // no periodic heartbeats
+	}
+	defer router.Close()
+
+	// Updates applied before bootstrapping are advertised by the first
+	// heartbeat.
+	err := router.UpdateMeta(ctx, []routing.MetaField{{Key: "version", Value: "1"}}, nil)
+	require.NoError(t, err, "should update meta before bootstrap")
+
+	err = router.Bootstrap(ctx)
+	require.NoError(t, err, "bootstrap should succeed")
+	assertMeta(t, "region=us-east,draining=false,version=1", <-heartbeats)
+
+	// Updates applied after bootstrapping trigger a heartbeat.
+	err = router.UpdateMeta(ctx,
+		[]routing.MetaField{{Key: "draining", Value: "true"}},
+		[]string{"region", "version"})
+	require.NoError(t, err, "should update meta")
+
+	select {
+	case m := <-heartbeats:
+		assertMeta(t, "draining=true", m)
+	case <-ctx.Done():
+		t.Fatal("should trigger heartbeat")
+	}
+
+	fields, err := router.MetaFields()
+	require.NoError(t, err, "should return meta fields")
+	assert.Equal(t, []routing.MetaField{{Key: "draining", Value: "true"}}, fields)
+}
+
+type static []routing.MetaField
+
+func (s static) Prepare(h pulse.Heartbeat) error {
+	return h.SetMeta(s)
+}
+
+func meta(t *testing.T, b []byte) routing.Meta {
+	msg, err := capnp.UnmarshalPacked(b)
+	require.NoError(t, err, "should unmarshal heartbeat")
+
+	var hb pulse.Heartbeat
+	require.NoError(t, hb.ReadMessage(msg), "should read heartbeat")
+
+	m, err := hb.Meta()
+	require.NoError(t, err, "should read meta")
+	return m
+}
+
+func assertMeta(t *testing.T, want string, m routing.Meta) {
+	t.Helper()
+
+	fields, err := m.Fields()
+	require.NoError(t, err, "should parse meta")
+
+	var got []string
+	for _, f := range fields {
+		got = append(got, f.String())
+	}
+
+	assert.Equal(t, want, strings.Join(got, ","))
+}
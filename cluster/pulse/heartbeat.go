@@ -61,6 +61,38 @@ func (h Heartbeat) SetMeta(fields []routing.MetaField) error {
 	return err
 }
 
+// Load returns the resource usage advertised by the heartbeat.  The
+// zero value is returned if the heartbeat does not carry load data.
+func (h Heartbeat) Load() routing.Load {
+	load, err := h.Heartbeat.Load()
+	if err != nil {
+		return routing.Load{}
+	}
+
+	return routing.Load{
+		Procs:  load.Procs(),
+		Memory: load.Memory(),
+		CPU:    load.Cpu(),
+		Slots:  load.Slots(),
+	}
+}
+
+func (h Heartbeat) SetLoad(l routing.Load) error {
+	load, err := h.Heartbeat.Load()
+	if err != nil || !load.IsValid() {
+		if load, err = h.NewLoad(); err != nil {
+			return err
+		}
+	}
+
+	load.SetProcs(l.Procs)
+	load.SetMemory(l.Memory)
+	load.SetCpu(l.CPU)
+	load.SetSlots(l.Slots)
+
+	return nil
+}
+
 func (h *Heartbeat) ReadMessage(m *capnp.Message) (err error) {
 	h.Heartbeat, err = api.ReadRootHeartbeat(m)
 	return
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
 )
 
 func TestHeartbeat_MarshalUnmarshal(t *testing.T) {
@@ -66,3 +67,27 @@ func TestHeartbeat_MarshalUnmarshal(t *testing.T) {
 		require.Equal(t, want, value)
 	}
 }
+
+func TestHeartbeat_Load(t *testing.T) {
+	t.Parallel()
+
+	h := pulse.NewHeartbeat()
+	assert.Zero(t, h.Load(), "should return zero value if load is unset")
+
+	want := routing.Load{
+		Procs:  3,
+		Memory: 1 << 24,
+		CPU:    0.75,
+		Slots:  13,
+	}
+
+	err := h.SetLoad(want)
+	require.NoError(t, err, "should set load")
+	assert.Equal(t, want, h.Load())
+
+	// Load is sampled on each tick, so the heartbeat is reused.
+	want.Procs++
+	err = h.SetLoad(want)
+	require.NoError(t, err, "should update load")
+	assert.Equal(t, want, h.Load())
+}
@@ -25,6 +25,12 @@ type Preparer interface {
 	Prepare(Heartbeat) error
 }
 
+// Sampler reports the resource usage of the local host.  It is called
+// each time a heartbeat is emitted.
+type Sampler interface {
+	Sample() routing.Load
+}
+
 func NewValidator(rt RoutingTable) pubsub.ValidatorEx {
 	return func(_ context.Context, _ peer.ID, m *pubsub.Message) pubsub.ValidationResult {
 		if rec, err := record(m); err == nil {
@@ -85,3 +85,40 @@ func (mr *MockPreparerMockRecorder) Prepare(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockPreparer)(nil).Prepare), arg0)
 }
+
+// MockSampler is a mock of Sampler interface.
+type MockSampler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSamplerMockRecorder
+}
+
+// MockSamplerMockRecorder is the mock recorder for MockSampler.
+type MockSamplerMockRecorder struct {
+	mock *MockSampler
+}
+
+// NewMockSampler creates a new mock instance.
+func NewMockSampler(ctrl *gomock.Controller) *MockSampler {
+	mock := &MockSampler{ctrl: ctrl}
+	mock.recorder = &MockSamplerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSampler) EXPECT() *MockSamplerMockRecorder {
+	return m.recorder
+}
+
+// Sample mocks base method.
+func (m *MockSampler) Sample() routing.Load {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sample")
+	ret0, _ := ret[0].(routing.Load)
+	return ret0
+}
+
+// Sample indicates an expected call of Sample.
+func (mr *MockSamplerMockRecorder) Sample() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sample", reflect.TypeOf((*MockSampler)(nil).Sample))
+}
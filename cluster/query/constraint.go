@@ -131,6 +131,9 @@ func matchIndex(index routing.Index) (matchFunc, error) {
 
 		// 	return err == nil && matchMeta(fieldEq, meta, routing.Meta(index))
 		// }, err
+
+	case "procs", "memory", "cpu", "slots":
+		return matchLoad(index)
 	}
 
 	return nil, fmt.Errorf("invalid index: %s", index)
@@ -238,6 +241,33 @@ func matchMeta(index routing.Index) (matchFunc, error) {
 
 }
 
+func matchLoad(index routing.Index) (matchFunc, error) {
+	ix, ok := index.(routing.LoadIndex)
+	if !ok {
+		return nil, errors.New("not a load index")
+	}
+
+	var (
+		want  = ix.Load()
+		field func(routing.Load) any
+	)
+
+	switch index.String() {
+	case "procs":
+		field = func(l routing.Load) any { return l.Procs }
+	case "memory":
+		field = func(l routing.Load) any { return l.Memory }
+	case "cpu":
+		field = func(l routing.Load) any { return l.CPU }
+	case "slots":
+		field = func(l routing.Load) any { return l.Slots }
+	}
+
+	return func(r routing.Record) bool {
+		return field(r.Load()) == field(want)
+	}, nil
+}
+
 type matchFunc func(routing.Record) bool
 
 func (match matchFunc) Match(r routing.Record) bool {
@@ -0,0 +1,194 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+// Op is a comparison operator for metadata predicates.
+type Op uint8
+
+const (
+	Exists Op = iota // field is present
+	Eq               // value is equal to
+	Prefix           // value begins with
+	Lt               // value is numerically less than
+	Lte              // value is numerically less than or equal to
+	Gt               // value is numerically greater than
+	Gte              // value is numerically greater than or equal to
+)
+
+// operators, in the order in which they are parsed.  Longer tokens
+// must precede their prefixes.
+var operators = []struct {
+	token string
+	op    Op
+}{
+	{"^=", Prefix},
+	{"<=", Lte},
+	{">=", Gte},
+	{"=", Eq},
+	{"<", Lt},
+	{">", Gt},
+}
+
+func (op Op) String() string {
+	switch op {
+	case Exists:
+		return ""
+	case Eq:
+		return "="
+	case Prefix:
+		return "^="
+	case Lt:
+		return "<"
+	case Lte:
+		return "<="
+	case Gt:
+		return ">"
+	case Gte:
+		return ">="
+	}
+
+	return fmt.Sprintf("<invalid op %d>", op)
+}
+
+// Numeric returns true if the operator compares numbers.
+func (op Op) Numeric() bool {
+	return op >= Lt && op <= Gte
+}
+
+// Predicate is a Matcher that tests the value of a metadata field.
+// Use with Where to filter a selection.
+type Predicate struct {
+	Key   string
+	Op    Op
+	Value string // ignored by Exists
+}
+
+// ParsePredicate parses a predicate of the form "key", "key=value",
+// "key^=prefix", or "key<n", where '<' is one of the numeric operators
+// <, <=, > and >=.  A bare key tests for the presence of the field.
+func ParsePredicate(s string) (Predicate, error) {
+	i := strings.IndexAny(s, "^<>=")
+	if i < 0 {
+		p := Predicate{Key: s, Op: Exists}
+		return p, p.Validate()
+	}
+
+	// The value may contain operator characters, so we split on the
+	// first operator.
+	for _, op := range operators {
+		if strings.HasPrefix(s[i:], op.token) {
+			p := Predicate{
+				Key:   s[:i],
+				Op:    op.op,
+				Value: s[i+len(op.token):],
+			}
+			return p, p.Validate()
+		}
+	}
+
+	return Predicate{}, fmt.Errorf("invalid operator in %q", s)
+}
+
+// Validate reports whether the predicate is well-formed.
+func (p Predicate) Validate() error {
+	if p.Key == "" {
+		return errors.New("missing key")
+	}
+
+	if p.Op > Gte {
+		return fmt.Errorf("invalid op: %d", p.Op)
+	}
+
+	if p.Op.Numeric() {
+		if _, err := strconv.ParseFloat(p.Value, 64); err != nil {
+			return fmt.Errorf("%s: not a number", p.Value)
+		}
+	}
+
+	return nil
+}
+
+func (p Predicate) String() string {
+	if p.Op == Exists {
+		return p.Key
+	}
+
+	return p.Key + p.Op.String() + p.Value
+}
+
+// Match returns true if the record's metadata satisfies the predicate.
+func (p Predicate) Match(r routing.Record) bool {
+	value, ok := lookupMeta(r, p.Key)
+	if !ok {
+		return false
+	}
+
+	switch p.Op {
+	case Exists:
+		return true
+
+	case Eq:
+		return value == p.Value
+
+	case Prefix:
+		return strings.HasPrefix(value, p.Value)
+	}
+
+	return p.compare(value)
+}
+
+func (p Predicate) compare(value string) bool {
+	x, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+
+	// Validate ensures p.Value is a number for well-formed predicates.
+	// Malformed predicates never match.
+	y, err := strconv.ParseFloat(p.Value, 64)
+	if err != nil {
+		return false
+	}
+
+	switch p.Op {
+	case Lt:
+		return x < y
+	case Lte:
+		return x <= y
+	case Gt:
+		return x > y
+	case Gte:
+		return x >= y
+	}
+
+	return false
+}
+
+// lookupMeta returns the value of the metadata field.  Unlike Meta.Get,
+// it distinguishes absent fields from fields with empty values.
+func lookupMeta(r routing.Record, key string) (string, bool) {
+	meta, err := r.Meta()
+	if err != nil {
+		return "", false
+	}
+
+	for i := 0; i < meta.Len(); i++ {
+		f, err := meta.At(i)
+		if err != nil {
+			return "", false
+		}
+
+		if f.Key == key {
+			return f.Value, true
+		}
+	}
+
+	return "", false
+}
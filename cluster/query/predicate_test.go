@@ -0,0 +1,85 @@
+package query_test
+
+import (
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cluster/query"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+func TestParsePredicate(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	for _, tt := range []struct {
+		expr string
+		want query.Predicate
+	}{
+		{"gpu", query.Predicate{Key: "gpu", Op: query.Exists}},
+		{"region=us-east", query.Predicate{Key: "region", Op: query.Eq, Value: "us-east"}},
+		{"region^=us-", query.Predicate{Key: "region", Op: query.Prefix, Value: "us-"}},
+		{"cpu<4", query.Predicate{Key: "cpu", Op: query.Lt, Value: "4"}},
+		{"cpu<=4", query.Predicate{Key: "cpu", Op: query.Lte, Value: "4"}},
+		{"cpu>4", query.Predicate{Key: "cpu", Op: query.Gt, Value: "4"}},
+		{"cpu>=4.5", query.Predicate{Key: "cpu", Op: query.Gte, Value: "4.5"}},
+		{"expr=a>=b", query.Predicate{Key: "expr", Op: query.Eq, Value: "a>=b"}},
+	} {
+		t.Run(tt.expr, func(t *testing.T) {
+			p, err := query.ParsePredicate(tt.expr)
+			require.NoError(t, err, "should parse predicate")
+			assert.Equal(t, tt.want, p)
+			assert.Equal(t, tt.expr, p.String(), "should round-trip")
+		})
+	}
+
+	for _, expr := range []string{"", "=foo", "cpu>four", "cpu^4"} {
+		_, err := query.ParsePredicate(expr)
+		assert.Error(t, err, "should fail to parse %q", expr)
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	r := &record{meta: newMeta("cpu=8", "region=us-east", "gpu=")}
+
+	for _, tt := range []struct {
+		expr  string
+		match bool
+	}{
+		{"gpu", true},
+		{"tpu", false},
+		{"region=us-east", true},
+		{"region=us", false},
+		{"region^=us-", true},
+		{"region^=eu-", false},
+		{"cpu>4", true},
+		{"cpu>8", false},
+		{"cpu>=8", true},
+		{"cpu<8", false},
+		{"cpu<=8", true},
+		{"cpu<16", true},
+		{"region>1", false}, // not a number
+		{"tpu<1", false},    // absent
+	} {
+		t.Run(tt.expr, func(t *testing.T) {
+			p, err := query.ParsePredicate(tt.expr)
+			require.NoError(t, err, "should parse predicate")
+			assert.Equal(t, tt.match, p.Match(r))
+		})
+	}
+}
+
+func newMeta(ss ...string) routing.Meta {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	meta, _ := capnp.NewTextList(seg, int32(len(ss)))
+	for i, s := range ss {
+		meta.Set(i, s)
+	}
+	return routing.Meta(meta)
+}
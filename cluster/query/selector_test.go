@@ -170,6 +170,7 @@ type record struct {
 	ins  uint64
 	host string
 	meta routing.Meta
+	load routing.Load
 	ttl  time.Duration
 }
 
@@ -215,6 +216,7 @@ func (r *record) TTL() time.Duration {
 }
 
 func (r *record) Meta() (routing.Meta, error) { return r.meta, nil }
+func (r *record) Load() routing.Load          { return r.load }
 
 func newPeerID() peer.ID {
 	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
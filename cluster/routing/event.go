@@ -0,0 +1,33 @@
+package routing
+
+import "fmt"
+
+// EventType designates a change in cluster membership.
+type EventType uint8
+
+const (
+	Join   EventType = iota // peer was added to the routing table
+	Update                  // peer's record was replaced by a newer one
+	Leave                   // peer's record expired
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Join:
+		return "join"
+	case Update:
+		return "update"
+	case Leave:
+		return "leave"
+	}
+
+	return fmt.Sprintf("<unknown event type %d>", t)
+}
+
+// Event is emitted by the routing table when a peer joins the
+// cluster, updates its record, or leaves the cluster.  For Leave
+// events, Record is the last record received from the peer.
+type Event struct {
+	Type   EventType
+	Record Record
+}
@@ -0,0 +1,17 @@
+package routing
+
+import "fmt"
+
+// Load describes the resource usage of a host.  It is advertised in
+// heartbeats, and is used to schedule processes across the cluster.
+type Load struct {
+	Procs  uint32  // number of running processes
+	Memory uint64  // bytes of memory in use by the WASM runtime
+	CPU    float32 // load average per CPU
+	Slots  uint32  // number of additional processes the host will run
+}
+
+func (l Load) String() string {
+	return fmt.Sprintf("procs=%d memory=%d cpu=%.2f slots=%d",
+		l.Procs, l.Memory, l.CPU, l.Slots)
+}
@@ -70,6 +70,7 @@ type Record interface {
 	TTL() time.Duration
 	Host() (string, error)
 	Meta() (Meta, error)
+	Load() Load
 }
 
 // Snapshot provides iteration strategies over an isolated snapshot
@@ -124,6 +125,14 @@ type MetaIndex interface {
 	MetaBytes() ([]byte, error)
 }
 
+// LoadIndex is an optional interface for Index that designates one
+// of the resource-usage indexes:  "procs", "memory", "cpu" or "slots".
+// The index value is the corresponding field of the returned Load.
+// Note that Record satisfies LoadIndex.
+type LoadIndex interface {
+	Load() Load
+}
+
 // Iterator is a stateful object that enumerates routing
 // records.  Iterator's methods are NOT guaranteed to be
 // thread-safe, but implementations MUST permit multiple
@@ -182,6 +191,21 @@ func (m Meta) Get(key string) (string, error) {
 	return "", nil
 }
 
+// Fields returns the parsed metadata fields.
+func (m Meta) Fields() ([]MetaField, error) {
+	fields := make([]MetaField, m.Len())
+	for i := range fields {
+		f, err := m.At(i)
+		if err != nil {
+			return nil, err
+		}
+
+		fields[i] = f
+	}
+
+	return fields, nil
+}
+
 // Index returns a set of indexes for the metadata fields.
 func (m Meta) Index() (indexes [][]byte, err error) {
 	var index []byte
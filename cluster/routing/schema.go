@@ -3,6 +3,7 @@ package routing
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 	"unsafe"
@@ -40,6 +41,22 @@ var schema = memdb.TableSchema{
 			AllowMissing: true,
 			Indexer:      metaIndexer{},
 		},
+		"procs": {
+			Name:    "procs",
+			Indexer: loadIndexer(procsKey),
+		},
+		"memory": {
+			Name:    "memory",
+			Indexer: loadIndexer(memoryKey),
+		},
+		"cpu": {
+			Name:    "cpu",
+			Indexer: loadIndexer(cpuKey),
+		},
+		"slots": {
+			Name:    "slots",
+			Indexer: loadIndexer(slotsKey),
+		},
 	},
 }
 
@@ -260,6 +277,58 @@ func (metaIndexer) PrefixFromArgs(args ...any) ([]byte, error) {
 	return metaIndexer{}.FromArgs(args...)
 }
 
+// loadIndexer indexes a field of the record's Load.  Keys are
+// big-endian, so that lexicographical order matches numerical
+// order, and range queries work as expected.
+type loadIndexer func(Load) uint64
+
+func (key loadIndexer) FromObject(obj any) (bool, []byte, error) {
+	if r, ok := obj.(LoadIndex); ok {
+		return true, key.bytes(r.Load()), nil
+	}
+
+	return false, nil, errType(obj)
+}
+
+func (key loadIndexer) FromArgs(args ...any) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, errNArgs(args)
+	}
+
+	switch arg := args[0].(type) {
+	case LoadIndex:
+		return key.bytes(arg.Load()), nil
+
+	case Load:
+		return key.bytes(arg), nil
+	}
+
+	return nil, errType(args[0])
+}
+
+func (key loadIndexer) bytes(load Load) []byte {
+	buf := pool.Get(8)
+	binary.BigEndian.PutUint64(buf, key(load))
+	return buf
+}
+
+func procsKey(load Load) uint64  { return uint64(load.Procs) }
+func memoryKey(load Load) uint64 { return load.Memory }
+func slotsKey(load Load) uint64  { return uint64(load.Slots) }
+
+// cpuKey maps the CPU load onto an unsigned integer that preserves
+// the ordering of floats.
+func cpuKey(load Load) uint64 {
+	bits := math.Float32bits(load.CPU)
+	if bits&(1<<31) != 0 {
+		bits = ^bits // negative; reverse order
+	} else {
+		bits |= 1 << 31 // positive; sort after negatives
+	}
+
+	return uint64(bits)
+}
+
 func stringToBytes(s string) []byte {
 	return *(*[]byte)(unsafe.Pointer(&s))
 }
@@ -400,12 +400,53 @@ func (t serverIndex) ServerBytes() ([]byte, error) {
 	return t.id.MarshalText()
 }
 
+func TestLoadIndexer(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("FromObject", func(t *testing.T) {
+		rec := testRecord{load: Load{Procs: 42}}
+		ok, index, err := loadIndexer(procsKey).FromObject(rec)
+		assert.NoError(t, err, "should index record")
+		assert.True(t, ok, "record should have load index")
+		assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 42}, index)
+
+		_, _, err = loadIndexer(procsKey).FromObject("fail")
+		assert.EqualError(t, err, "invalid type: string")
+	})
+
+	t.Run("FromArgs", func(t *testing.T) {
+		index, err := loadIndexer(slotsKey).FromArgs(Load{Slots: 1})
+		assert.NoError(t, err, "should accept Load")
+		assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1}, index)
+
+		_, err = loadIndexer(slotsKey).FromArgs()
+		assert.EqualError(t, err, "expected one argument (got 0)")
+
+		_, err = loadIndexer(slotsKey).FromArgs(uint32(1))
+		assert.EqualError(t, err, "invalid type: uint32")
+	})
+
+	t.Run("CPUOrder", func(t *testing.T) {
+		var prev uint64
+		for i, cpu := range []float32{-1, -0.5, 0, 0.25, 1, 64} {
+			key := cpuKey(Load{CPU: cpu})
+			if i > 0 {
+				assert.Greater(t, key, prev,
+					"key for %f should sort after previous key", cpu)
+			}
+			prev = key
+		}
+	})
+}
+
 type testRecord struct {
 	peer   peer.ID
 	server ID
 	seq    uint64
 	host   string
 	meta   Meta
+	load   Load
 	ttl    time.Duration
 }
 
@@ -414,6 +455,7 @@ func (r testRecord) Seq() uint64           { return r.seq }
 func (r testRecord) Server() ID            { return ID(r.server) }
 func (r testRecord) Host() (string, error) { return r.host, nil }
 func (r testRecord) Meta() (Meta, error)   { return r.meta, nil }
+func (r testRecord) Load() Load            { return r.load }
 
 func (r testRecord) TTL() time.Duration {
 	if r.ttl == 0 {
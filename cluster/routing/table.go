@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/wetware/pkg/util/stm"
+	"github.com/wetware/pkg/util/watch"
 )
 
 type clock struct {
@@ -16,6 +17,11 @@ type Table struct {
 	clock   *clock
 	records stm.TableRef
 	sched   stm.Scheduler
+	subs    *watch.Events[Event]
+
+	// writes serializes commits with the publication of their events,
+	// so that subscribers observe events in commit order.
+	writes *sync.Mutex
 }
 
 func (c *clock) Load() time.Time {
@@ -48,9 +54,20 @@ func New(t0 time.Time) Table {
 		clock:   clock,
 		records: records,
 		sched:   sched,
+		subs:    new(watch.Events[Event]),
+		writes:  new(sync.Mutex),
 	}
 }
 
+// Subscribe to Join, Update and Leave events.  Events are delivered
+// after the corresponding change has been committed to the table.
+// If the channel's buffer is full when an event is emitted, the
+// channel is closed and the subscription is dropped.  Callers MUST
+// call the returned function to cancel the subscription.
+func (table Table) Subscribe(buf int) (<-chan Event, func()) {
+	return table.subs.Subscribe(buf)
+}
+
 func (table Table) Snapshot() Snapshot {
 	return &query{
 		records: table.records,
@@ -66,10 +83,14 @@ func (table Table) Advance(t time.Time) {
 
 		// Most ticks will not have expired entries, so avoid locking.
 		if rx := table.sched.Txn(false); table.expiredRecords(rx, t) {
+			table.writes.Lock()
+			defer table.writes.Unlock()
+
 			wx := table.sched.Txn(true)
-			defer wx.Commit()
+			evs := table.dropExpired(wx, t)
+			wx.Commit()
 
-			table.dropExpired(wx, t)
+			table.subs.Publish(evs...)
 		}
 	}
 }
@@ -82,7 +103,7 @@ func (table Table) expiredRecords(tx stm.Txn, t time.Time) bool {
 	return it != nil && it.Next() != nil
 }
 
-func (table Table) dropExpired(wx stm.Txn, t time.Time) {
+func (table Table) dropExpired(wx stm.Txn, t time.Time) (evs []Event) {
 	it, err := wx.ReverseLowerBound(table.records, "ttl", t)
 	if err != nil {
 		panic(err)
@@ -93,7 +114,13 @@ func (table Table) dropExpired(wx stm.Txn, t time.Time) {
 			panic(err)
 		}
 
+		evs = append(evs, Event{
+			Type:   Leave,
+			Record: r.(*record).Record,
+		})
 	}
+
+	return
 }
 
 // Upsert inserts a record in the routing table, updating it
@@ -102,10 +129,21 @@ func (table Table) Upsert(rec Record) bool {
 	// Some records are stale, so avoid locking until we're
 	// sure to write.
 	if rx := table.sched.Txn(false); table.valid(rx, rec) {
+		table.writes.Lock()
+		defer table.writes.Unlock()
+
+		// A concurrent writer may have committed a newer record
+		// since we checked.
 		wx := table.sched.Txn(true)
-		defer wx.Commit()
+		if !table.valid(wx, rec) {
+			wx.Abort()
+			return false
+		}
+
+		ev := table.upsert(wx, rec)
+		wx.Commit()
 
-		table.upsert(wx, rec)
+		table.subs.Publish(ev)
 		return true
 	}
 
@@ -130,11 +168,20 @@ func (table Table) valid(tx stm.Txn, rec Record) bool {
 	return old.Seq() > rec.Seq()
 }
 
-func (table Table) upsert(wx stm.Txn, rec Record) {
+func (table Table) upsert(wx stm.Txn, rec Record) Event {
+	ev := Event{Type: Join, Record: rec}
+	if v, err := wx.First(table.records, "id", rec); err != nil {
+		panic(err)
+	} else if v != nil {
+		ev.Type = Update
+	}
+
 	err := wx.Insert(table.records, table.withDeadline(rec))
 	if err != nil {
 		panic(err)
 	}
+
+	return ev
 }
 
 // record wraps a Record and provides a stable deadline, calculated
@@ -109,6 +109,138 @@ func TestRoutingTable_advance(t *testing.T) {
 	}
 }
 
+func TestRoutingTable_subscribe(t *testing.T) {
+	t.Parallel()
+
+	table := routing.New(t0)
+
+	events, cancel := table.Subscribe(8)
+	defer cancel()
+
+	rec := &record{ttl: time.Millisecond * 10}
+	require.True(t, table.Upsert(rec), "must upsert record")
+	require.False(t, table.Upsert(rec), "must reject stale record")
+
+	update := &record{id: rec.id, ins: rec.ins, seq: 1, ttl: time.Millisecond * 10}
+	require.True(t, table.Upsert(update), "must upsert updated record")
+
+	// See TestRoutingTable_advance for why we add 1ns.
+	table.Advance(t0.Add(time.Millisecond*10 + 1))
+
+	for _, want := range []routing.Event{
+		{Type: routing.Join, Record: rec},
+		{Type: routing.Update, Record: update},
+		{Type: routing.Leave, Record: update},
+	} {
+		select {
+		case got := <-events:
+			assert.Equal(t, want.Type, got.Type, "should receive %s event", want.Type)
+			assert.Equal(t, want.Record, got.Record, "should match %s record", want.Type)
+		default:
+			t.Fatalf("should receive %s event", want.Type)
+		}
+	}
+
+	select {
+	case ev := <-events:
+		t.Errorf("unexpected %s event", ev.Type)
+	default:
+	}
+}
+
+func TestRoutingTable_subscribe_order(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+	table := routing.New(t0)
+
+	events, cancel := table.Subscribe(n)
+	defer cancel()
+
+	// Concurrent writers race to upsert successive records from
+	// the same instance.
+	rec := &record{}
+	var wg sync.WaitGroup
+	for _, seq := range rand.Perm(n) {
+		wg.Add(1)
+		go func(seq uint64) {
+			defer wg.Done()
+			table.Upsert(&record{id: rec.Peer(), ins: uint64(rec.Server()), seq: seq})
+		}(uint64(seq))
+	}
+	wg.Wait()
+	cancel()
+
+	var last int64 = -1
+	for ev := range events {
+		seq := int64(ev.Record.Seq())
+		assert.Greater(t, seq, last, "should publish events in commit order")
+		last = seq
+	}
+}
+
+func TestRoutingTable_subscribe_overflow(t *testing.T) {
+	t.Parallel()
+
+	table := routing.New(t0)
+
+	events, cancel := table.Subscribe(1)
+	defer cancel()
+
+	require.True(t, table.Upsert(&record{}), "must upsert record")
+	require.True(t, table.Upsert(&record{}), "must upsert record")
+
+	_, ok := <-events
+	require.True(t, ok, "should receive buffered event")
+
+	_, ok = <-events
+	assert.False(t, ok, "should drop subscriber that fell behind")
+
+	cancel() // should not panic after subscription was dropped
+}
+
+func TestRoutingTable_load(t *testing.T) {
+	t.Parallel()
+
+	table := routing.New(t0)
+
+	for _, load := range []routing.Load{
+		{Procs: 8, Slots: 0, CPU: 1.5, Memory: 1 << 30},
+		{Procs: 0, Slots: 16, CPU: 0.25, Memory: 0},
+		{Procs: 2, Slots: 300, CPU: 0.5, Memory: 1 << 20},
+	} {
+		require.True(t, table.Upsert(&record{load: load}), "must upsert record")
+	}
+
+	for _, tt := range []struct {
+		index string
+		from  routing.Load
+		want  []uint32 // procs, in iteration order
+	}{
+		{index: "procs", want: []uint32{0, 2, 8}},
+		{index: "procs", from: routing.Load{Procs: 1}, want: []uint32{2, 8}},
+		{index: "slots", want: []uint32{8, 0, 2}},
+		{index: "slots", from: routing.Load{Slots: 17}, want: []uint32{2}},
+		{index: "cpu", want: []uint32{0, 2, 8}},
+		{index: "cpu", from: routing.Load{CPU: 1}, want: []uint32{8}},
+		{index: "memory", from: routing.Load{Memory: 1}, want: []uint32{2, 8}},
+	} {
+		it, err := table.Snapshot().LowerBound(loadIndex{
+			name: tt.index,
+			load: tt.from,
+		})
+		require.NoError(t, err, "should iterate over %s index", tt.index)
+
+		var got []uint32
+		for r := it.Next(); r != nil; r = it.Next() {
+			got = append(got, r.Load().Procs)
+		}
+
+		assert.Equal(t, tt.want, got,
+			"should sort by %s, starting from %s", tt.index, tt.from)
+	}
+}
+
 func TestRegression_ttl_index(t *testing.T) {
 	t.Parallel()
 
@@ -297,6 +429,15 @@ func (r *benchmarkRecord) Peer() peer.ID               { return r.id }
 func (r *benchmarkRecord) PeerBytes() ([]byte, error)  { return r.idBytes, nil }
 func (r *benchmarkRecord) Meta() (routing.Meta, error) { return r.Heartbeat.Meta() }
 
+type loadIndex struct {
+	name string
+	load routing.Load
+}
+
+func (ix loadIndex) String() string     { return ix.name }
+func (ix loadIndex) Prefix() bool       { return false }
+func (ix loadIndex) Load() routing.Load { return ix.load }
+
 type record struct {
 	once sync.Once
 	id   peer.ID
@@ -304,6 +445,7 @@ type record struct {
 	ins  uint64
 	host string
 	meta routing.Meta
+	load routing.Load
 	ttl  time.Duration
 }
 
@@ -349,6 +491,7 @@ func (r *record) TTL() time.Duration {
 }
 
 func (r *record) Meta() (routing.Meta, error) { return r.meta, nil }
+func (r *record) Load() routing.Load          { return r.load }
 
 func (r *record) PeerBytes() ([]byte, error) {
 	r.init()
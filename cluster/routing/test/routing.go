@@ -51,6 +51,20 @@ func (mr *MockRecordMockRecorder) Host() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Host", reflect.TypeOf((*MockRecord)(nil).Host))
 }
 
+// Load mocks base method.
+func (m *MockRecord) Load() routing.Load {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(routing.Load)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockRecordMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockRecord)(nil).Load))
+}
+
 // Meta mocks base method.
 func (m *MockRecord) Meta() (routing.Meta, error) {
 	m.ctrl.T.Helper()
@@ -408,6 +422,43 @@ func (mr *MockMetaIndexMockRecorder) MetaBytes() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetaBytes", reflect.TypeOf((*MockMetaIndex)(nil).MetaBytes))
 }
 
+// MockLoadIndex is a mock of LoadIndex interface.
+type MockLoadIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadIndexMockRecorder
+}
+
+// MockLoadIndexMockRecorder is the mock recorder for MockLoadIndex.
+type MockLoadIndexMockRecorder struct {
+	mock *MockLoadIndex
+}
+
+// NewMockLoadIndex creates a new mock instance.
+func NewMockLoadIndex(ctrl *gomock.Controller) *MockLoadIndex {
+	mock := &MockLoadIndex{ctrl: ctrl}
+	mock.recorder = &MockLoadIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadIndex) EXPECT() *MockLoadIndexMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockLoadIndex) Load() routing.Load {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(routing.Load)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockLoadIndexMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockLoadIndex)(nil).Load))
+}
+
 // MockIterator is a mock of Iterator interface.
 type MockIterator struct {
 	ctrl     *gomock.Controller
@@ -134,6 +134,21 @@ func (mr *MockRoutingTableMockRecorder) Snapshot() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockRoutingTable)(nil).Snapshot))
 }
 
+// Subscribe mocks base method.
+func (m *MockRoutingTable) Subscribe(buf int) (<-chan routing.Event, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", buf)
+	ret0, _ := ret[0].(<-chan routing.Event)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockRoutingTableMockRecorder) Subscribe(buf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockRoutingTable)(nil).Subscribe), buf)
+}
+
 // Upsert mocks base method.
 func (m *MockRoutingTable) Upsert(arg0 routing.Record) bool {
 	m.ctrl.T.Helper()
@@ -0,0 +1,225 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/discovery"
+	local "github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/urfave/cli/v2"
+
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/query"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/proto"
+	"github.com/wetware/pkg/vat"
+)
+
+const discoveryTimeout = 10 * time.Second
+
+// ErrNodeNotFound is returned when no cluster node satisfies the
+// node-selection flags.
+var ErrNodeNotFound = errors.New("node not found")
+
+// nodeFlags select the cluster node on which a command operates.
+// When several flags are set, the node must satisfy all of them.
+var nodeFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:     "id",
+		Usage:    "select node by peer `ID`",
+		Category: "NODE",
+	},
+	&cli.StringFlag{
+		Name:     "server",
+		Usage:    "select node by routing `ID`",
+		Category: "NODE",
+	},
+	&cli.StringFlag{
+		Name:     "hostname",
+		Usage:    "select node by `HOSTNAME`",
+		Category: "NODE",
+	},
+	&cli.StringSliceFlag{
+		Name:     "meta",
+		Usage:    "select node by metadata `PREDICATE` (e.g. region=us-east, cpu>4)",
+		Category: "NODE",
+	},
+}
+
+// selection is a set of constraints on a cluster node, parsed from
+// nodeFlags.
+type selection struct {
+	indexes []routing.Index
+	where   []query.Predicate
+	matches []func(routing.Record) bool
+}
+
+func newSelection(c *cli.Context) (s selection, err error) {
+	if c.IsSet("id") {
+		var id peer.ID
+		if id, err = peer.Decode(c.String("id")); err != nil {
+			return s, fmt.Errorf("peer id: %w", err)
+		}
+
+		s.add(peerIndex(id), func(r routing.Record) bool {
+			return r.Peer() == id
+		})
+	}
+
+	if c.IsSet("server") {
+		var id routing.ID
+		if err = id.UnmarshalText([]byte(c.String("server"))); err != nil {
+			return s, fmt.Errorf("routing id: %w", err)
+		}
+
+		s.add(serverIndex(id), func(r routing.Record) bool {
+			return r.Server() == id
+		})
+	}
+
+	if c.IsSet("hostname") {
+		name := c.String("hostname")
+		s.add(hostIndex(name), func(r routing.Record) bool {
+			host, err := r.Host()
+			return err == nil && host == name
+		})
+	}
+
+	for _, expr := range c.StringSlice("meta") {
+		var p query.Predicate
+		if p, err = query.ParsePredicate(expr); err != nil {
+			return s, fmt.Errorf("meta: %w", err)
+		}
+
+		s.where = append(s.where, p)
+		s.matches = append(s.matches, p.Match)
+	}
+
+	return s, nil
+}
+
+func (s *selection) add(ix routing.Index, match func(routing.Record) bool) {
+	s.indexes = append(s.indexes, ix)
+	s.matches = append(s.matches, match)
+}
+
+// Empty returns true if no node-selection flags were passed.
+func (s selection) Empty() bool {
+	return len(s.indexes) == 0 && len(s.where) == 0
+}
+
+// Query returns a view query that selects candidate nodes.  Metadata
+// predicates are evaluated by the server, but the candidates must be
+// further filtered with Match.
+func (s selection) Query() view.Query {
+	sel := view.All()
+	if len(s.indexes) > 0 {
+		sel = view.Match(s.indexes[0])
+	}
+
+	cs := make([]view.Constraint, len(s.where))
+	for i, p := range s.where {
+		cs[i] = view.Where(p)
+	}
+
+	return view.NewQuery(sel, cs...)
+}
+
+// Match reports whether the record satisfies every constraint.
+func (s selection) Match(r routing.Record) bool {
+	for _, match := range s.matches {
+		if !match(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Find the first node in the view that matches the selection.
+func (s selection) Find(ctx context.Context, v view.View) (peer.ID, error) {
+	it, release := v.Iter(ctx, s.Query())
+	defer release()
+
+	for r := it.Next(); r != nil; r = it.Next() {
+		if s.Match(r) {
+			return r.Peer(), nil
+		}
+	}
+
+	if it.Err() != nil {
+		return "", it.Err()
+	}
+
+	return "", ErrNodeNotFound
+}
+
+// dialNode logs into the cluster node that matches the node-selection
+// flags.  If no flags were passed, it logs into the first node found
+// through the bootstrap service.
+func dialNode(c *cli.Context, h local.Host, d discovery.Discoverer) (auth.Session, error) {
+	s, err := newSelection(c)
+	if err != nil {
+		return auth.Session{}, err
+	}
+
+	dialer := vat.Dialer{
+		Host:    h,
+		Account: auth.SignerFromHost(h),
+	}
+
+	sess, err := dialer.DialDiscover(c.Context, d, c.String("ns"))
+	if err != nil || s.Empty() {
+		return sess, err
+	}
+
+	id, err := s.Find(c.Context, sess.View())
+	if err != nil || id == sess.Peer() {
+		return sess, err
+	}
+	sess.Logout()
+
+	// Look up the target's addresses through the bootstrap service.
+	// If discovery does not turn up the target, fall back on any
+	// addresses already present in the peerstore.
+	ctx, cancel := context.WithTimeout(c.Context, discoveryTimeout)
+	defer cancel()
+
+	addr := peer.AddrInfo{ID: id}
+	if peers, err := d.FindPeers(ctx, c.String("ns")); err == nil {
+		for info := range peers {
+			if info.ID == id {
+				addr = info
+				break
+			}
+		}
+	}
+
+	return dialer.Dial(c.Context, addr, proto.Namespace(c.String("ns"))...)
+}
+
+/*
+	Indexes
+*/
+
+type peerIndex peer.ID
+
+func (peerIndex) String() string   { return "id" }
+func (peerIndex) Prefix() bool     { return false }
+func (ix peerIndex) Peer() peer.ID { return peer.ID(ix) }
+
+type serverIndex routing.ID
+
+func (serverIndex) String() string        { return "server" }
+func (serverIndex) Prefix() bool          { return false }
+func (ix serverIndex) Server() routing.ID { return routing.ID(ix) }
+
+type hostIndex string
+
+func (hostIndex) String() string                { return "host" }
+func (hostIndex) Prefix() bool                  { return false }
+func (ix hostIndex) HostBytes() ([]byte, error) { return []byte(ix), nil }
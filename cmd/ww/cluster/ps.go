@@ -0,0 +1,99 @@
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/vat"
+)
+
+// Ps returns the command that lists the processes running on a
+// cluster node.
+func Ps() *cli.Command {
+	return &cli.Command{
+		Name:  "ps",
+		Usage: "list processes on a cluster node",
+		Flags: append([]cli.Flag{
+			&cli.BoolFlag{
+				Name:    "watch",
+				Aliases: []string{"w"},
+				Usage:   "stream process lifecycle events",
+			},
+		}, nodeFlags...),
+		Action: psAction,
+	}
+}
+
+func psAction(c *cli.Context) error {
+	h, err := vat.DialP2P()
+	if err != nil {
+		return err
+	}
+	defer h.Close()
+
+	bootstrap, err := newBootstrap(c, h)
+	if err != nil {
+		return fmt.Errorf("discovery: %w", err)
+	}
+	defer bootstrap.Close()
+
+	sess, err := dialNode(c, h, bootstrap)
+	if err != nil {
+		return err
+	}
+	defer sess.Logout()
+
+	ex := sess.Executor()
+	if c.Bool("watch") {
+		return watchProcs(c, ex)
+	}
+
+	procs, err := ex.Ps(c.Context)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PID\tPPID\tSTATE\tUPTIME\tMEMORY\tCALLS\tCID\tARGS")
+	for _, p := range procs {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%d\t%d\t%s\t%s\n",
+			p.Pid,
+			p.Ppid,
+			p.State,
+			time.Since(p.Start).Round(time.Second),
+			p.Memory,
+			p.Calls,
+			p.Cid,
+			strings.Join(p.Args, " "))
+	}
+
+	return w.Flush()
+}
+
+func watchProcs(c *cli.Context, ex csp.Executor) error {
+	w, release := ex.Watch(c.Context)
+	defer release()
+
+	for ev, ok := w.Next(); ok; ev, ok = w.Next() {
+		renderEvent(c.App.Writer, ev)
+	}
+
+	return w.Err()
+}
+
+func renderEvent(w io.Writer, ev csp.ProcEvent) {
+	switch ev.Type {
+	case csp.Exit:
+		fmt.Fprintf(w, "%s pid=%d ppid=%d code=%d\n",
+			ev.Type, ev.Info.Pid, ev.Info.Ppid, ev.ExitCode)
+	default:
+		fmt.Fprintf(w, "%s pid=%d ppid=%d cid=%s\n",
+			ev.Type, ev.Info.Pid, ev.Info.Ppid, ev.Info.Cid)
+	}
+}
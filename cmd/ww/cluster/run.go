@@ -1,11 +1,20 @@
 package cluster
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 
+	"capnproto.org/go/capnp/v3"
 	"github.com/urfave/cli/v2"
+
+	"github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/vat"
 )
 
 const killTimeout = 30 * time.Second
@@ -14,77 +23,206 @@ func run() *cli.Command {
 	return &cli.Command{
 		Name:      "run",
 		Usage:     "run a WASM module on a cluster node",
-		ArgsUsage: "<path> (defaults to stdin)",
+		ArgsUsage: "<path> [args...] (defaults to stdin)",
+		Flags:     append(append(limitFlags, runFlags...), nodeFlags...),
 		Action:    runAction(),
 	}
 }
 
+// limitFlags set the resource limits of the process.  Unset limits
+// take the node's defaults.
+var limitFlags = []cli.Flag{
+	&cli.UintFlag{
+		Name:     "memory",
+		Usage:    "maximum linear memory, in 64KiB `PAGES`",
+		Category: "LIMITS",
+	},
+	&cli.DurationFlag{
+		Name:     "timeout",
+		Usage:    "terminate the process after `DURATION`",
+		Category: "LIMITS",
+	},
+	&cli.Uint64Flag{
+		Name:     "calls",
+		Usage:    "maximum number of guest function `CALLS` (not instructions)",
+		Category: "LIMITS",
+	},
+}
+
+var runFlags = []cli.Flag{
+	&cli.DurationFlag{
+		Name:  "grace",
+		Usage: "on interrupt, give the process `DURATION` to exit before killing it",
+	},
+	&cli.StringSliceFlag{
+		Name:  "cap",
+		Usage: "grant session capability `NAME` to the process, instead of the full session",
+	},
+}
+
+// sessionCaps returns the session capabilities named by the --cap
+// flag.  The session retains ownership of the clients.
+func sessionCaps(c *cli.Context, sess auth.Session) ([]csp.Cap, error) {
+	var caps []csp.Cap
+	for _, name := range c.StringSlice("cap") {
+		var client capnp.Client
+		switch name {
+		case "view":
+			client = capnp.Client(sess.View())
+		case "executor":
+			client = capnp.Client(sess.Executor())
+		case "pubsub":
+			client = capnp.Client(sess.PubSub())
+		case "capstore":
+			client = capnp.Client(sess.CapStore())
+		case "anchor":
+			client = capnp.Client(sess.Anchor())
+		case "scheduler":
+			client = capnp.Client(sess.Scheduler())
+		default:
+			return nil, fmt.Errorf("unknown capability: %s", name)
+		}
+
+		caps = append(caps, csp.Cap{Name: name, Client: client})
+	}
+
+	return caps, nil
+}
+
+func limits(c *cli.Context) csp.Limits {
+	return csp.Limits{
+		MemoryPages: uint32(c.Uint("memory")),
+		Timeout:     c.Duration("timeout"),
+		Calls:       c.Uint64("calls"),
+	}
+}
+
 func runAction() cli.ActionFunc {
 	return func(c *cli.Context) error {
-		return nil
-
-		// // Load the name of the entry function and the WASM file containing
-		// // the module to run.
-		// rom, err := bytecode(c)
-		// if err != nil {
-		// 	return err
-		// }
-
-		// // Set up the wetware client and dial into the cluster.
-		// h, err := vat.DialP2P()
-		// if err != nil {
-		// 	return err
-		// }
-		// defer h.Close()
-
-		// // Connect to peers.
-		// bootstrap, err := newBootstrap(c, h)
-		// if err != nil {
-		// 	return fmt.Errorf("discovery: %w", err)
-		// }
-		// defer bootstrap.Close()
-
-		// // Login into the wetware cluster.
-		// sess, err = vat.Dialer{
-		// 	Host:    h,
-		// 	Account: auth.SignerFromHost(h),
-		// }.DialDiscover(c.Context, bootstrap, c.String("ns"))
-		// if err != nil {
-		// 	return err
-		// }
-
-		// view := sess.Cluster()
-		// defer view.Release()
-
-		// // Prepare argv for the process.
-		// args := []string{}
-		// if c.Args().Len() > 1 {
-		// 	args = append(args, c.Args().Slice()[1:]...)
-		// }
-
-		// // Run remote process.
-		// // TODO: find a way to bind an anchor to an execution context.
-		// proc, release := host.Exec().Exec(c.Context, core.Session(sess), rom, 0, args...)
-		// defer release()
-
-		// // Wait for remote process to end.
-		// waitChan := make(chan error, 1)
-		// go func() {
-		// 	waitChan <- proc.Wait(c.Context)
-		// }()
-		// select {
-		// case err = <-waitChan:
-		// 	return err
-		// case <-c.Context.Done():
-		// 	killChan := make(chan error, 1)
-		// 	go func() { killChan <- proc.Kill(context.Background()) }()
-		// 	select {
-		// 	case err = <-killChan:
-		// 		return err
-		// 	case <-time.After(killTimeout):
-		// 		return err
-		// 	}
-		// }
+		// Load the WASM file containing the module to run.
+		rom, err := bytecode(c)
+		if err != nil {
+			return err
+		}
+
+		// Set up the wetware client and dial into the cluster.
+		h, err := vat.DialP2P()
+		if err != nil {
+			return err
+		}
+		defer h.Close()
+
+		// Connect to peers.
+		bootstrap, err := newBootstrap(c, h)
+		if err != nil {
+			return fmt.Errorf("discovery: %w", err)
+		}
+		defer bootstrap.Close()
+
+		// Login into the selected cluster node.
+		sess, err = dialNode(c, h, bootstrap)
+		if err != nil {
+			return err
+		}
+		defer sess.Logout()
+
+		// Prepare argv for the process.
+		var args []string
+		if c.Args().Len() > 1 {
+			args = append(args, c.Args().Slice()[1:]...)
+		}
+
+		// Grant the process the requested capabilities.  If none were
+		// requested, it is served the full session.
+		caps, err := sessionCaps(c, sess)
+		if err != nil {
+			return err
+		}
+
+		root := core.Session(sess)
+		if len(caps) > 0 {
+			root = core.Session{}
+		}
+
+		// Run remote process.  Note that c.Context is canceled when
+		// the user interrupts the command, so RPCs that must outlive
+		// the interrupt are bound to a background context.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		proc, release := sess.Executor().Exec(ctx, root, rom, 0, limits(c), caps, args...)
+		defer release()
+
+		// Stream the process' output to the terminal.
+		output := copyOutput(ctx, proc, c.App.Writer, c.App.ErrWriter)
+
+		// Wait for remote process to end.
+		waitChan := make(chan error, 1)
+		go func() {
+			waitChan <- proc.Wait(ctx)
+		}()
+
+		select {
+		case err = <-waitChan:
+			<-output // flush remaining output
+			return err
+		case <-c.Context.Done():
+			return kill(proc, c.Duration("grace"), waitChan)
+		}
+	}
+}
+
+// copyOutput copies the process' stdout and stderr to the supplied
+// writers.  The returned channel is closed when both streams have
+// been exhausted.
+func copyOutput(ctx context.Context, proc csp.Proc, stdout, stderr io.Writer) <-chan struct{} {
+	outR, releaseOut := proc.Stdout(ctx)
+	errR, releaseErr := proc.Stderr(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer releaseOut()
+		io.Copy(stdout, outR)
+	}()
+	go func() {
+		defer wg.Done()
+		defer releaseErr()
+		io.Copy(stderr, errR)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wg.Wait()
+	}()
+
+	return done
+}
+
+// kill the process and wait for it to exit, giving up after killTimeout.
+// If grace is non-zero, the process is first asked to terminate, and is
+// killed if it has not exited after grace.
+func kill(proc csp.Proc, grace time.Duration, waitChan <-chan error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), grace+killTimeout)
+	defer cancel()
+
+	var err error
+	if grace > 0 {
+		err = proc.Terminate(ctx, grace)
+	} else {
+		err = proc.Kill(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-waitChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -80,6 +80,7 @@ var app = cli.App{
 		run.Command(),
 		start.Command(),
 		cluster.Command(),
+		cluster.Ps(),
 	},
 }
 
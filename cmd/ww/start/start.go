@@ -16,6 +16,7 @@ import (
 
 	"github.com/wetware/pkg/auth"
 	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/cap/anchor"
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/routing"
 	"github.com/wetware/pkg/vat"
@@ -38,6 +39,36 @@ var flags = []cli.Flag{
 		Usage:   "metadata fields in key=value format",
 		EnvVars: []string{"WW_META"},
 	},
+	&cli.PathFlag{
+		Name:    "rom-dir",
+		Usage:   "persist bytecode in `DIR`",
+		EnvVars: []string{"WW_ROM_DIR"},
+	},
+	&cli.UintFlag{
+		Name:    "max-procs",
+		Usage:   "maximum number of concurrent processes (0 = unbounded)",
+		EnvVars: []string{"WW_MAX_PROCS"},
+	},
+	&cli.IntFlag{
+		Name:    "anchor-max-depth",
+		Usage:   "maximum depth of the anchor tree (0 = unbounded)",
+		EnvVars: []string{"WW_ANCHOR_MAX_DEPTH"},
+	},
+	&cli.IntFlag{
+		Name:    "anchor-max-children",
+		Usage:   "maximum number of children per anchor (0 = unbounded)",
+		EnvVars: []string{"WW_ANCHOR_MAX_CHILDREN"},
+	},
+	&cli.IntFlag{
+		Name:    "anchor-max-nodes",
+		Usage:   "maximum number of anchors in the tree (0 = unbounded)",
+		EnvVars: []string{"WW_ANCHOR_MAX_NODES"},
+	},
+	&cli.IntFlag{
+		Name:    "anchor-max-path-len",
+		Usage:   "maximum length of an anchor path, in bytes (0 = unbounded)",
+		EnvVars: []string{"WW_ANCHOR_MAX_PATH_LEN"},
+	},
 }
 
 func Command() *cli.Command {
@@ -87,13 +118,29 @@ func serve(c *cli.Context) error {
 	}
 	defer bootstrap.Close()
 
+	host := routedhost.Wrap(h, dht)
+
+	// Bytecode is exchanged with peers over BitSwap, so that processes
+	// can be spawned from the CIDs of bytecode held by other nodes.
+	blocks := vat.NewBlockService(c.Context, host, dht, c.String("ns"))
+	defer blocks.Close()
+
 	return vat.Config{
 		NS:        c.String("ns"),
-		Host:      routedhost.Wrap(h, dht),
+		Host:      host,
 		Bootstrap: bootstrap,
 		Ambient:   ambient(dht),
 		Meta:      meta,
 		Auth:      auth.AllowAll,
+		MaxProcs:  uint32(c.Uint("max-procs")),
+		ROMDir:    c.Path("rom-dir"),
+		Blocks:    blocks,
+		AnchorLimits: anchor.Limits{
+			MaxDepth:    c.Int("anchor-max-depth"),
+			MaxChildren: c.Int("anchor-max-children"),
+			MaxNodes:    c.Int("anchor-max-nodes"),
+			MaxPathLen:  c.Int("anchor-max-path-len"),
+		},
 	}.Serve(c.Context)
 }
 
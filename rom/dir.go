@@ -0,0 +1,83 @@
+package rom
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/go-cid"
+)
+
+// ErrCorrupt is returned when stored bytecode does not match its CID.
+var ErrCorrupt = errors.New("corrupt rom")
+
+// Dir stores ROMs in a directory on the local filesystem.  Each ROM
+// is stored in a file named after its CID.  Dir is safe for concurrent
+// use, including by multiple processes.
+type Dir struct {
+	Path string
+}
+
+// Put stores the bytecode, and returns its CID.  The directory is
+// created if it does not exist.  Put is idempotent.
+func (d Dir) Put(bc []byte) (cid.Cid, error) {
+	id := ROM{Bytecode: bc}.CID()
+	if ok, err := d.Has(id); ok || err != nil {
+		return id, err
+	}
+
+	if err := os.MkdirAll(d.Path, 0o755); err != nil {
+		return cid.Undef, err
+	}
+
+	// Write to a temporary file, and rename it, so that readers never
+	// observe a partially-written ROM.
+	f, err := os.CreateTemp(d.Path, ".rom-*")
+	if err != nil {
+		return cid.Undef, err
+	}
+	defer os.Remove(f.Name()) // no-op after rename
+
+	if _, err = f.Write(bc); err != nil {
+		f.Close()
+		return cid.Undef, err
+	}
+
+	if err = f.Close(); err != nil {
+		return cid.Undef, err
+	}
+
+	return id, os.Rename(f.Name(), d.path(id))
+}
+
+// Get returns the bytecode for the CID.  It verifies the bytecode's
+// hash against the CID, and returns ErrCorrupt if they do not match.
+// If the ROM is not stored, the error satisfies os.IsNotExist.
+func (d Dir) Get(id cid.Cid) ([]byte, error) {
+	bc, err := os.ReadFile(d.path(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if got := (ROM{Bytecode: bc}).CID(); !got.Equals(id) {
+		return nil, fmt.Errorf("%w: %s has cid %s", ErrCorrupt, id, got)
+	}
+
+	return bc, nil
+}
+
+// Has reports whether the ROM is stored.  It does not verify the
+// stored bytecode.
+func (d Dir) Has(id cid.Cid) (bool, error) {
+	_, err := os.Stat(d.path(id))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (d Dir) path(id cid.Cid) string {
+	return filepath.Join(d.Path, id.String())
+}
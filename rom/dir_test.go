@@ -0,0 +1,49 @@
+package rom_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/rom"
+)
+
+func TestDir(t *testing.T) {
+	t.Parallel()
+
+	d := rom.Dir{Path: filepath.Join(t.TempDir(), "roms")}
+	bc := []byte("bytecode")
+	want := rom.ROM{Bytecode: bc}.CID()
+
+	ok, err := d.Has(want)
+	require.NoError(t, err, "should succeed if directory does not exist")
+	assert.False(t, ok, "should not have rom")
+
+	_, err = d.Get(want)
+	assert.True(t, os.IsNotExist(err), "should report missing rom")
+
+	id, err := d.Put(bc)
+	require.NoError(t, err, "should create directory and store rom")
+	assert.Equal(t, want, id, "should return rom CID")
+
+	_, err = d.Put(bc)
+	require.NoError(t, err, "should be idempotent")
+
+	ok, err = d.Has(id)
+	require.NoError(t, err)
+	assert.True(t, ok, "should have rom")
+
+	got, err := d.Get(id)
+	require.NoError(t, err, "should read rom")
+	assert.Equal(t, bc, got)
+
+	// Corrupt the stored rom.
+	err = os.WriteFile(filepath.Join(d.Path, id.String()), []byte("corrupt"), 0o644)
+	require.NoError(t, err)
+
+	_, err = d.Get(id)
+	assert.ErrorIs(t, err, rom.ErrCorrupt, "should verify hash on read")
+}
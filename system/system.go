@@ -23,6 +23,28 @@ func Instantiate(ctx context.Context, r wazero.Runtime, vat io.WriteCloser) (*wa
 		WithWriter(vat))
 }
 
+// Compile the host module, and instantiate it in r.  Runtimes that host
+// several guests should call Compile once, and bind each guest to its
+// own socket by calling Instantiate on the compiled module.  Unlike the
+// package-level Instantiate, this is safe for concurrent use.
+func Compile(ctx context.Context, r wazero.Runtime) (*wazergo.CompiledModule[*Host], error) {
+	c, err := wazergo.Compile(ctx, r, HostModule)
+	if err != nil {
+		return nil, err
+	}
+
+	// The compiled module instantiates the host module in r only if
+	// it is missing, which races when guests are spawned concurrently.
+	_, err = r.InstantiateModule(ctx, c.CompiledModule, wazero.NewModuleConfig().
+		WithStartFunctions())
+	if err != nil {
+		c.Close(ctx)
+		return nil, err
+	}
+
+	return c, nil
+}
+
 // The `functions` type impements `HostModule[*Module]`, providing the
 // module name, map of exported functions, and the ability to create instances
 // of the module type.
@@ -0,0 +1,172 @@
+// Package watch implements the event streams behind the Watch methods
+// of capabilities.  Servers publish events to a set of subscribers,
+// and stream each subscription to a remote handler.  Clients buffer
+// the events received by the handler for consumption by an iterator.
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Buffer is the number of events that a server buffers for each
+// watcher before dropping it.
+const Buffer = 64
+
+// ErrOverflow is returned by a watch that has fallen too far behind
+// the stream of events.
+var ErrOverflow = errors.New("event buffer overflow")
+
+// Events is a set of channels receiving events of type T.  The zero-
+// value Events is ready to use.
+type Events[T any] struct {
+	mu   sync.Mutex
+	subs map[chan T]struct{}
+}
+
+// Subscribe to events.  The caller MUST call the returned function to
+// unsubscribe.  The channel is closed when the subscriber falls behind
+// by more than buf events.
+func (s *Events[T]) Subscribe(buf int) (<-chan T, func()) {
+	ch := make(chan T, buf)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.subs == nil {
+		s.subs = make(map[chan T]struct{})
+	}
+	s.subs[ch] = struct{}{}
+
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.unsubscribe(ch)
+	}
+}
+
+// Publish the events to subscribers.  Publish never blocks;  any
+// subscriber whose buffer is full is dropped.  Publishing to a nil
+// Events is a no-op.
+func (s *Events[T]) Publish(evs ...T) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ev := range evs {
+		for ch := range s.subs {
+			select {
+			case ch <- ev:
+			default:
+				s.unsubscribe(ch)
+			}
+		}
+	}
+}
+
+// unsubscribe removes the channel and closes it, if it has not
+// already been removed.  The caller MUST hold the lock.
+func (s *Events[T]) unsubscribe(ch chan T) {
+	if _, ok := s.subs[ch]; ok {
+		delete(s.subs, ch)
+		close(ch)
+	}
+}
+
+// Call is the server side of a Watch call.
+type Call interface {
+	Go()
+}
+
+// Sink is the client of a remote event handler, whose recv method
+// streams events of type P, e.g. api.View_EventHandler.
+type Sink[P any] interface {
+	Recv(context.Context, func(P) error) error
+	WaitStreaming() error
+}
+
+// Serve streams events to the handler until the context expires, the
+// handler fails, or the subscription is dropped because the handler
+// fell behind, in which case ErrOverflow is returned.  Each event is
+// bound to the parameters of the handler's recv method by bind.
+//
+// Serve calls call.Go(), so that the server can handle other calls
+// while the watch is running.
+func Serve[T, P any](ctx context.Context, call Call, h Sink[P], events <-chan T, bind func(T) func(P) error) error {
+	return ServeUntil(ctx, call, h, events, bind, nil)
+}
+
+// ServeUntil is like Serve, but it also returns when done is closed.
+// The events that were buffered when done was closed are delivered
+// first.  A nil done channel is never closed.
+func ServeUntil[T, P any](ctx context.Context, call Call, h Sink[P], events <-chan T, bind func(T) func(P) error, done <-chan struct{}) error {
+	send := func(ev T, ok bool) error {
+		if !ok {
+			return ErrOverflow
+		}
+
+		return h.Recv(ctx, bind(ev))
+	}
+
+	var err error
+	for call.Go(); err == nil; {
+		select {
+		case ev, ok := <-events:
+			err = send(ev, ok)
+
+		case <-done:
+			for err == nil && len(events) > 0 {
+				ev, ok := <-events
+				err = send(ev, ok)
+			}
+
+			if err == nil {
+				err = errDone
+			}
+
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+
+	if e := h.WaitStreaming(); e != nil {
+		return e
+	}
+
+	if err == errDone {
+		err = nil
+	}
+
+	return err
+}
+
+// errDone stops ServeUntil when done is closed.
+var errDone = errors.New("done")
+
+// Handler buffers the events received by the client side of a watch,
+// for consumption by an iterator.  It is closed when the handler
+// capability that serves it is released.
+type Handler[T any] chan T
+
+func (ch Handler[T]) Shutdown() { close(ch) }
+
+func (ch Handler[T]) Next() (ev T, ok bool) {
+	ev, ok = <-ch
+	return
+}
+
+// Send the event to the iterator.  Send blocks until the iterator
+// is ready to receive it, or until the context expires.
+func (ch Handler[T]) Send(ctx context.Context, ev T) error {
+	select {
+	case ch <- ev:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
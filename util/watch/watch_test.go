@@ -0,0 +1,92 @@
+package watch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/util/watch"
+)
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Publish", func(t *testing.T) {
+		t.Parallel()
+
+		var s watch.Events[int]
+		a, cancelA := s.Subscribe(2)
+		defer cancelA()
+		b, cancelB := s.Subscribe(2)
+		defer cancelB()
+
+		s.Publish(1, 2)
+
+		for _, ch := range []<-chan int{a, b} {
+			assert.Equal(t, 1, <-ch, "should deliver events in order")
+			assert.Equal(t, 2, <-ch, "should deliver events in order")
+		}
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		t.Parallel()
+
+		var s watch.Events[int]
+		events, cancel := s.Subscribe(1)
+		defer cancel()
+
+		s.Publish(1, 2)
+		s.Publish(3) // must not send to the closed channel
+
+		ev, ok := <-events
+		require.True(t, ok, "should receive buffered event")
+		assert.Equal(t, 1, ev)
+
+		_, ok = <-events
+		assert.False(t, ok, "should drop subscriber that fell behind")
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		t.Parallel()
+
+		var s watch.Events[int]
+		events, cancel := s.Subscribe(1)
+		cancel()
+		cancel() // idempotent
+
+		s.Publish(1)
+
+		_, ok := <-events
+		assert.False(t, ok, "should close channel")
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		var s *watch.Events[int]
+		assert.NotPanics(t, func() { s.Publish(1) },
+			"publishing to nil Events should be a no-op")
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	h := make(watch.Handler[int], 1)
+	require.NoError(t, h.Send(context.Background(), 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, h.Send(ctx, 2), context.Canceled,
+		"should abort send when buffer is full")
+
+	ev, ok := h.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 1, ev)
+
+	h.Shutdown()
+	_, ok = h.Next()
+	assert.False(t, ok, "should be exhausted after shutdown")
+}
@@ -49,6 +49,11 @@ func (d Dialer) Dial(ctx context.Context, addr peer.AddrInfo, protos ...protocol
 		return auth.Session{}, fmt.Errorf("dial: %w", err)
 	}
 
+	return d.Login(ctx, conn)
+}
+
+// Login to the vat at the other end of the connection.
+func (d Dialer) Login(ctx context.Context, conn *rpc.Conn) (auth.Session, error) {
 	client := conn.Bootstrap(ctx)
 	if err := client.Resolve(ctx); err != nil {
 		return auth.Session{}, fmt.Errorf("bootstrap: %w", err)
@@ -0,0 +1,137 @@
+package vat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/util/proto"
+)
+
+// executors dials the executors of cluster nodes on behalf of the
+// scheduler.  A host cannot dial itself, so the local executor is used
+// for the local peer.  Sessions with remote nodes are kept open, and
+// are redialed when their connection is lost.
+type executors struct {
+	NS     string
+	Dialer Dialer
+	Local  csp.Executor // owned by executors
+
+	mu       sync.Mutex
+	sessions map[peer.ID]remote
+	closed   bool
+}
+
+type remote struct {
+	conn *rpc.Conn
+	sess auth.Session
+}
+
+func (ex *executors) DialExecutor(ctx context.Context, id peer.ID) (csp.Executor, error) {
+	if id == ex.Dialer.Host.ID() {
+		return ex.Local.AddRef(), nil
+	}
+
+	if e, ok := ex.cached(id); ok {
+		return e, nil
+	}
+
+	// Dial without holding mu, so that a slow peer does not hold up
+	// placements on other nodes.
+	conn, err := ex.Dialer.DialRPC(ctx, peer.AddrInfo{ID: id}, proto.Namespace(ex.NS)...)
+	if err != nil {
+		return csp.Executor{}, fmt.Errorf("dial: %w", err)
+	}
+
+	sess, err := ex.Dialer.Login(ctx, conn)
+	if err != nil {
+		conn.Close()
+		return csp.Executor{}, err
+	}
+
+	return ex.store(id, remote{conn: conn, sess: sess})
+}
+
+// cached returns the executor of a live session with id, if any.
+// Sessions whose connection was lost are discarded.
+func (ex *executors) cached(id peer.ID) (csp.Executor, bool) {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	r, ok := ex.sessions[id]
+	if ok && r.live() {
+		return r.sess.Executor().AddRef(), true
+	}
+
+	if ok {
+		r.sess.Logout()
+		delete(ex.sessions, id)
+	}
+
+	return csp.Executor{}, false
+}
+
+// store a newly dialed session with id, and return its executor.  If
+// a concurrent dial has already stored a live session, the new one is
+// closed in favor of it.
+func (ex *executors) store(id peer.ID, r remote) (csp.Executor, error) {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	if ex.closed {
+		r.close()
+		return csp.Executor{}, errors.New("closed")
+	}
+
+	if old, ok := ex.sessions[id]; ok {
+		if old.live() {
+			r.close()
+			return old.sess.Executor().AddRef(), nil
+		}
+
+		old.sess.Logout()
+	}
+
+	if ex.sessions == nil {
+		ex.sessions = make(map[peer.ID]remote)
+	}
+	ex.sessions[id] = r
+
+	return r.sess.Executor().AddRef(), nil
+}
+
+// Close the remote sessions, and release the local executor.
+func (ex *executors) Close() error {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	for id, r := range ex.sessions {
+		r.close()
+		delete(ex.sessions, id)
+	}
+
+	ex.closed = true
+	ex.Local.Release()
+	return nil
+}
+
+// live reports whether the connection of the session is still open.
+func (r remote) live() bool {
+	select {
+	case <-r.conn.Done():
+		return false
+	default:
+		return true
+	}
+}
+
+func (r remote) close() {
+	r.sess.Logout()
+	r.conn.Close()
+}
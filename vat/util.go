@@ -5,11 +5,18 @@ import (
 	"strings"
 
 	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/ipfs/boxo/bitswap"
+	bsnet "github.com/ipfs/boxo/bitswap/network"
+	"github.com/ipfs/boxo/blockservice"
+	"github.com/ipfs/boxo/blockstore"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	p2p "github.com/libp2p/go-libp2p"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	"github.com/libp2p/go-libp2p-kad-dht/dual"
 	local "github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/routing"
 	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	tcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/wetware/pkg/util/proto"
@@ -46,6 +53,16 @@ func NewDHT(ctx context.Context, h local.Host, ns string) (*dual.DHT, error) {
 		dual.WanDHTOption(wanOpt(ns)...))
 }
 
+// NewBlockService returns a block service that exchanges blocks with
+// peers over BitSwap, using the namespace's protocol prefix.  Blocks are
+// kept in memory, and are announced to r as they are added.  The caller
+// MUST close the block service.
+func NewBlockService(ctx context.Context, h local.Host, r routing.ContentRouting, ns string) blockservice.BlockService {
+	bs := blockstore.NewBlockstore(ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	net := bsnet.NewFromIpfsHost(h, r, bsnet.Prefix(proto.Root(ns)))
+	return blockservice.New(bs, bitswap.New(ctx, net, bs))
+}
+
 func lanOpt(ns string) []dht.Option {
 	return []dht.Option{
 		dht.Mode(dht.ModeServer),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"runtime"
+	"sync"
 	"time"
 
 	"capnproto.org/go/capnp/v3"
@@ -22,14 +23,25 @@ import (
 	"go.uber.org/multierr"
 	"zenhack.net/go/util/rc"
 
+	capstore_api "github.com/wetware/pkg/api/capstore"
 	api "github.com/wetware/pkg/api/cluster"
 	"github.com/wetware/pkg/api/core"
+	pubsub_api "github.com/wetware/pkg/api/pubsub"
 	"github.com/wetware/pkg/auth"
 	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/cap/anchor"
+	"github.com/wetware/pkg/cap/bitswap"
+	"github.com/wetware/pkg/cap/capstore"
+	capstore_server "github.com/wetware/pkg/cap/capstore/server"
+	"github.com/wetware/pkg/cap/csp"
 	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/cap/meta"
+	"github.com/wetware/pkg/cap/pubsub"
+	"github.com/wetware/pkg/cap/scheduler"
 	"github.com/wetware/pkg/cluster"
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/rom"
 	"github.com/wetware/pkg/system"
 	"github.com/wetware/pkg/util/proto"
 )
@@ -43,6 +55,31 @@ type Config struct {
 	Meta               pulse.Preparer
 	Auth               auth.Policy
 	RuntimeConfig      wazero.RuntimeConfig
+
+	// AnchorLimits bound the anchor tree that is served by the vat.
+	// Applications that serve several roots, e.g. one per tenant,
+	// can set different limits on each anchor.Node.
+	AnchorLimits anchor.Limits
+
+	// MaxProcs is the maximum number of processes that the vat will
+	// run concurrently.  If zero, the number is unbounded.
+	MaxProcs uint32
+
+	// Limits are the default resource limits for processes spawned
+	// by the vat.  Zero-valued fields are unbounded.
+	Limits csp.Limits
+
+	// ROMDir is a directory in which bytecode is persisted, so that
+	// it survives restarts.  If empty, bytecode is cached in memory
+	// only.
+	ROMDir string
+
+	// Blocks is the local peer's BitSwap block service.  If non-nil,
+	// bytecode that is cached by the vat is added to it, so that peers
+	// can fetch it, and it is used to fetch bytecode from peers when a
+	// process is spawned from a CID that is not cached locally.  See
+	// NewBlockService.
+	Blocks bitswap.BlockService
 }
 
 func (conf Config) Serve(ctx context.Context) error {
@@ -87,24 +124,56 @@ func (conf Config) Serve(ctx context.Context) error {
 		return err
 	}
 
+	e, err := conf.NewExecutor(ctx)
+	if err != nil {
+		return err
+	}
+	defer e.Runtime.Close(ctx)
+
 	r := &cluster.Router{
 		Topic:        t,
 		Meta:         conf.Meta,
+		Load:         e,
 		RoutingTable: rt,
 	}
 	defer r.Close()
 
-	root, err := conf.NewRootSession(r)
+	logger := conf.Logger().With("id", r.ID())
+
+	// The scheduler forwards execs to the executors of cluster nodes,
+	// including the local one.
+	ex := &executors{
+		NS: conf.NS,
+		Dialer: Dialer{
+			Host:    conf.Host,
+			Account: auth.SignerFromHost(conf.Host),
+		},
+		Local: e.Executor(),
+	}
+	defer ex.Close()
+
+	root, err := conf.NewRootSession(r, Services{
+		Executor: e.Executor(),
+		Scheduler: scheduler.Server{
+			RoutingTable: rt,
+			Dialer:       ex,
+			Log:          logger,
+		}.Scheduler(),
+		PubSub: (&pubsub.Server{
+			Log:         logger,
+			TopicJoiner: ps,
+		}).PubSub(),
+		CapStore: (&capstore_server.CapStore{
+			Map:    new(sync.Map),
+			Logger: logger,
+		}).CapStore(),
+		Anchor: (&anchor.Node{Limits: conf.AnchorLimits}).Anchor(),
+	})
 	if err != nil {
 		return err
 	}
 	defer root.Logout()
 
-	// e, err := conf.NewExecutor(ctx)
-	// if err != nil {
-	// 	return err
-	// }
-
 	server := &Server{
 		NS:      conf.NS,
 		Host:    conf.Host,
@@ -121,8 +190,6 @@ func (conf Config) Serve(ctx context.Context) error {
 	}
 	defer release()
 
-	logger := conf.Logger().With("id", r.ID())
-
 	logger.Info("wetware started")
 	defer logger.Warn("wetware stopped")
 
@@ -143,7 +210,17 @@ func (conf Config) Serve(ctx context.Context) error {
 	}
 }
 
-func (conf Config) NewRootSession(r *cluster.Router) (auth.Session, error) {
+// Services are the capabilities granted to the root session.  The root
+// session takes ownership of each capability, and releases it on logout.
+type Services struct {
+	Executor  csp.Executor
+	Scheduler scheduler.Scheduler
+	PubSub    pubsub.Router
+	CapStore  capstore.CapStore
+	Anchor    anchor.Anchor
+}
+
+func (conf Config) NewRootSession(r *cluster.Router, svc Services) (auth.Session, error) {
 	_, seg := capnp.NewSingleSegmentMessage(nil)
 
 	sess, err := core.NewRootSession(seg) // TODO(optimization):  non-root?
@@ -164,6 +241,12 @@ func (conf Config) NewRootSession(r *cluster.Router) (auth.Session, error) {
 		sess.Local().SetHost(hostname),
 		sess.Local().SetPeer(string(conf.Host.ID())),
 		sess.SetView(api.View(r.View())),
+		sess.SetExecutor(core.Executor(svc.Executor)),
+		sess.SetScheduler(core.Scheduler(svc.Scheduler)),
+		sess.SetPubSub(pubsub_api.Router(svc.PubSub)),
+		sess.SetCapStore(capstore_api.CapStore(svc.CapStore)),
+		sess.SetAnchor(capnp.Client(svc.Anchor)),
+		sess.SetMeta(api.Meta(meta.Server{Router: r}.Meta())),
 	)
 
 	return auth.Session(sess), err
@@ -190,11 +273,32 @@ func (conf Config) NewExecutor(ctx context.Context) (csp_server.Runtime, error)
 		return csp_server.Runtime{}, err
 	}
 
+	sys, err := system.Compile(ctx, r)
+	if err != nil {
+		return csp_server.Runtime{}, err
+	}
+
+	cache := new(csp_server.BytecodeCache)
+	if conf.ROMDir != "" {
+		cache.Store = rom.Dir{Path: conf.ROMDir}
+	}
+
+	var bs bitswap.BitSwap
+	if conf.Blocks != nil {
+		cache.Provider = conf.Blocks
+		bs = bitswap.Server{Exchange: conf.Blocks}.BitSwap()
+	}
+
 	return csp_server.Runtime{
-		Runtime: r,
-		Cache:   make(csp_server.BytecodeCache),
-		Tree:    csp_server.NewProcTree(ctx),
-		Log:     slog.Default(),
+		Runtime:  r,
+		Cache:    cache,
+		Modules:  new(csp_server.ModuleCache),
+		System:   sys,
+		Tree:     csp_server.NewProcTree(ctx),
+		Log:      slog.Default(),
+		MaxProcs: conf.MaxProcs,
+		Limits:   conf.Limits,
+		BitSwap:  bs,
 	}, nil
 }
 
@@ -7,14 +7,21 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p"
+	routinghelpers "github.com/libp2p/go-libp2p-routing-helpers"
 	"github.com/libp2p/go-libp2p/core/discovery"
 	"github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
 	inproc "github.com/lthibault/go-libp2p-inproc-transport"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	core_api "github.com/wetware/pkg/api/core"
 	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/rom"
 	"github.com/wetware/pkg/vat"
 )
 
@@ -85,6 +92,73 @@ func TestServe(t *testing.T) {
 	})
 }
 
+func TestExecCached_bitswap(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// A module whose _start function returns immediately.
+	bc := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, // header
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00, // types: () -> ()
+		0x03, 0x02, 0x01, 0x00, // functions: 1 of type 0
+		0x05, 0x03, 0x01, 0x00, 0x01, // memory: 1 page
+		0x07, 0x13, 0x02, // exports
+		0x06, '_', 's', 't', 'a', 'r', 't', 0x00, 0x00,
+		0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+		0x0a, 0x04, 0x01, 0x02, 0x00, 0x0b, // code: no-op
+	}
+
+	// The bytecode is passed to exec on the first node, and spawned by
+	// CID on the second, which must fetch it from the first.
+	ex := make([]csp.Executor, 2)
+	hs := make([]host.Host, 2)
+	caches := make([]*csp_server.BytecodeCache, 2)
+	for i := range ex {
+		h, err := libp2p.New(
+			libp2p.NoTransports,
+			libp2p.Transport(inproc.New()),
+			libp2p.ListenAddrStrings("/inproc/~"))
+		require.NoError(t, err)
+		defer h.Close()
+		hs[i] = h
+
+		blocks := vat.NewBlockService(ctx, h, routinghelpers.Null{}, "test")
+		defer blocks.Close()
+
+		r, err := vat.Config{
+			NS:     "test",
+			Host:   h,
+			Blocks: blocks,
+		}.NewExecutor(ctx)
+		require.NoError(t, err)
+		defer r.Runtime.Close(ctx)
+
+		ex[i] = r.Executor()
+		defer ex[i].Release()
+		caches[i] = r.Cache
+	}
+
+	require.NoError(t, hs[1].Connect(ctx, *host.InfoFromHost(hs[0])),
+		"must connect hosts")
+
+	proc, release := ex[0].Exec(ctx, core_api.Session{}, bc, 0, csp.Limits{}, nil)
+	defer release()
+	require.NoError(t, proc.Wait(ctx), "should run process on first node")
+
+	id := rom.ROM{Bytecode: bc}.CID()
+	require.Zero(t, caches[1].Stats().Entries, "second node must not cache bytecode")
+
+	proc, release = ex[1].ExecCached(ctx, core_api.Session{}, id, 0, csp.Limits{}, nil)
+	defer release()
+	require.NoError(t, proc.Wait(ctx), "should run process on second node")
+
+	stats := caches[1].Stats()
+	assert.Equal(t, 1, stats.Entries, "should cache fetched bytecode")
+	assert.Equal(t, len(bc), stats.Bytes, "should cache fetched bytecode")
+}
+
 type beacon struct {
 	*peer.AddrInfo
 	event.Bus